@@ -15,9 +15,10 @@ import (
 	"github.com/lino-network/lino/x/proposal"
 
 	acc "github.com/lino-network/lino/x/account"
-	accModel "github.com/lino-network/lino/x/account/model"
 	developer "github.com/lino-network/lino/x/developer"
+	globalModel "github.com/lino-network/lino/x/global/model"
 	infra "github.com/lino-network/lino/x/infra"
+	proposalModel "github.com/lino-network/lino/x/proposal/model"
 	rep "github.com/lino-network/lino/x/reputation"
 	val "github.com/lino-network/lino/x/validator"
 	vote "github.com/lino-network/lino/x/vote"
@@ -76,6 +77,9 @@ type LinoBlockchain struct {
 
 	// global param
 	paramHolder param.ParamHolder
+
+	// store migrations of protocol upgrades known by this binary
+	upgradeRegistry proposal.UpgradeRegistry
 }
 
 // NewLinoBlockchain - create a Lino Blockchain instance
@@ -112,6 +116,8 @@ func NewLinoBlockchain(
 	lb.infraManager = infra.NewInfraManager(lb.CapKeyInfraStore, lb.paramHolder)
 	lb.developerManager = developer.NewDeveloperManager(lb.CapKeyDeveloperStore, lb.paramHolder)
 	lb.proposalManager = proposal.NewProposalManager(lb.CapKeyProposalStore, lb.paramHolder)
+	lb.upgradeRegistry = proposal.NewUpgradeRegistry()
+	lb.registerUpgradeHandlers()
 
 	lb.Router().
 		AddRoute(types.AccountRouterName, acc.NewHandler(lb.accountManager, lb.globalManager)).
@@ -123,10 +129,22 @@ func NewLinoBlockchain(
 			lb.developerManager, lb.accountManager, lb.globalManager)).
 		AddRoute(types.ProposalRouterName, proposal.NewHandler(
 			lb.accountManager, lb.proposalManager, lb.postManager, lb.globalManager, lb.voteManager)).
-		AddRoute(types.InfraRouterName, infra.NewHandler(lb.infraManager)).
+		AddRoute(types.InfraRouterName, infra.NewHandler(lb.infraManager, lb.postManager)).
 		AddRoute(types.ValidatorRouterName, val.NewHandler(
 			lb.accountManager, lb.valManager, lb.voteManager, lb.globalManager))
 
+	lb.QueryRouter().
+		AddRoute(types.AccountQuerierRoute, acc.NewQuerier(lb.accountManager)).
+		AddRoute(types.PostQuerierRoute, post.NewQuerier(
+			lb.postManager, lb.accountManager, lb.globalManager, lb.developerManager, lb.reputationManager)).
+		AddRoute(types.VoteQuerierRoute, vote.NewQuerier(lb.voteManager)).
+		AddRoute(types.DeveloperQuerierRoute, developer.NewQuerier(lb.developerManager)).
+		AddRoute(types.ProposalQuerierRoute, proposal.NewQuerier(lb.proposalManager)).
+		AddRoute(types.InfraQuerierRoute, infra.NewQuerier(lb.infraManager)).
+		AddRoute(types.ValidatorQuerierRoute, val.NewQuerier(lb.valManager)).
+		AddRoute(types.GlobalQuerierRoute, global.NewQuerier(lb.globalManager)).
+		AddRoute(types.ReputationQuerierRoute, rep.NewQuerier(lb.reputationManager))
+
 	lb.SetInitChainer(lb.initChainer)
 	lb.SetBeginBlocker(lb.beginBlocker)
 	lb.SetEndBlocker(lb.endBlocker)
@@ -186,6 +204,10 @@ func MakeCodec() *wire.Codec {
 	val.RegisterWire(cdc)
 	proposal.RegisterWire(cdc)
 
+	// interfaces in exported genesis state
+	registerEvent(cdc)
+	registerProposal(cdc)
+
 	cdc.Seal()
 
 	return cdc
@@ -195,10 +217,35 @@ func registerEvent(cdc *wire.Codec) {
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(post.RewardEvent{}, "lino/eventReward", nil)
 	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "lino/eventReturn", nil)
+	cdc.RegisterConcrete(acc.ScheduledTransferEvent{}, "lino/eventScheduledTransfer", nil)
+	cdc.RegisterConcrete(val.UnbondingEvent{}, "lino/eventValidatorUnbonding", nil)
 	cdc.RegisterConcrete(param.ChangeParamEvent{}, "lino/eventCpe", nil)
 	cdc.RegisterConcrete(proposal.DecideProposalEvent{}, "lino/eventDpe", nil)
 }
 
+// registered names are same as proposal storage, so that proposals
+// returned by proposal querier can be decoded by application codec
+func registerProposal(cdc *wire.Codec) {
+	cdc.RegisterInterface((*proposalModel.Proposal)(nil), nil)
+	cdc.RegisterConcrete(&proposalModel.ChangeParamProposal{}, "changeParam", nil)
+	cdc.RegisterConcrete(&proposalModel.ProtocolUpgradeProposal{}, "upgrade", nil)
+	cdc.RegisterConcrete(&proposalModel.FailedEventProposal{}, "failedEvent", nil)
+	cdc.RegisterConcrete(&proposalModel.ContentCensorshipProposal{}, "censorship", nil)
+
+	cdc.RegisterInterface((*param.Parameter)(nil), nil)
+	cdc.RegisterConcrete(param.GlobalAllocationParam{}, "allocation", nil)
+	cdc.RegisterConcrete(param.InfraInternalAllocationParam{}, "infraAllocation", nil)
+	cdc.RegisterConcrete(param.EvaluateOfContentValueParam{}, "contentValue", nil)
+	cdc.RegisterConcrete(param.VoteParam{}, "voteParam", nil)
+	cdc.RegisterConcrete(param.ProposalParam{}, "proposalParam", nil)
+	cdc.RegisterConcrete(param.DeveloperParam{}, "developerParam", nil)
+	cdc.RegisterConcrete(param.ValidatorParam{}, "validatorParam", nil)
+	cdc.RegisterConcrete(param.CoinDayParam{}, "coinDayParam", nil)
+	cdc.RegisterConcrete(param.BandwidthParam{}, "bandwidthParam", nil)
+	cdc.RegisterConcrete(param.AccountParam{}, "accountParam", nil)
+	cdc.RegisterConcrete(param.PostParam{}, "postParam", nil)
+}
+
 // custom logic for lino blockchain initialization
 func (lb *LinoBlockchain) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	// set init time to zero
@@ -236,6 +283,14 @@ func (lb *LinoBlockchain) initChainer(ctx sdk.Context, req abci.RequestInitChain
 		}
 	}
 
+	// restart from exported state, all KVStores are restored from the snapshot
+	if genesisState.ExportedState != nil {
+		if err := lb.importExportedState(ctx, genesisState); err != nil {
+			panic(err)
+		}
+		return abci.ResponseInitChain{}
+	}
+
 	totalCoin := types.NewCoinFromInt64(0)
 
 	// calculate total lino coin
@@ -285,6 +340,131 @@ func (lb *LinoBlockchain) initChainer(ctx sdk.Context, req abci.RequestInitChain
 	return abci.ResponseInitChain{}
 }
 
+// import KVStores exported by ExportAppStateAndValidators
+func (lb *LinoBlockchain) importExportedState(ctx sdk.Context, genesisState *GenesisState) sdk.Error {
+	if genesisState.Version != GenesisStateVersion {
+		return ErrUnsupportedGenesisVersion(genesisState.Version)
+	}
+	state := genesisState.ExportedState
+	if err := validateExportedState(state); err != nil {
+		return err
+	}
+	if err := reconcileTotalLinoCoin(state); err != nil {
+		return err
+	}
+	if err := lb.accountManager.Import(ctx, state.Accounts); err != nil {
+		return err
+	}
+	if err := lb.postManager.Import(ctx, state.Posts); err != nil {
+		return err
+	}
+	if err := lb.voteManager.Import(ctx, state.Votes); err != nil {
+		return err
+	}
+	if err := lb.valManager.Import(ctx, state.Validators); err != nil {
+		return err
+	}
+	if err := lb.developerManager.Import(ctx, state.Developers); err != nil {
+		return err
+	}
+	if err := lb.infraManager.Import(ctx, state.Infra); err != nil {
+		return err
+	}
+	if err := lb.proposalManager.Import(ctx, state.Proposals); err != nil {
+		return err
+	}
+	if err := lb.globalManager.Import(ctx, state.Global); err != nil {
+		return err
+	}
+	lb.reputationManager.Import(ctx, state.Reputation)
+	return nil
+}
+
+// all KVStore sections except reputation must be present in exported state
+func validateExportedState(state *GenesisExportedState) sdk.Error {
+	switch {
+	case state.Accounts == nil:
+		return ErrGenesisSectionMissing("accounts")
+	case state.Posts == nil:
+		return ErrGenesisSectionMissing("posts")
+	case state.Votes == nil:
+		return ErrGenesisSectionMissing("votes")
+	case state.Validators == nil:
+		return ErrGenesisSectionMissing("validators")
+	case state.Developers == nil:
+		return ErrGenesisSectionMissing("developers")
+	case state.Infra == nil:
+		return ErrGenesisSectionMissing("infra")
+	case state.Proposals == nil:
+		return ErrGenesisSectionMissing("proposals")
+	case state.Global == nil:
+		return ErrGenesisSectionMissing("global")
+	}
+	return nil
+}
+
+// reconcileTotalLinoCoin - coin held by savings, unclaimed rewards, stakes, interests,
+// delegations, settled and outstanding delegator rewards, deposits, unbonding deposits, pending and dead-lettered coin return
+// events and unclaimed friction must equal TotalLinoCoin. Inflation pools and
+// consumption reward pool are not in circulation, they are added to TotalLinoCoin
+// once distributed. Consumption window is the evaluated donation weight of the
+// reward pool rather than coin.
+func reconcileTotalLinoCoin(state *GenesisExportedState) sdk.Error {
+	imported := types.NewCoinFromInt64(0)
+	for _, row := range state.Accounts.Accounts {
+		imported = imported.Plus(row.Bank.Saving).Plus(row.Reward.UnclaimReward)
+	}
+	for _, voter := range state.Votes.Voters {
+		imported = imported.Plus(voter.LinoStake).Plus(voter.Interest)
+	}
+	for _, row := range state.Votes.Delegations {
+		imported = imported.Plus(row.Delegation.Amount)
+	}
+	for _, row := range state.Votes.DelegatorRewards {
+		imported = imported.Plus(row.Reward)
+	}
+	for _, row := range state.Votes.DelegatorRewardPools {
+		imported = imported.Plus(row.Pool.Outstanding)
+	}
+	for _, validator := range state.Validators.Validators {
+		imported = imported.Plus(validator.Deposit)
+	}
+	for _, deposit := range state.Validators.UnbondingDeposits {
+		imported = imported.Plus(deposit.Amount)
+	}
+	for _, developer := range state.Developers.Developers {
+		imported = imported.Plus(developer.Deposit)
+	}
+	for _, row := range state.Global.TimeEventLists {
+		for _, event := range row.TimeEventList.Events {
+			if returnEvent, ok := event.(acc.ReturnCoinEvent); ok {
+				imported = imported.Plus(returnEvent.Amount)
+			}
+		}
+	}
+	for _, failedEvent := range state.Global.FailedEvents {
+		if returnEvent, ok := failedEvent.Event.(acc.ReturnCoinEvent); ok {
+			imported = imported.Plus(returnEvent.Amount)
+		}
+	}
+	days := make(map[int64]bool)
+	for _, row := range state.Global.LinoStakeStats {
+		days[row.Day] = true
+	}
+	for _, row := range state.Global.LinoStakeStats {
+		// friction of a day without lino stake is carried over to the next day
+		if row.LinoStakeStat.TotalLinoStake.IsZero() && days[row.Day+1] {
+			continue
+		}
+		imported = imported.Plus(row.LinoStakeStat.UnclaimedFriction)
+	}
+	total := state.Global.GlobalMeta.TotalLinoCoin
+	if !imported.IsEqual(total) {
+		return ErrTotalLinoCoinNotReconciled(total, imported)
+	}
+	return nil
+}
+
 // convert GenesisAccount to AppAccount
 func (lb *LinoBlockchain) toAppAccount(ctx sdk.Context, ga GenesisAccount) sdk.Error {
 	if lb.accountManager.DoesAccountExist(ctx, types.AccountKey(ga.Name)) {
@@ -366,6 +546,12 @@ func (lb *LinoBlockchain) toAppInfra(
 
 // init process for a block, execute time events and fire incompetent validators
 func (lb *LinoBlockchain) beginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	// run store migrations of protocol upgrades due at this height,
+	// node halts here if binary doesn't know a scheduled upgrade
+	if err := lb.proposalManager.ApplyUpgrades(ctx, lb.upgradeRegistry); err != nil {
+		panic(err)
+	}
+
 	chainStartTime, err := lb.globalManager.GetChainStartTime(ctx)
 	if err != nil {
 		panic(err)
@@ -398,6 +584,7 @@ func (lb *LinoBlockchain) beginBlocker(ctx sdk.Context, req abci.RequestBeginBlo
 
 	lb.syncInfoWithVoteManager(ctx)
 	lb.executeTimeEvents(ctx)
+	lb.retryFailedEvents(ctx)
 	return abci.ResponseBeginBlock{
 		Tags: tags.ToKVPairs(),
 	}
@@ -413,7 +600,7 @@ func (lb *LinoBlockchain) executeTimeEvents(ctx sdk.Context) {
 	}
 	for i := lastBlockTime; i < currentTime; i++ {
 		if timeEvents := lb.globalManager.GetTimeEventListAtTime(ctx, i); timeEvents != nil {
-			lb.executeEvents(ctx, timeEvents.Events)
+			lb.executeEvents(ctx, timeEvents.Events, i)
 			lb.globalManager.RemoveTimeEventList(ctx, i)
 		}
 	}
@@ -422,28 +609,11 @@ func (lb *LinoBlockchain) executeTimeEvents(ctx sdk.Context) {
 	}
 }
 
-// execute events in list based on their type
-func (lb *LinoBlockchain) executeEvents(ctx sdk.Context, eventList []types.Event) sdk.Error {
+// execute events in list registered at unix time, failed events are moved to dead-letter store
+func (lb *LinoBlockchain) executeEvents(ctx sdk.Context, eventList []types.Event, unixTime int64) sdk.Error {
 	for _, event := range eventList {
-		switch e := event.(type) {
-		case post.RewardEvent:
-			if err := e.Execute(
-				ctx, lb.postManager, lb.accountManager, lb.globalManager,
-				lb.developerManager, lb.voteManager, lb.reputationManager); err != nil {
-				panic(err)
-			}
-		case acc.ReturnCoinEvent:
-			if err := e.Execute(ctx, lb.accountManager); err != nil {
-				panic(err)
-			}
-		case proposal.DecideProposalEvent:
-			if err := e.Execute(
-				ctx, lb.voteManager, lb.valManager, lb.accountManager, lb.proposalManager,
-				lb.postManager, lb.globalManager); err != nil {
-				panic(err)
-			}
-		case param.ChangeParamEvent:
-			if err := e.Execute(ctx, lb.paramHolder); err != nil {
+		if err := lb.executeEvent(ctx, event); err != nil {
+			if err := lb.globalManager.AddFailedEvent(ctx, event, unixTime, err); err != nil {
 				panic(err)
 			}
 		}
@@ -451,6 +621,51 @@ func (lb *LinoBlockchain) executeEvents(ctx sdk.Context, eventList []types.Event
 	return nil
 }
 
+// retry failed events in dead-letter store which have retries left
+func (lb *LinoBlockchain) retryFailedEvents(ctx sdk.Context) {
+	failedEvents, err := lb.globalManager.GetRetryableFailedEvents(ctx)
+	if err != nil {
+		panic(err)
+	}
+	for _, failedEvent := range failedEvents {
+		retryErr := lb.executeEvent(ctx, failedEvent.Event)
+		if err := lb.globalManager.UpdateFailedEventRetry(ctx, failedEvent.ID, retryErr); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// execute event based on its type, state changes are discarded if execution fails
+func (lb *LinoBlockchain) executeEvent(ctx sdk.Context, event types.Event) sdk.Error {
+	cacheCtx, write := ctx.CacheContext()
+	var err sdk.Error
+	switch e := event.(type) {
+	case post.RewardEvent:
+		err = e.Execute(
+			cacheCtx, lb.postManager, lb.accountManager, lb.globalManager,
+			lb.developerManager, lb.voteManager, lb.reputationManager)
+	case acc.ReturnCoinEvent:
+		err = e.Execute(cacheCtx, lb.accountManager)
+	case acc.ScheduledTransferEvent:
+		err = e.Execute(cacheCtx, lb.accountManager, lb.globalManager)
+	case val.UnbondingEvent:
+		err = e.Execute(cacheCtx, lb.valManager, lb.accountManager, lb.globalManager)
+	case proposal.DecideProposalEvent:
+		err = e.Execute(
+			cacheCtx, lb.voteManager, lb.valManager, lb.accountManager, lb.proposalManager,
+			lb.postManager, lb.globalManager)
+	case param.ChangeParamEvent:
+		err = e.Execute(cacheCtx, lb.paramHolder)
+	default:
+		err = ErrUnknownEvent(event)
+	}
+	if err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
 // udpate validator set and renew reputation round
 func (lb *LinoBlockchain) endBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	ABCIValList, err := lb.valManager.GetUpdateValidatorList(ctx)
@@ -516,6 +731,10 @@ func (lb *LinoBlockchain) distributeInflationToValidator(ctx sdk.Context) {
 	if err != nil {
 		panic(err)
 	}
+	// keep inflation in pool if no one can receive it
+	if len(lst.OncallValidators) == 0 {
+		return
+	}
 	coin, err := lb.globalManager.GetValidatorHourlyInflation(ctx)
 	if err != nil {
 		panic(err)
@@ -529,8 +748,19 @@ func (lb *LinoBlockchain) distributeInflationToValidator(ctx sdk.Context) {
 			ratPerValidator = coin.ToRat().Quo(sdk.NewRat(int64(len(lst.OncallValidators) - i))).Round(types.PrecisionFactor)
 		}
 		coinPerValidator := types.RatToCoin(ratPerValidator)
+		// validator keeps the commission, the rest is shared with its delegators
+		commissionRate, err := lb.valManager.GetCommissionRate(ctx, validator)
+		if err != nil {
+			panic(err)
+		}
+		commission := types.RatToCoin(coinPerValidator.ToRat().Mul(commissionRate))
+		distributed, err := lb.voteManager.DistributeDelegatorReward(
+			ctx, validator, coinPerValidator.Minus(commission))
+		if err != nil {
+			panic(err)
+		}
 		lb.accountManager.AddSavingCoin(
-			ctx, validator, coinPerValidator, "", "", types.ValidatorInflation)
+			ctx, validator, coinPerValidator.Minus(distributed), "", "", types.ValidatorInflation)
 		coin = coin.Minus(coinPerValidator)
 	}
 }
@@ -538,15 +768,19 @@ func (lb *LinoBlockchain) distributeInflationToValidator(ctx sdk.Context) {
 // distribute inflation to infra provider monthly
 // TODO: encaptulate module event inside module
 func (lb *LinoBlockchain) distributeInflationToInfraProvider(ctx sdk.Context) {
-	inflation, err := lb.globalManager.GetInfraMonthlyInflation(ctx)
+	lst, err := lb.infraManager.GetInfraProviderList(ctx)
 	if err != nil {
 		panic(err)
 	}
-
-	lst, err := lb.infraManager.GetInfraProviderList(ctx)
+	// keep inflation in pool if no one can receive it
+	if len(lst.AllInfraProviders) == 0 {
+		return
+	}
+	inflation, err := lb.globalManager.GetInfraMonthlyInflation(ctx)
 	if err != nil {
 		panic(err)
 	}
+
 	totalDistributedInflation := types.NewCoinFromInt64(0)
 	for idx, provider := range lst.AllInfraProviders {
 		if idx == (len(lst.AllInfraProviders) - 1) {
@@ -572,12 +806,15 @@ func (lb *LinoBlockchain) distributeInflationToInfraProvider(ctx sdk.Context) {
 // distribute inflation to developer monthly
 // TODO: encaptulate module event inside module
 func (lb *LinoBlockchain) distributeInflationToDeveloper(ctx sdk.Context) {
-	inflation, err := lb.globalManager.GetDeveloperMonthlyInflation(ctx)
+	lst, err := lb.developerManager.GetDeveloperList(ctx)
 	if err != nil {
 		panic(err)
 	}
-
-	lst, err := lb.developerManager.GetDeveloperList(ctx)
+	// keep inflation in pool if no one can receive it
+	if len(lst.AllDevelopers) == 0 {
+		return
+	}
+	inflation, err := lb.globalManager.GetDeveloperMonthlyInflation(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -626,35 +863,148 @@ func (lb *LinoBlockchain) syncInfoWithVoteManager(ctx sdk.Context) {
 func (lb *LinoBlockchain) ExportAppStateAndValidators() (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
 	ctx := lb.BaseApp.NewContext(true, abci.Header{})
 
-	// iterate to get the accounts
-	accounts := []GenesisAccount{}
-	appendAccount := func(accInfo accModel.AccountInfo, accBank accModel.AccountBank) (stop bool) {
-		saving := accBank.Saving
-		deposit, err := lb.valManager.GetValidatorDeposit(ctx, accInfo.Username)
-		if err != nil {
-			saving = saving.Plus(deposit)
-		}
-		account := GenesisAccount{
-			Name:           string(accInfo.Username),
-			ResetKey:       accInfo.ResetKey,
-			TransactionKey: accInfo.TransactionKey,
-			AppKey:         accInfo.AppKey,
-			IsValidator:    false,
-			Coin:           saving,
-		}
-		accounts = append(accounts, account)
-		return false
+	exportedState, sdkErr := lb.exportState(ctx)
+	if sdkErr != nil {
+		return nil, nil, sdkErr
+	}
+	genesisParam, sdkErr := lb.exportGenesisParam(ctx)
+	if sdkErr != nil {
+		return nil, nil, sdkErr
 	}
-	lb.accountManager.IterateAccounts(ctx, appendAccount)
 
 	genesisState := GenesisState{
-		Accounts:   accounts,
-		Developers: []GenesisAppDeveloper{},
-		Infra:      []GenesisInfraProvider{},
+		Version:      GenesisStateVersion,
+		Accounts:     []GenesisAccount{},
+		Developers:   []GenesisAppDeveloper{},
+		Infra:        []GenesisInfraProvider{},
+		GenesisParam: *genesisParam,
+		InitGlobalMeta: globalModel.InitParamList{
+			MaxTPS:                       exportedState.Global.TPS.MaxTPS,
+			ConsumptionFrictionRate:      exportedState.Global.ConsumptionMeta.ConsumptionFrictionRate,
+			ConsumptionFreezingPeriodSec: exportedState.Global.ConsumptionMeta.ConsumptionFreezingPeriodSec,
+		},
+		ExportedState: exportedState,
 	}
 	appState, err = wire.MarshalJSONIndent(lb.cdc, genesisState)
 	if err != nil {
 		return nil, nil, err
 	}
+
+	// oncall validators are the validator set of the restarted chain
+	for _, oncall := range exportedState.Validators.List.OncallValidators {
+		for _, validator := range exportedState.Validators.Validators {
+			if validator.Username != oncall {
+				continue
+			}
+			pubKey, err := tmtypes.PB2TM.PubKey(validator.ABCIValidator.PubKey)
+			if err != nil {
+				return nil, nil, err
+			}
+			validators = append(validators, tmtypes.GenesisValidator{
+				PubKey: pubKey,
+				Power:  validator.ABCIValidator.Power,
+				Name:   string(validator.Username),
+			})
+		}
+	}
 	return appState, validators, nil
 }
+
+// export all KVStores
+func (lb *LinoBlockchain) exportState(ctx sdk.Context) (*GenesisExportedState, sdk.Error) {
+	var err sdk.Error
+	state := &GenesisExportedState{}
+	if state.Accounts, err = lb.accountManager.Export(ctx); err != nil {
+		return nil, err
+	}
+	if state.Posts, err = lb.postManager.Export(ctx); err != nil {
+		return nil, err
+	}
+	if state.Votes, err = lb.voteManager.Export(ctx); err != nil {
+		return nil, err
+	}
+	if state.Validators, err = lb.valManager.Export(ctx); err != nil {
+		return nil, err
+	}
+	if state.Developers, err = lb.developerManager.Export(ctx); err != nil {
+		return nil, err
+	}
+	if state.Infra, err = lb.infraManager.Export(ctx); err != nil {
+		return nil, err
+	}
+	if state.Proposals, err = lb.proposalManager.Export(ctx); err != nil {
+		return nil, err
+	}
+	if state.Global, err = lb.globalManager.Export(ctx); err != nil {
+		return nil, err
+	}
+	state.Reputation = lb.reputationManager.Export(ctx)
+	return state, nil
+}
+
+// export current parameters, restarted chain will be initialized from them
+func (lb *LinoBlockchain) exportGenesisParam(ctx sdk.Context) (*GenesisParam, sdk.Error) {
+	contentValueParam, err := lb.paramHolder.GetEvaluateOfContentValueParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	globalAllocationParam, err := lb.paramHolder.GetGlobalAllocationParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	infraAllocationParam, err := lb.paramHolder.GetInfraInternalAllocationParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	voteParam, err := lb.paramHolder.GetVoteParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	proposalParam, err := lb.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	developerParam, err := lb.paramHolder.GetDeveloperParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validatorParam, err := lb.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	coinDayParam, err := lb.paramHolder.GetCoinDayParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bandwidthParam, err := lb.paramHolder.GetBandwidthParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountParam, err := lb.paramHolder.GetAccountParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	postParam, err := lb.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reputationParam, err := lb.paramHolder.GetReputationParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GenesisParam{
+		InitFromConfig:               true,
+		EvaluateOfContentValueParam:  *contentValueParam,
+		GlobalAllocationParam:        *globalAllocationParam,
+		InfraInternalAllocationParam: *infraAllocationParam,
+		VoteParam:                    *voteParam,
+		ProposalParam:                *proposalParam,
+		DeveloperParam:               *developerParam,
+		ValidatorParam:               *validatorParam,
+		CoinDayParam:                 *coinDayParam,
+		BandwidthParam:               *bandwidthParam,
+		AccountParam:                 *accountParam,
+		PostParam:                    *postParam,
+		ReputationParam:              *reputationParam,
+	}, nil
+}
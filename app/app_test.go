@@ -20,10 +20,13 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/lino-network/lino/param"
+	acc "github.com/lino-network/lino/x/account"
 	devModel "github.com/lino-network/lino/x/developer/model"
 	globalModel "github.com/lino-network/lino/x/global/model"
 	infraModel "github.com/lino-network/lino/x/infra/model"
 	"github.com/lino-network/lino/x/post"
+	proposalModel "github.com/lino-network/lino/x/proposal/model"
+	voteModel "github.com/lino-network/lino/x/vote/model"
 )
 
 var (
@@ -226,6 +229,7 @@ func TestGenesisFromConfig(t *testing.T) {
 			ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 			ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 			ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+			FailedEventMaxRetries:     3,
 		},
 		param.DeveloperParam{
 			DeveloperMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
@@ -243,6 +247,8 @@ func TestGenesisFromConfig(t *testing.T) {
 			PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
 			ValidatorListSize:              int64(21),
 			AbsentCommitLimitation:         int64(600), // 10min
+			SignedBlocksWindow:             int64(1200),
+			MinSignedPerWindow:             sdk.NewRat(1, 2),
 		},
 		param.CoinDayParam{
 			SecondsToRecoverCoinDay: int64(7 * 24 * 3600),
@@ -262,6 +268,7 @@ func TestGenesisFromConfig(t *testing.T) {
 			ReportOrUpvoteIntervalSec: 24 * 3600,
 			PostIntervalSec:           600,
 			MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+			MaxNumOfPostVersions:      10,
 		},
 		param.ReputationParam{
 			BestContentIndexN: 10,
@@ -862,3 +869,237 @@ func TestGlobalTime(t *testing.T) {
 		assert.Equal(t, cs.expectLastBlockTime, lastBlockTime)
 	}
 }
+
+func TestExportAppStateAndValidators(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+
+	appState, validators, err := lb.ExportAppStateAndValidators()
+	assert.Nil(t, err)
+	assert.Equal(t, 21, len(validators))
+	for _, validator := range validators {
+		if validator.Name == user1 {
+			assert.Equal(t, priv2.PubKey(), validator.PubKey)
+		}
+	}
+
+	genesisState := new(GenesisState)
+	assert.Nil(t, lb.cdc.UnmarshalJSON(appState, genesisState))
+	assert.Equal(t, GenesisStateVersion, genesisState.Version)
+	assert.True(t, genesisState.GenesisParam.InitFromConfig)
+	assert.NotNil(t, genesisState.ExportedState)
+	assert.Equal(t, 21, len(genesisState.ExportedState.Accounts.Accounts))
+	assert.Equal(t, 21, len(genesisState.ExportedState.Validators.Validators))
+	assert.Equal(t, int64(1), genesisState.ExportedState.Proposals.NextProposalID.NextProposalID)
+}
+
+func TestImportExportedState(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+	ctx := lb.BaseApp.NewContext(true, abci.Header{})
+	saving, err := lb.accountManager.GetSavingFromBank(ctx, types.AccountKey(user1))
+	assert.Nil(t, err)
+	linoStake, err := lb.voteManager.GetLinoStake(ctx, types.AccountKey(user1))
+	assert.Nil(t, err)
+	validatorList, err := lb.valManager.GetValidatorList(ctx)
+	assert.Nil(t, err)
+
+	appState, _, exportErr := lb.ExportAppStateAndValidators()
+	assert.Nil(t, exportErr)
+
+	logger, db := loggerAndDB()
+	restarted := NewLinoBlockchain(logger, db, nil)
+	restarted.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	restarted.Commit()
+
+	ctx = restarted.BaseApp.NewContext(true, abci.Header{})
+	importedSaving, err := restarted.accountManager.GetSavingFromBank(ctx, types.AccountKey(user1))
+	assert.Nil(t, err)
+	assert.Equal(t, saving, importedSaving)
+	importedLinoStake, err := restarted.voteManager.GetLinoStake(ctx, types.AccountKey(user1))
+	assert.Nil(t, err)
+	assert.Equal(t, linoStake, importedLinoStake)
+	importedValidatorList, err := restarted.valManager.GetValidatorList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, validatorList.OncallValidators, importedValidatorList.OncallValidators)
+}
+
+func TestImportExportedStateNotReconciled(t *testing.T) {
+	lb := newLinoBlockchain(t, 1)
+	appState, _, err := lb.ExportAppStateAndValidators()
+	assert.Nil(t, err)
+
+	genesisState := new(GenesisState)
+	assert.Nil(t, lb.cdc.UnmarshalJSON(appState, genesisState))
+	saving := genesisState.ExportedState.Accounts.Accounts[0].Bank.Saving
+	// saving exceeds total lino coin
+	genesisState.ExportedState.Accounts.Accounts[0].Bank.Saving =
+		genesisState.ExportedState.Global.GlobalMeta.TotalLinoCoin.Plus(types.NewCoinFromInt64(1))
+	appState, err = wire.MarshalJSONIndent(lb.cdc, genesisState)
+	assert.Nil(t, err)
+
+	logger, db := loggerAndDB()
+	restarted := NewLinoBlockchain(logger, db, nil)
+	assert.Panics(t, func() {
+		restarted.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	})
+
+	// saving falls short of total lino coin
+	genesisState.ExportedState.Accounts.Accounts[0].Bank.Saving =
+		saving.Minus(types.NewCoinFromInt64(1))
+	appState, err = wire.MarshalJSONIndent(lb.cdc, genesisState)
+	assert.Nil(t, err)
+	logger, db = loggerAndDB()
+	restarted = NewLinoBlockchain(logger, db, nil)
+	assert.Panics(t, func() {
+		restarted.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	})
+
+	// unclaimed reward is part of total lino coin
+	genesisState.ExportedState.Accounts.Accounts[0].Reward.UnclaimReward = types.NewCoinFromInt64(1)
+	appState, err = wire.MarshalJSONIndent(lb.cdc, genesisState)
+	assert.Nil(t, err)
+	logger, db = loggerAndDB()
+	restarted = NewLinoBlockchain(logger, db, nil)
+	assert.NotPanics(t, func() {
+		restarted.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	})
+
+	// reward outstanding in delegator reward pool is part of total lino coin
+	genesisState.ExportedState.Accounts.Accounts[0].Reward.UnclaimReward = types.NewCoinFromInt64(0)
+	genesisState.ExportedState.Votes.DelegatorRewardPools = []voteModel.DelegatorRewardPoolRow{
+		{
+			Voter: genesisState.ExportedState.Accounts.Accounts[0].Username,
+			Pool: voteModel.DelegatorRewardPool{
+				RewardPerDelegation: sdk.NewInt(0),
+				Outstanding:         types.NewCoinFromInt64(1),
+			},
+		},
+	}
+	appState, err = wire.MarshalJSONIndent(lb.cdc, genesisState)
+	assert.Nil(t, err)
+	logger, db = loggerAndDB()
+	restarted = NewLinoBlockchain(logger, db, nil)
+	assert.NotPanics(t, func() {
+		restarted.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	})
+	genesisState.ExportedState.Accounts.Accounts[0].Bank.Saving = saving
+	genesisState.ExportedState.Votes.DelegatorRewardPools = nil
+
+	for _, version := range []string{"0", "1"} {
+		genesisState.Version = version
+		appState, err = wire.MarshalJSONIndent(lb.cdc, genesisState)
+		assert.Nil(t, err)
+		logger, db = loggerAndDB()
+		restarted = NewLinoBlockchain(logger, db, nil)
+		assert.Panics(t, func() {
+			restarted.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+		})
+	}
+}
+
+func TestExportedProposalAminoNames(t *testing.T) {
+	cdc := MakeCodec()
+	tables := proposalModel.ProposalTables{
+		OngoingProposals: []proposalModel.Proposal{
+			&proposalModel.ChangeParamProposal{
+				ProposalInfo: proposalModel.ProposalInfo{
+					AgreeVotes:    types.NewCoinFromInt64(0),
+					DisagreeVotes: types.NewCoinFromInt64(0),
+				},
+				Param: param.GlobalAllocationParam{
+					GlobalGrowthRate:         growthRate,
+					InfraAllocation:          sdk.NewRat(20, 100),
+					ContentCreatorAllocation: sdk.NewRat(65, 100),
+					DeveloperAllocation:      sdk.NewRat(10, 100),
+					ValidatorAllocation:      validatorAllocation,
+				},
+			},
+		},
+	}
+	bz, err := cdc.MarshalJSON(tables)
+	assert.Nil(t, err)
+	// names are part of genesis state format, changing them requires a new GenesisStateVersion
+	assert.Contains(t, string(bz), `"type":"changeParam"`)
+	assert.Contains(t, string(bz), `"type":"allocation"`)
+
+	imported := proposalModel.ProposalTables{}
+	assert.Nil(t, cdc.UnmarshalJSON(bz, &imported))
+	assert.Equal(t, 1, len(imported.OngoingProposals))
+	_, ok := imported.OngoingProposals[0].(*proposalModel.ChangeParamProposal)
+	assert.True(t, ok)
+}
+
+func TestFailedTimeEventRetriedAndDropped(t *testing.T) {
+	lb := newLinoBlockchain(t, 1)
+	coin := types.NewCoinFromInt64(100)
+
+	// coin is returned to an account which doesn't exist
+	header := abci.Header{ChainID: "Lino", Time: time.Unix(1, 0), Height: 2}
+	lb.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := lb.BaseApp.NewContext(false, header)
+	assert.Nil(t, lb.accountManager.MinusSavingCoin(
+		ctx, types.AccountKey(user1), coin, "", "", types.TransferOut))
+	assert.Nil(t, lb.globalManager.RegisterCoinReturnEvent(
+		ctx, []types.Event{acc.ReturnCoinEvent{
+			Username: "nonexist", Amount: coin, ReturnType: types.ProposalReturnCoin}}, 1, 10))
+	lb.EndBlock(abci.RequestEndBlock{})
+	lb.Commit()
+
+	ctx = lb.BaseApp.NewContext(true, abci.Header{})
+	proposalParam, err := lb.paramHolder.GetProposalParam(ctx)
+	assert.Nil(t, err)
+	globalStore := globalModel.NewGlobalStorage(lb.CapKeyGlobalStore)
+
+	// event fails and is dead-lettered in first block, then retried once per block
+	// until no retry is left
+	for i := int64(0); i <= proposalParam.FailedEventMaxRetries+1; i++ {
+		lb.BeginBlock(abci.RequestBeginBlock{
+			Header: abci.Header{ChainID: "Lino", Time: time.Unix(20+i, 0), Height: 3 + i}})
+		lb.EndBlock(abci.RequestEndBlock{})
+		lb.Commit()
+
+		ctx = lb.BaseApp.NewContext(true, abci.Header{})
+		failedEvents, err := globalStore.GetFailedEvents(ctx)
+		assert.Nil(t, err)
+		if !assert.Equal(t, 1, len(failedEvents)) {
+			return
+		}
+		expectAttempts := i + 1
+		if expectAttempts > proposalParam.FailedEventMaxRetries+1 {
+			expectAttempts = proposalParam.FailedEventMaxRetries + 1
+		}
+		assert.Equal(t, expectAttempts, failedEvents[0].Attempts)
+		assert.Equal(t, proposalParam.FailedEventMaxRetries+1-expectAttempts, failedEvents[0].RemainingRetries)
+	}
+
+	// coin held by dead-lettered event is reconciled in exported state
+	appState, _, err := lb.ExportAppStateAndValidators()
+	assert.Nil(t, err)
+	genesisState := new(GenesisState)
+	assert.Nil(t, lb.cdc.UnmarshalJSON(appState, genesisState))
+	assert.Equal(t, 1, len(genesisState.ExportedState.Global.FailedEvents))
+	assert.Nil(t, reconcileTotalLinoCoin(genesisState.ExportedState))
+
+	// drop failed event
+	header = abci.Header{ChainID: "Lino", Time: time.Unix(30, 0), Height: 10}
+	lb.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx = lb.BaseApp.NewContext(false, header)
+	failedEvents, err := globalStore.GetFailedEvents(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, lb.globalManager.DropFailedEvent(ctx, failedEvents[0].ID))
+	lb.EndBlock(abci.RequestEndBlock{})
+	lb.Commit()
+
+	ctx = lb.BaseApp.NewContext(true, abci.Header{})
+	failedEvents, err = globalStore.GetFailedEvents(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(failedEvents))
+}
+
+type unknownEvent struct{}
+
+func TestExecuteUnknownEvent(t *testing.T) {
+	lb := newLinoBlockchain(t, 1)
+	ctx := lb.BaseApp.NewContext(false, abci.Header{ChainID: "Lino", Time: time.Unix(1, 0), Height: 2})
+	err := lb.executeEvent(ctx, unknownEvent{})
+	assert.Equal(t, ErrUnknownEvent(unknownEvent{}), err)
+}
@@ -12,3 +12,24 @@ import (
 func ErrGenesisFailed(msg string) sdk.Error {
 	return types.NewError(types.CodeGenesisFailed, fmt.Sprintf("genesis failed: %s", msg))
 }
+
+// ErrUnsupportedGenesisVersion - error if exported genesis state version is unknown
+func ErrUnsupportedGenesisVersion(version string) sdk.Error {
+	return types.NewError(types.CodeGenesisFailed, fmt.Sprintf("unsupported genesis version: %s", version))
+}
+
+// ErrGenesisSectionMissing - error if exported genesis state misses a KVStore section
+func ErrGenesisSectionMissing(section string) sdk.Error {
+	return types.NewError(types.CodeGenesisFailed, fmt.Sprintf("genesis section %s is missing", section))
+}
+
+// ErrUnknownEvent - error if time event has no executor
+func ErrUnknownEvent(event types.Event) sdk.Error {
+	return types.NewError(types.CodeUnknownEvent, fmt.Sprintf("unknown event type %T", event))
+}
+
+// ErrTotalLinoCoinNotReconciled - error if imported balances don't match total lino coin
+func ErrTotalLinoCoinNotReconciled(total, imported types.Coin) sdk.Error {
+	return types.NewError(types.CodeGenesisFailed,
+		fmt.Sprintf("imported balances %v don't match total lino coin %v", imported, total))
+}
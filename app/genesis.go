@@ -13,7 +13,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
+	accModel "github.com/lino-network/lino/x/account/model"
+	devModel "github.com/lino-network/lino/x/developer/model"
 	globalModel "github.com/lino-network/lino/x/global/model"
+	infraModel "github.com/lino-network/lino/x/infra/model"
+	postModel "github.com/lino-network/lino/x/post/model"
+	proposalModel "github.com/lino-network/lino/x/proposal/model"
+	rep "github.com/lino-network/lino/x/reputation"
+	valModel "github.com/lino-network/lino/x/validator/model"
+	voteModel "github.com/lino-network/lino/x/vote/model"
 	"github.com/spf13/pflag"
 	crypto "github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
@@ -26,6 +34,10 @@ var (
 	flagOWK        = "owk"
 )
 
+// GenesisStateVersion - version of genesis state format, bump it whenever exported
+// tables or amino names registered by MakeCodec change
+const GenesisStateVersion = "2"
+
 // get app init parameters for server init command
 func LinoBlockchainInit() server.AppInit {
 	fsAppGenState := pflag.NewFlagSet("", pflag.ContinueOnError)
@@ -46,11 +58,26 @@ func LinoBlockchainInit() server.AppInit {
 
 // genesis state for blockchain
 type GenesisState struct {
+	Version        string                    `json:"version"`
 	Accounts       []GenesisAccount          `json:"accounts"`
 	Developers     []GenesisAppDeveloper     `json:"developers"`
 	Infra          []GenesisInfraProvider    `json:"infra"`
 	GenesisParam   GenesisParam              `json:"genesis_param"`
 	InitGlobalMeta globalModel.InitParamList `json:"init_global_meta"`
+	ExportedState  *GenesisExportedState     `json:"exported_state,omitempty"`
+}
+
+// GenesisExportedState - complete KVStore state exported from a running chain
+type GenesisExportedState struct {
+	Accounts   *accModel.AccountTables         `json:"accounts"`
+	Posts      *postModel.PostTables           `json:"posts"`
+	Votes      *voteModel.VoteTables           `json:"votes"`
+	Validators *valModel.ValidatorTables       `json:"validators"`
+	Developers *devModel.DeveloperTables       `json:"developers"`
+	Infra      *infraModel.InfraProviderTables `json:"infra"`
+	Proposals  *proposalModel.ProposalTables   `json:"proposals"`
+	Global     *globalModel.GlobalTables       `json:"global"`
+	Reputation []rep.KVPair                    `json:"reputation"`
 }
 
 // genesis account will get coin to the address and register user
@@ -141,6 +168,7 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 
 	// totalLino := "10000000000"
 	genesisState := GenesisState{
+		Version:    GenesisStateVersion,
 		Accounts:   []GenesisAccount{},
 		Developers: []GenesisAppDeveloper{},
 		Infra:      []GenesisInfraProvider{},
@@ -187,6 +215,7 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 				ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 				ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+				FailedEventMaxRetries:     3,
 			},
 			param.DeveloperParam{
 				DeveloperMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
@@ -204,6 +233,9 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
 				ValidatorListSize:              int64(21),
 				AbsentCommitLimitation:         int64(600), // 10min
+				ValidatorUnbondingPeriodSec:    int64(0),
+				SignedBlocksWindow:             int64(1200), // 1hr
+				MinSignedPerWindow:             sdk.NewRat(1, 2),
 			},
 			param.CoinDayParam{
 				SecondsToRecoverCoinDay: int64(7 * 24 * 3600),
@@ -223,6 +255,7 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				ReportOrUpvoteIntervalSec: 24 * 3600,
 				PostIntervalSec:           600,
 				MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+				MaxNumOfPostVersions:      10,
 			},
 			param.ReputationParam{
 				BestContentIndexN: 10,
@@ -87,6 +87,7 @@ func TestGetGenesisJson(t *testing.T) {
 				ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 				ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 				ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+				FailedEventMaxRetries:     3,
 			},
 			param.DeveloperParam{
 				DeveloperMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
@@ -104,6 +105,8 @@ func TestGetGenesisJson(t *testing.T) {
 				PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
 				ValidatorListSize:              int64(21),
 				AbsentCommitLimitation:         int64(600), // 30min
+				SignedBlocksWindow:             int64(1200),
+				MinSignedPerWindow:             sdk.NewRat(1, 2),
 			},
 			param.CoinDayParam{
 				SecondsToRecoverCoinDay: int64(7 * 24 * 3600),
@@ -123,6 +126,7 @@ func TestGetGenesisJson(t *testing.T) {
 				ReportOrUpvoteIntervalSec: 24 * 3600,
 				PostIntervalSec:           600,
 				MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+				MaxNumOfPostVersions:      10,
 			},
 			param.ReputationParam{
 				BestContentIndexN: 10,
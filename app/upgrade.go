@@ -0,0 +1,11 @@
+package app
+
+// registerUpgradeHandlers - register store migrations of protocol upgrades.
+// A release introducing a protocol upgrade registers its migration steps here
+// under the name used in the upgrade proposal, e.g.
+//
+//	lb.upgradeRegistry.Register("upgrade-name", migrateStep1, migrateStep2)
+//
+// Nodes running a binary without the handler halt at the upgrade height.
+func (lb *LinoBlockchain) registerUpgradeHandlers() {
+}
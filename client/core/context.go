@@ -66,3 +66,9 @@ func (c CoreContext) WithPrivKey(privKey crypto.PrivKey) CoreContext {
 	c.PrivKey = privKey
 	return c
 }
+
+// WithMemo - mount memo on context
+func (c CoreContext) WithMemo(memo string) CoreContext {
+	c.Memo = memo
+	return c
+}
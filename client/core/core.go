@@ -2,13 +2,16 @@ package core
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/pkg/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	crypto "github.com/tendermint/tendermint/crypto"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
@@ -54,9 +57,21 @@ func (ctx CoreContext) QuerySubspace(cdc *wire.Codec, subspace []byte, storeName
 	return
 }
 
+// QueryCustom - query from Tendermint custom querier registered by module,
+// the path is "/custom/<module>/<endpoint>/<params>..."
+func (ctx CoreContext) QueryCustom(module, endpoint string, params ...string) (res []byte, err error) {
+	path := strings.Join(append([]string{"/custom", module, endpoint}, params...), "/")
+	return ctx.queryWithPath(path, nil)
+}
+
 // Query from Tendermint with the provided storename and path
 func (ctx CoreContext) query(key cmn.HexBytes, storeName, endPath string) (res []byte, err error) {
 	path := fmt.Sprintf("/store/%s/%s", storeName, endPath)
+	return ctx.queryWithPath(path, key)
+}
+
+// Query from Tendermint with the provided full path and data
+func (ctx CoreContext) queryWithPath(path string, key cmn.HexBytes) (res []byte, err error) {
 	node, err := ctx.GetNode()
 	if err != nil {
 		return res, err
@@ -79,6 +94,30 @@ func (ctx CoreContext) query(key cmn.HexBytes, storeName, endPath string) (res [
 
 // sign and build the transaction from the msg
 func (ctx CoreContext) SignAndBuild(msgs []sdk.Msg, cdc *wire.Codec) ([]byte, error) {
+	return ctx.signAndBuild(msgs, cdc, func(bz []byte) ([]byte, crypto.PubKey, error) {
+		if ctx.PrivKey == nil {
+			return nil, nil, errors.New("Must provide private key")
+		}
+		sig, err := ctx.PrivKey.Sign(bz)
+		if err != nil {
+			return nil, nil, err
+		}
+		return sig, ctx.PrivKey.PubKey(), nil
+	})
+}
+
+// SignAndBuildWithKeybase - sign and build the transaction from the msg with
+// the key stored under name in local keybase
+func (ctx CoreContext) SignAndBuildWithKeybase(
+	kb keys.Keybase, name, passphrase string, msgs []sdk.Msg, cdc *wire.Codec) ([]byte, error) {
+	return ctx.signAndBuild(msgs, cdc, func(bz []byte) ([]byte, crypto.PubKey, error) {
+		return kb.Sign(name, passphrase, bz)
+	})
+}
+
+// sign the message bytes with the given signer and marshal the standard tx
+func (ctx CoreContext) signAndBuild(
+	msgs []sdk.Msg, cdc *wire.Codec, sign func([]byte) ([]byte, crypto.PubKey, error)) ([]byte, error) {
 	// build the Sign Messsage from the Standard Message
 	chainID := ctx.ChainID
 	if chainID == "" {
@@ -94,16 +133,12 @@ func (ctx CoreContext) SignAndBuild(msgs []sdk.Msg, cdc *wire.Codec) ([]byte, er
 	}
 
 	// sign and build
-	bz := signMsg.Bytes()
-	if ctx.PrivKey == nil {
-		return nil, errors.New("Must provide private key")
-	}
-	sig, err := ctx.PrivKey.Sign(bz)
+	sig, pubKey, err := sign(signMsg.Bytes())
 	if err != nil {
 		return nil, err
 	}
 	sigs := []auth.StdSignature{{
-		PubKey:    ctx.PrivKey.PubKey(),
+		PubKey:    pubKey,
 		Signature: sig,
 		Sequence:  sequence,
 	}}
@@ -123,6 +158,18 @@ func (ctx CoreContext) SignBuildBroadcast(
 	return ctx.BroadcastTx(txBytes)
 }
 
+// SignBuildBroadcastWithKeybase - sign the msg with key in local keybase, then
+// build and broadcast the transaction
+func (ctx CoreContext) SignBuildBroadcastWithKeybase(
+	kb keys.Keybase, name, passphrase string,
+	msgs []sdk.Msg, cdc *wire.Codec) (*ctypes.ResultBroadcastTxCommit, error) {
+	txBytes, err := ctx.SignAndBuildWithKeybase(kb, name, passphrase, msgs, cdc)
+	if err != nil {
+		return nil, err
+	}
+	return ctx.BroadcastTx(txBytes)
+}
+
 // get passphrase from std input
 func (ctx CoreContext) GetPassphraseFromStdin(name string) (pass string, err error) {
 	buf := client.BufferStdin()
@@ -23,6 +23,24 @@ const (
 	FlagAmount   = "amount"
 	FlagMemo     = "memo"
 
+	// Key rotation
+	FlagGracePeriod = "grace-period"
+
+	// Scheduled transfer
+	FlagStartTime  = "start-time"
+	FlagInterval   = "interval"
+	FlagTimes      = "times"
+	FlagScheduleID = "schedule-id"
+
+	// History
+	FlagStart      = "start"
+	FlagEnd        = "end"
+	FlagDetailType = "detail-type"
+	FlagOffset     = "offset"
+	FlagLimit      = "limit"
+	FlagAll        = "all"
+	FlagFormat     = "format"
+
 	// Developer
 	FlagDeveloper   = "developer"
 	FlagDeposit     = "deposit"
@@ -36,8 +54,9 @@ const (
 	FlagGrantAmount = "grant-amount"
 
 	// Infra
-	FlagProvider = "provider"
-	FlagUsage    = "usage"
+	FlagProvider      = "provider"
+	FlagUsage         = "usage"
+	FlagContentHashes = "content-hashes"
 
 	// Post
 	FlagDonator                 = "donator"
@@ -52,12 +71,33 @@ const (
 	FlagSourceAuthor            = "source-author"
 	FlagSourcePostID            = "source-post-ID"
 	FlagRedistributionSplitRate = "redistribution-split-rate"
+	FlagTags                    = "tags"
+	FlagSort                    = "sort"
+	FlagDepth                   = "depth"
+	FlagCursor                  = "cursor"
+	FlagContentHash             = "content-hash"
+	FlagContentSize             = "content-size"
+	FlagContentMIMEType         = "content-mime-type"
+	FlagContentURI              = "content-uri"
+	FlagPrice                   = "price"
+	FlagEncryptedContentHash    = "encrypted-content-hash"
+	FlagEncryptedContentSize    = "encrypted-content-size"
+	FlagEncryptedContentMIME    = "encrypted-content-mime-type"
+	FlagEncryptedContentURI     = "encrypted-content-uri"
+	FlagCoAuthors               = "co-authors"
 
 	// Vote
 	FlagVoter      = "voter"
 	FlagProposalID = "proposal-id"
 	FlagResult     = "result"
 	FlagLink       = "link"
+	FlagEventID    = "event-id"
+	FlagReplay     = "replay"
+	FlagReason     = "reason"
+
+	// Validator
+	FlagCommissionRate          = "commission-rate"
+	FlagMaxCommissionChangeRate = "max-commission-change-rate"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -0,0 +1,184 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/lino-network/lino/client/core"
+	"github.com/lino-network/lino/types"
+
+	acc "github.com/lino-network/lino/x/account"
+	"github.com/lino-network/lino/x/developer"
+	"github.com/lino-network/lino/x/infra"
+	"github.com/lino-network/lino/x/post"
+	"github.com/lino-network/lino/x/proposal"
+	val "github.com/lino-network/lino/x/validator"
+	"github.com/lino-network/lino/x/vote"
+)
+
+// queryParam - resolve one custom query parameter from the route variables
+type queryParam func(vars map[string]string) string
+
+// routeVar - use the route variable as query parameter
+func routeVar(name string) queryParam {
+	return func(vars map[string]string) string {
+		return vars[name]
+	}
+}
+
+// permlinkVar - build the permlink from author and post id route variables
+func permlinkVar(author, postID string) queryParam {
+	return func(vars map[string]string) string {
+		return string(types.GetPermlink(types.AccountKey(vars[author]), vars[postID]))
+	}
+}
+
+// queryHandler - forward the request to the custom querier registered under
+// module, the querier result is already JSON encoded
+func queryHandler(ctx core.CoreContext, module, endpoint string, params ...queryParam) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		queryParams := make([]string, len(params))
+		for i, param := range params {
+			queryParams[i] = param(vars)
+		}
+		res, err := ctx.QueryCustom(module, endpoint, queryParams...)
+		if err != nil {
+			writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSONResponse(w, res)
+	}
+}
+
+func registerQueryRoutes(ctx core.CoreContext, r *mux.Router) {
+	username := routeVar("username")
+	permlink := permlinkVar("author", "postID")
+
+	// account
+	r.HandleFunc("/accounts/{offset:[0-9]+}/{limit:[0-9]+}", queryHandler(
+		ctx, types.AccountQuerierRoute, acc.QueryAllAccountInfo,
+		routeVar("offset"), routeVar("limit"))).Methods("GET")
+	r.HandleFunc("/accounts/{username}/info", queryHandler(
+		ctx, types.AccountQuerierRoute, acc.QueryAccountInfo, username)).Methods("GET")
+	r.HandleFunc("/accounts/{username}/bank", queryHandler(
+		ctx, types.AccountQuerierRoute, acc.QueryAccountBank, username)).Methods("GET")
+	r.HandleFunc("/accounts/{username}/meta", queryHandler(
+		ctx, types.AccountQuerierRoute, acc.QueryAccountMeta, username)).Methods("GET")
+	r.HandleFunc("/accounts/{username}/reward", queryHandler(
+		ctx, types.AccountQuerierRoute, acc.QueryAccountReward, username)).Methods("GET")
+	r.HandleFunc("/accounts/{username}/balance_history/{bucket}", queryHandler(
+		ctx, types.AccountQuerierRoute, acc.QueryAccountBalanceHistory,
+		username, routeVar("bucket"))).Methods("GET")
+	r.HandleFunc("/accounts/{username}/reward_history/{bucket}", queryHandler(
+		ctx, types.AccountQuerierRoute, acc.QueryAccountRewardHistory,
+		username, routeVar("bucket"))).Methods("GET")
+	r.HandleFunc("/accounts/{username}/followers", queryHandler(
+		ctx, types.AccountQuerierRoute, acc.QueryAccountFollowers, username)).Methods("GET")
+	r.HandleFunc("/accounts/{username}/followings", queryHandler(
+		ctx, types.AccountQuerierRoute, acc.QueryAccountFollowings, username)).Methods("GET")
+	r.HandleFunc("/accounts/{username}/key_history", queryHandler(
+		ctx, types.AccountQuerierRoute, acc.QueryAccountKeyHistory, username)).Methods("GET")
+	r.HandleFunc("/accounts/{username}/scheduled_transfers", queryHandler(
+		ctx, types.AccountQuerierRoute, acc.QueryAccountScheduledTransfers, username)).Methods("GET")
+	r.HandleFunc("/accounts/{username}/posts", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPostsByAuthor, username)).Methods("GET")
+	r.HandleFunc("/accounts/{username}/pending_rewards", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPendingRewardsByAuthor, username)).Methods("GET")
+
+	// post
+	r.HandleFunc("/posts/{author}/{postID}/info", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPostInfo, permlink)).Methods("GET")
+	r.HandleFunc("/posts/{author}/{postID}/meta", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPostMeta, permlink)).Methods("GET")
+	r.HandleFunc("/posts/{author}/{postID}/comments/{commentAuthor}/{commentPostID}", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPostComment,
+		permlink, permlinkVar("commentAuthor", "commentPostID"))).Methods("GET")
+	r.HandleFunc("/posts/{author}/{postID}/donations/{username}", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPostDonations, permlink, username)).Methods("GET")
+	r.HandleFunc("/posts/{author}/{postID}/views/{username}", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPostView, permlink, username)).Methods("GET")
+	r.HandleFunc("/posts/{author}/{postID}/donation_estimate/{username}/{amount}", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryDonationEstimate,
+		username, routeVar("author"), routeVar("postID"), routeVar("amount"))).Methods("GET")
+	r.HandleFunc("/posts/{author}/{postID}/pending_rewards", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPendingRewards, permlink)).Methods("GET")
+	r.HandleFunc("/posts/{author}/{postID}/versions", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPostVersions, permlink)).Methods("GET")
+	r.HandleFunc("/posts/{author}/{postID}/versions/{version}", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPostVersion, permlink, routeVar("version"))).Methods("GET")
+	r.HandleFunc("/posts/{author}/{postID}/paywall", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPostPaywall, permlink)).Methods("GET")
+	r.HandleFunc("/posts/{author}/{postID}/unlocks/{username}", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPostUnlock, permlink, username)).Methods("GET")
+	r.HandleFunc("/posts/{author}/{postID}/co_authors", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPostCoAuthors, permlink)).Methods("GET")
+	r.HandleFunc("/posts/{author}/{postID}/comment_tree/{sort}/{depth}/{limit}", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryCommentTree,
+		permlink, routeVar("sort"), routeVar("depth"), routeVar("limit"))).Methods("GET")
+	r.HandleFunc("/posts/{author}/{postID}/comment_tree/{sort}/{depth}/{limit}/{cursorAuthor}/{cursorPostID}", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryCommentTree,
+		permlink, routeVar("sort"), routeVar("depth"), routeVar("limit"),
+		permlinkVar("cursorAuthor", "cursorPostID"))).Methods("GET")
+	r.HandleFunc("/tags/{tag}/posts/{offset}/{limit}", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPostsByTag,
+		routeVar("tag"), routeVar("offset"), routeVar("limit"))).Methods("GET")
+	r.HandleFunc("/contents/{hash}/posts", queryHandler(
+		ctx, types.PostQuerierRoute, post.QueryPostsByContentHash, routeVar("hash"))).Methods("GET")
+
+	// vote
+	r.HandleFunc("/voters/{username}", queryHandler(
+		ctx, types.VoteQuerierRoute, vote.QueryVoter, username)).Methods("GET")
+	r.HandleFunc("/voters/{username}/delegators", queryHandler(
+		ctx, types.VoteQuerierRoute, vote.QueryAllDelegators, username)).Methods("GET")
+	r.HandleFunc("/voters/{username}/delegations/{delegator}", queryHandler(
+		ctx, types.VoteQuerierRoute, vote.QueryDelegation,
+		username, routeVar("delegator"))).Methods("GET")
+	r.HandleFunc("/delegators/{username}/reward", queryHandler(
+		ctx, types.VoteQuerierRoute, vote.QueryDelegatorReward, username)).Methods("GET")
+
+	// validator
+	r.HandleFunc("/validators", queryHandler(
+		ctx, types.ValidatorQuerierRoute, val.QueryValidatorList)).Methods("GET")
+	r.HandleFunc("/validators/{username}", queryHandler(
+		ctx, types.ValidatorQuerierRoute, val.QueryValidator, username)).Methods("GET")
+	r.HandleFunc("/validators/{username}/commission", queryHandler(
+		ctx, types.ValidatorQuerierRoute, val.QueryCommission, username)).Methods("GET")
+	r.HandleFunc("/validators/{username}/punishments", queryHandler(
+		ctx, types.ValidatorQuerierRoute, val.QueryPunishmentHistory, username)).Methods("GET")
+	r.HandleFunc("/validators/{username}/uptime", queryHandler(
+		ctx, types.ValidatorQuerierRoute, val.QueryUptime, username)).Methods("GET")
+	r.HandleFunc("/jailed_validators", queryHandler(
+		ctx, types.ValidatorQuerierRoute, val.QueryJailedValidators)).Methods("GET")
+
+	// developer
+	r.HandleFunc("/developers", queryHandler(
+		ctx, types.DeveloperQuerierRoute, developer.QueryDeveloperList)).Methods("GET")
+	r.HandleFunc("/developers/{username}", queryHandler(
+		ctx, types.DeveloperQuerierRoute, developer.QueryDeveloper, username)).Methods("GET")
+
+	// infra
+	r.HandleFunc("/infra_providers", queryHandler(
+		ctx, types.InfraQuerierRoute, infra.QueryInfraProviderList)).Methods("GET")
+	r.HandleFunc("/infra_providers/{username}", queryHandler(
+		ctx, types.InfraQuerierRoute, infra.QueryInfraProvider, username)).Methods("GET")
+	r.HandleFunc("/infra_providers/{username}/served_contents", queryHandler(
+		ctx, types.InfraQuerierRoute, infra.QueryServedContents, username)).Methods("GET")
+
+	// proposal
+	r.HandleFunc("/proposals/ongoing", queryHandler(
+		ctx, types.ProposalQuerierRoute, proposal.QueryOngoingProposalList)).Methods("GET")
+	r.HandleFunc("/proposals/ongoing/{proposalID}", queryHandler(
+		ctx, types.ProposalQuerierRoute, proposal.QueryOngoingProposal,
+		routeVar("proposalID"))).Methods("GET")
+	r.HandleFunc("/proposals/expired", queryHandler(
+		ctx, types.ProposalQuerierRoute, proposal.QueryExpiredProposalList)).Methods("GET")
+	r.HandleFunc("/proposals/expired/{proposalID}", queryHandler(
+		ctx, types.ProposalQuerierRoute, proposal.QueryExpiredProposal,
+		routeVar("proposalID"))).Methods("GET")
+	r.HandleFunc("/proposals/{proposalID}/votes", queryHandler(
+		ctx, types.VoteQuerierRoute, vote.QueryAllVotes, routeVar("proposalID"))).Methods("GET")
+	r.HandleFunc("/proposals/{proposalID}/votes/{username}", queryHandler(
+		ctx, types.VoteQuerierRoute, vote.QueryVote,
+		routeVar("proposalID"), username)).Methods("GET")
+}
@@ -0,0 +1,79 @@
+package rest
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/gorilla/mux"
+	"github.com/lino-network/lino/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	tmserver "github.com/tendermint/tendermint/rpc/lib/server"
+)
+
+// nolint
+const (
+	flagListenAddr         = "laddr"
+	flagMaxOpenConnections = "max-open"
+)
+
+// ServeCommand - create the rest-server command which serves Lino queries
+// and transactions over HTTP
+func ServeCommand(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rest-server",
+		Short: "Start a local REST server for Lino blockchain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			listenAddr := viper.GetString(flagListenAddr)
+			handler := createHandler(cdc)
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "rest-server")
+			maxOpen := viper.GetInt(flagMaxOpenConnections)
+
+			listener, err := tmserver.StartHTTPServer(
+				listenAddr, handler, logger,
+				tmserver.Config{MaxOpenConnections: maxOpen},
+			)
+			if err != nil {
+				return err
+			}
+			logger.Info("REST server started")
+
+			// wait forever and cleanup
+			cmn.TrapSignal(func() {
+				if err := listener.Close(); err != nil {
+					logger.Error("error closing listener", "err", err)
+				}
+			})
+			return nil
+		},
+	}
+	cmd.Flags().String(flagListenAddr, "tcp://localhost:1317", "The address for the server to listen on")
+	cmd.Flags().Int(flagMaxOpenConnections, 1000, "The number of maximum open connections")
+	cmd.Flags().String(client.FlagChainID, "", "Chain ID of tendermint node")
+	cmd.Flags().String(client.FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
+	cmd.Flags().Bool(client.FlagTrustNode, true, "Don't verify proofs for responses")
+	return cmd
+}
+
+func createHandler(cdc *wire.Codec) http.Handler {
+	r := mux.NewRouter()
+	ctx := client.NewCoreContextFromViper()
+
+	registerQueryRoutes(ctx, r)
+	registerTxRoutes(ctx, cdc, r)
+	return r
+}
+
+func writeErrorResponse(w http.ResponseWriter, status int, msg string) {
+	w.WriteHeader(status)
+	w.Write([]byte(msg))
+}
+
+func writeJSONResponse(w http.ResponseWriter, output []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(output)
+}
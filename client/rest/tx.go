@@ -0,0 +1,170 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/gorilla/mux"
+	"github.com/lino-network/lino/client/core"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	acc "github.com/lino-network/lino/x/account"
+	"github.com/lino-network/lino/x/developer"
+	"github.com/lino-network/lino/x/infra"
+	"github.com/lino-network/lino/x/post"
+	"github.com/lino-network/lino/x/proposal"
+	val "github.com/lino-network/lino/x/validator"
+	"github.com/lino-network/lino/x/vote"
+)
+
+// msgTypes - all messages which can be broadcast through POST /txs/{type}
+var msgTypes = map[string]sdk.Msg{
+	// account
+	"register":                acc.RegisterMsg{},
+	"follow":                  acc.FollowMsg{},
+	"unfollow":                acc.UnfollowMsg{},
+	"transfer":                acc.TransferMsg{},
+	"claim":                   acc.ClaimMsg{},
+	"recover":                 acc.RecoverMsg{},
+	"updateAcc":               acc.UpdateAccountMsg{},
+	"addCoSigner":             acc.AddCoSignerMsg{},
+	"removeCoSigner":          acc.RemoveCoSignerMsg{},
+	"changeThreshold":         acc.ChangeThresholdMsg{},
+	"updateTxKey":             acc.UpdateTransactionKeyMsg{},
+	"updateAppKey":            acc.UpdateAppKeyMsg{},
+	"createScheduledTransfer": acc.CreateScheduledTransferMsg{},
+	"cancelScheduledTransfer": acc.CancelScheduledTransferMsg{},
+
+	// post
+	"createPost":     post.CreatePostMsg{},
+	"updatePost":     post.UpdatePostMsg{},
+	"deletePost":     post.DeletePostMsg{},
+	"donate":         post.DonateMsg{},
+	"view":           post.ViewMsg{},
+	"reportOrUpvote": post.ReportOrUpvoteMsg{},
+	"unlockPost":     post.UnlockPostMsg{},
+	"acceptCoAuthor": post.AcceptCoAuthorMsg{},
+
+	// developer
+	"devRegister":                developer.DeveloperRegisterMsg{},
+	"devUpdate":                  developer.DeveloperUpdateMsg{},
+	"devRevoke":                  developer.DeveloperRevokeMsg{},
+	"grantPermission":            developer.GrantPermissionMsg{},
+	"revokePermission":           developer.RevokePermissionMsg{},
+	"preAuthorizationPermission": developer.PreAuthorizationMsg{},
+
+	// infra
+	"providerReport": infra.ProviderReportMsg{},
+
+	// vote
+	"stakeIn":              vote.StakeInMsg{},
+	"stakeOut":             vote.StakeOutMsg{},
+	"delegate":             vote.DelegateMsg{},
+	"delegateWithdraw":     vote.DelegatorWithdrawMsg{},
+	"claimInterest":        vote.ClaimInterestMsg{},
+	"claimDelegatorReward": vote.ClaimDelegatorRewardMsg{},
+
+	// validator
+	"valDeposit":          val.ValidatorDepositMsg{},
+	"valWithdraw":         val.ValidatorWithdrawMsg{},
+	"valRevoke":           val.ValidatorRevokeMsg{},
+	"valUpdateCommission": val.ValidatorUpdateCommissionMsg{},
+	"valUnjail":           val.ValidatorUnjailMsg{},
+
+	// proposal
+	"voteProposal":           proposal.VoteProposalMsg{},
+	"deletePostContent":      proposal.DeletePostContentMsg{},
+	"upgradeProtocol":        proposal.UpgradeProtocolMsg{},
+	"recoverFailedEvent":     proposal.RecoverFailedEventMsg{},
+	"changeGlobalAllocation": proposal.ChangeGlobalAllocationParamMsg{},
+	"changeEvaluation":       proposal.ChangeEvaluateOfContentValueParamMsg{},
+	"changeInfraAllocation":  proposal.ChangeInfraInternalAllocationParamMsg{},
+	"changeVoteParam":        proposal.ChangeVoteParamMsg{},
+	"changeProposalParam":    proposal.ChangeProposalParamMsg{},
+	"changeDeveloperParam":   proposal.ChangeDeveloperParamMsg{},
+	"changeValidatorParam":   proposal.ChangeValidatorParamMsg{},
+	"changeBandwidthParam":   proposal.ChangeBandwidthParamMsg{},
+	"changeAccountParam":     proposal.ChangeAccountParamMsg{},
+	"changePostParam":        proposal.ChangePostParamMsg{},
+}
+
+// BroadcastTxBody - request body of sign and broadcast endpoint, the msg is
+// the codec JSON of the message, e.g. {"type": "lino/transfer", "value": {...}}
+type BroadcastTxBody struct {
+	Name     string          `json:"name"`
+	Password string          `json:"password"`
+	ChainID  string          `json:"chain_id"`
+	Sequence int64           `json:"sequence"`
+	Memo     string          `json:"memo"`
+	Msg      json.RawMessage `json:"msg"`
+}
+
+func registerTxRoutes(ctx core.CoreContext, cdc *wire.Codec, r *mux.Router) {
+	r.HandleFunc("/txs/{type}", signAndBroadcastHandler(ctx, cdc)).Methods("POST")
+}
+
+// signAndBroadcastHandler - sign the msg with key in local keybase and
+// broadcast the transaction to Tendermint
+func signAndBroadcastHandler(ctx core.CoreContext, cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		msgType := mux.Vars(r)["type"]
+		expectMsg, ok := msgTypes[msgType]
+		if !ok {
+			writeErrorResponse(w, http.StatusNotFound, fmt.Sprintf("unknown msg type: %s", msgType))
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var req BroadcastTxBody
+		if err := json.Unmarshal(body, &req); err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var msg sdk.Msg
+		if err := cdc.UnmarshalJSON(req.Msg, &msg); err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if reflect.TypeOf(msg) != reflect.TypeOf(expectMsg) {
+			writeErrorResponse(w, http.StatusBadRequest,
+				fmt.Sprintf("msg doesn't match type %s", msgType))
+			return
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		kb, err := keys.GetKeyBase()
+		if err != nil {
+			writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		txCtx := ctx.WithSequence(req.Sequence).WithMemo(req.Memo)
+		if req.ChainID != "" {
+			txCtx = txCtx.WithChainID(req.ChainID)
+		}
+		res, err := txCtx.SignBuildBroadcastWithKeybase(
+			kb, req.Name, req.Password, []sdk.Msg{msg}, cdc)
+		if err != nil {
+			writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		output, err := cdc.MarshalJSON(res)
+		if err != nil {
+			writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSONResponse(w, output)
+	}
+}
@@ -4,18 +4,19 @@ import (
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/client/keys"
-	"github.com/cosmos/cosmos-sdk/client/lcd"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/lino-network/lino/app"
 	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/client/rest"
 	"github.com/lino-network/lino/types"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/cli"
 
 	acccmd "github.com/lino-network/lino/x/account/commands"
 	developercmd "github.com/lino-network/lino/x/developer/commands"
+	globalcmd "github.com/lino-network/lino/x/global/commands"
 	infracmd "github.com/lino-network/lino/x/infra/commands"
 	postcmd "github.com/lino-network/lino/x/post/commands"
 	proposalcmd "github.com/lino-network/lino/x/proposal/commands"
@@ -57,10 +58,10 @@ func main() {
 
 	advancedCmd.AddCommand(
 		tendermintCmd,
-		lcd.ServeCommand(cdc),
 	)
 	linocliCmd.AddCommand(
 		advancedCmd,
+		rest.ServeCommand(cdc),
 		client.LineBreak,
 	)
 
@@ -72,10 +73,20 @@ func main() {
 		client.PostCommands(
 			acccmd.RecoverTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			acccmd.UpdateTransactionKeyTxCmd(cdc),
+			acccmd.UpdateAppKeyTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			acccmd.TransferTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			acccmd.CreateScheduledTransferTxCmd(cdc),
+			acccmd.CancelScheduledTransferTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			acccmd.FollowTxCmd(cdc),
@@ -100,6 +111,14 @@ func main() {
 		client.PostCommands(
 			postcmd.DonateTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			postcmd.UnlockPostTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			postcmd.AcceptCoAuthorTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			validatorcmd.DepositValidatorTxCmd(cdc),
@@ -112,6 +131,14 @@ func main() {
 		client.PostCommands(
 			validatorcmd.RevokeTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			validatorcmd.UpdateCommissionTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			validatorcmd.UnjailTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			delegationcmd.DelegateTxCmd(cdc),
@@ -120,9 +147,17 @@ func main() {
 		client.PostCommands(
 			delegationcmd.WithdrawDelegateTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			delegationcmd.ClaimDelegatorRewardTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			delegatecmd.GetDelegationCmd(types.VoteKVStoreKey, cdc),
+			delegatecmd.GetDelegationCmd(types.VoteQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			delegatecmd.GetDelegatorRewardCmd(types.VoteQuerierRoute, cdc),
 		)...)
 
 	linocliCmd.AddCommand(
@@ -135,25 +170,29 @@ func main() {
 		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			votecmd.GetVoterCmd(types.VoteKVStoreKey, cdc),
+			votecmd.GetVoterCmd(types.VoteQuerierRoute, cdc),
 		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			proposalcmd.GetOngoingProposalCmd(types.VoteKVStoreKey, cdc),
+			proposalcmd.GetOngoingProposalCmd(types.ProposalQuerierRoute, cdc),
 		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			proposalcmd.GetExpiredProposalCmd(types.VoteKVStoreKey, cdc),
+			proposalcmd.GetExpiredProposalCmd(types.ProposalQuerierRoute, cdc),
 		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			proposalcmd.VoteProposalTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			proposalcmd.RecoverFailedEventTxCmd(cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			votecmd.GetVoteCmd(types.VoteKVStoreKey, cdc),
+			votecmd.GetVoteCmd(types.VoteQuerierRoute, cdc),
 		)...)
 
 	linocliCmd.AddCommand(
@@ -187,50 +226,119 @@ func main() {
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			acccmd.GetBankCmd(types.AccountKVStoreKey, cdc),
+			acccmd.GetBankCmd(types.AccountQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			acccmd.GetAccountCmd(types.AccountQuerierRoute, cdc),
 		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			acccmd.GetAccountCmd(types.AccountKVStoreKey, cdc),
+			acccmd.GetAccountsCmd(types.AccountQuerierRoute, cdc),
 		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			acccmd.GetAccountsCmd(types.AccountKVStoreKey, cdc),
+			acccmd.GetBalanceHistoryCmd(types.AccountQuerierRoute, cdc),
 		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			postcmd.GetPostCmd(types.PostKVStoreKey, cdc),
+			acccmd.GetRewardHistoryCmd(types.AccountQuerierRoute, cdc),
 		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			postcmd.GetPostsCmd(types.PostKVStoreKey, cdc),
+			acccmd.GetScheduledTransfersCmd(types.AccountQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostCmd(types.PostQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostsCmd(types.PostQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetDonationEstimateCmd(types.PostQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPendingRewardsCmd(types.PostQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostsByTagCmd(types.PostQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetCommentTreeCmd(types.PostQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostVersionsCmd(types.PostQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostUnlockCmd(types.PostQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostCoAuthorsCmd(types.PostQuerierRoute, cdc),
 		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			infracmd.GetInfraProviderCmd(types.InfraKVStoreKey, cdc),
+			infracmd.GetInfraProviderCmd(types.InfraQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			infracmd.GetInfraProvidersCmd(types.InfraQuerierRoute, cdc),
 		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			infracmd.GetInfraProvidersCmd(types.InfraKVStoreKey, cdc),
+			infracmd.GetServedContentsCmd(types.InfraQuerierRoute, cdc),
 		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			developercmd.GetDeveloperCmd(types.DeveloperKVStoreKey, cdc),
+			developercmd.GetDeveloperCmd(types.DeveloperQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			developercmd.GetDevelopersCmd(types.DeveloperQuerierRoute, cdc),
+		)...)
+
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			validatorcmd.GetValidatorsCmd(types.ValidatorQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			validatorcmd.GetValidatorCmd(types.ValidatorQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			validatorcmd.GetCommissionCmd(types.ValidatorQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			validatorcmd.GetJailedValidatorsCmd(types.ValidatorQuerierRoute, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			validatorcmd.GetPunishmentHistoryCmd(types.ValidatorQuerierRoute, cdc),
 		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			developercmd.GetDevelopersCmd(types.DeveloperKVStoreKey, cdc),
+			validatorcmd.GetUptimeCmd(types.ValidatorQuerierRoute, cdc),
 		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			validatorcmd.GetValidatorsCmd(types.ValidatorKVStoreKey, cdc),
+			globalcmd.GetTimeEventQueueCmd(types.GlobalQuerierRoute, cdc),
 		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			validatorcmd.GetValidatorCmd(types.ValidatorKVStoreKey, cdc),
+			globalcmd.GetFailedEventsCmd(types.GlobalQuerierRoute, cdc),
 		)...)
 
 	// add proxy, version and key info
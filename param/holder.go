@@ -69,6 +69,7 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		ReportOrUpvoteIntervalSec: 24 * 3600,
 		PostIntervalSec:           600,
 		MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+		MaxNumOfPostVersions:      10,
 	}
 	if err := ph.setPostParam(ctx, postParam); err != nil {
 		return err
@@ -106,6 +107,9 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
 		ValidatorListSize:              int64(21),
 		AbsentCommitLimitation:         int64(600), // 30min
+		ValidatorUnbondingPeriodSec:    int64(0),
+		SignedBlocksWindow:             int64(1200), // 1hr
+		MinSignedPerWindow:             sdk.NewRat(1, 2),
 	}
 	if err := ph.setValidatorParam(ctx, validatorParam); err != nil {
 		return err
@@ -138,6 +142,7 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+		FailedEventMaxRetries:     3,
 	}
 	if err := ph.setProposalParam(ctx, proposalParam); err != nil {
 		return err
@@ -108,6 +108,8 @@ func TestValidatorParam(t *testing.T) {
 		PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
 		ValidatorListSize:              int64(21),
 		AbsentCommitLimitation:         int64(100),
+		SignedBlocksWindow:             int64(100),
+		MinSignedPerWindow:             sdk.NewRat(1, 2),
 	}
 	err := ph.setValidatorParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -154,6 +156,7 @@ func TestProposalParam(t *testing.T) {
 		ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+		FailedEventMaxRetries:     3,
 	}
 	err := ph.setProposalParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -255,6 +258,9 @@ func TestInitParam(t *testing.T) {
 		PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
 		ValidatorListSize:              int64(21),
 		AbsentCommitLimitation:         int64(600),
+		ValidatorUnbondingPeriodSec:    int64(0),
+		SignedBlocksWindow:             int64(1200),
+		MinSignedPerWindow:             sdk.NewRat(1, 2),
 	}
 
 	voteParam := VoteParam{
@@ -280,6 +286,7 @@ func TestInitParam(t *testing.T) {
 		ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+		FailedEventMaxRetries:     3,
 	}
 
 	coinDayParam := CoinDayParam{
@@ -300,6 +307,7 @@ func TestInitParam(t *testing.T) {
 		ReportOrUpvoteIntervalSec: int64(24 * 3600),
 		PostIntervalSec:           int64(600),
 		MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+		MaxNumOfPostVersions:      10,
 	}
 	checkStorage(t, ctx, ph, globalAllocationParam, infraInternalAllocationParam,
 		evaluateOfContentValueParam, developerParam, validatorParam, voteParam,
@@ -348,6 +356,9 @@ func TestInitParamFromConfig(t *testing.T) {
 		PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
 		ValidatorListSize:              int64(21),
 		AbsentCommitLimitation:         int64(600),
+		ValidatorUnbondingPeriodSec:    int64(0),
+		SignedBlocksWindow:             int64(1200),
+		MinSignedPerWindow:             sdk.NewRat(1, 2),
 	}
 
 	voteParam := VoteParam{
@@ -373,6 +384,7 @@ func TestInitParamFromConfig(t *testing.T) {
 		ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+		FailedEventMaxRetries:     3,
 	}
 
 	coinDayParam := CoinDayParam{
@@ -393,6 +405,7 @@ func TestInitParamFromConfig(t *testing.T) {
 		ReportOrUpvoteIntervalSec: int64(24 * 3600),
 		PostIntervalSec:           int64(600),
 		MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+		MaxNumOfPostVersions:      10,
 	}
 	repParam := ReputationParam{
 		BestContentIndexN: 10,
@@ -68,6 +68,7 @@ type VoteParam struct {
 // ProtocolUpgradeMinDeposit - minimum deposit to propose protocol upgrade proposal
 // ProtocolUpgradePassRatio - upvote and downvote ratio for protocol upgrade proposal
 // ProtocolUpgradePassVotes - minimum voting power required to pass protocol upgrade proposal
+// FailedEventMaxRetries - times a failed time event is retried before waiting for proposal to drop or replay it
 type ProposalParam struct {
 	ContentCensorshipDecideSec  int64      `json:"content_censorship_decide_second"`
 	ContentCensorshipMinDeposit types.Coin `json:"content_censorship_min_deposit"`
@@ -82,6 +83,7 @@ type ProposalParam struct {
 	ProtocolUpgradeMinDeposit   types.Coin `json:"protocol_upgrade_min_deposit"`
 	ProtocolUpgradePassRatio    sdk.Rat    `json:"protocol_upgrade_pass_ratio"`
 	ProtocolUpgradePassVotes    types.Coin `json:"protocol_upgrade_pass_votes"`
+	FailedEventMaxRetries       int64      `json:"failed_event_max_retries"`
 }
 
 // DeveloperParam - developer parameters
@@ -106,7 +108,12 @@ type DeveloperParam struct {
 // PenaltyByzantine - when validator acts as byzantine (double sign, for example),
 // minus PenaltyByzantine amount of Coin from validator deposit
 // ValidatorListSize - size of oncall validator
-// AbsentCommitLimitation - absent block limitation till penalty
+// AbsentCommitLimitation - absent block limitation till penalty, only used if SignedBlocksWindow is 0
+// ValidatorUnbondingPeriodSec - withdrawn deposit is still slashable for byzantine behavior
+// in this period before returning to validator, 0 means returning immediately
+// SignedBlocksWindow - number of recent blocks in which validator signing is tracked
+// MinSignedPerWindow - when signed ratio in a full window falls below it, minus PenaltyMissCommit
+// amount of Coin from validator deposit
 type ValidatorParam struct {
 	ValidatorMinWithdraw           types.Coin `json:"validator_min_withdraw"`
 	ValidatorMinVotingDeposit      types.Coin `json:"validator_min_voting_deposit"`
@@ -118,6 +125,9 @@ type ValidatorParam struct {
 	PenaltyByzantine               types.Coin `json:"penalty_byzantine"`
 	ValidatorListSize              int64      `json:"validator_list_size"`
 	AbsentCommitLimitation         int64      `json:"absent_commit_limitation"`
+	ValidatorUnbondingPeriodSec    int64      `json:"validator_unbonding_period_second"`
+	SignedBlocksWindow             int64      `json:"signed_blocks_window"`
+	MinSignedPerWindow             sdk.Rat    `json:"min_signed_per_window"`
 }
 
 // CoinDayParam - coin day parameters
@@ -150,10 +160,12 @@ type AccountParam struct {
 // PostParam - post parameters
 // ReportOrUpvoteIntervalSec - report interval second
 // PostIntervalSec - post interval second
+// MaxNumOfPostVersions - max number of previous versions retained for each post
 type PostParam struct {
 	ReportOrUpvoteIntervalSec int64      `json:"report_or_upvote_interval_second"`
 	PostIntervalSec           int64      `json:"post_interval_sec"`
 	MaxReportReputation       types.Coin `json:"max_report_reputation"`
+	MaxNumOfPostVersions      int64      `json:"max_num_of_post_versions"`
 }
 
 // BestContentIndexN - hard cap of how many content can be indexed every round.
@@ -41,6 +41,15 @@ type IDToURLMapping struct {
 	URL        string `json:"url"`
 }
 
+// ContentReference - content addressed reference to post content stored off chain.
+// Hash is hex encoded sha256 of the content, Size is the content size in bytes
+type ContentReference struct {
+	Hash     string `json:"hash"`
+	Size     int64  `json:"size"`
+	MIMEType string `json:"mime_type"`
+	URI      string `json:"uri"`
+}
+
 // PenaltyList - get validator who doesn't vote for proposal
 type PenaltyList struct {
 	PenaltyList []AccountKey `json:"penalty_list"`
@@ -55,3 +64,16 @@ func FindAccountInList(me AccountKey, lst []AccountKey) int {
 	}
 	return -1
 }
+
+// IsValidContentHash - check content hash is a lower case hex encoded sha256 hash
+func IsValidContentHash(hash string) bool {
+	if len(hash) != ContentHashLength {
+		return false
+	}
+	for _, c := range hash {
+		if !(c >= '0' && c <= '9') && !(c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
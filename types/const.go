@@ -26,6 +26,17 @@ const (
 	DeveloperRouterName = "developer"
 	ProposalRouterName  = "proposal"
 
+	// QuerierRoute for custom query routing in app
+	AccountQuerierRoute    = "account"
+	PostQuerierRoute       = "post"
+	ValidatorQuerierRoute  = "validator"
+	VoteQuerierRoute       = "vote"
+	InfraQuerierRoute      = "infra"
+	DeveloperQuerierRoute  = "developer"
+	ProposalQuerierRoute   = "proposal"
+	GlobalQuerierRoute     = "global"
+	ReputationQuerierRoute = "reputation"
+
 	// Different permission level for msg
 	UnknownPermission          = Permission(0)
 	AppPermission              = Permission(1)
@@ -40,9 +51,10 @@ const (
 	ProposalRevoked = ProposalResult(2)
 
 	// Different proposal types
-	ChangeParam       = ProposalType(0)
-	ContentCensorship = ProposalType(1)
-	ProtocolUpgrade   = ProposalType(2)
+	ChangeParam         = ProposalType(0)
+	ContentCensorship   = ProposalType(1)
+	ProtocolUpgrade     = ProposalType(2)
+	FailedEventRecovery = ProposalType(3)
 
 	// Different donation types
 	DirectDeposit = DonationType(0)
@@ -63,6 +75,7 @@ const (
 	ProposalReturnCoin   = TransferDetailType(11)
 	GenesisCoin          = TransferDetailType(12)
 	ClaimInterest        = TransferDetailType(13)
+	ClaimDelegatorReward = TransferDetailType(14)
 
 	// Different possible outcomes
 	TransferOut      = TransferDetailType(20)
@@ -74,6 +87,9 @@ const (
 	InfraDeposit     = TransferDetailType(26)
 	ProposalDeposit  = TransferDetailType(27)
 
+	// Records which don't change balance
+	ScheduledTransferFailed = TransferDetailType(40)
+
 	// punishment type
 	UnknownPunish      = PunishType(0)
 	PunishByzantine    = PunishType(1)
@@ -138,6 +154,49 @@ const (
 	// MaximumNumOfLinks - maximum number of links per post
 	MaximumNumOfLinks = 10
 
+	// MaximumNumOfTags - maximum number of tags per post
+	MaximumNumOfTags = 5
+
+	// MaximumLengthOfTag - maximum length of post tag
+	MaximumLengthOfTag = 30
+
+	// MaximumPostsByTagPageSize - max number of posts returned in one page of tag query
+	MaximumPostsByTagPageSize = 100
+
+	// MaximumAccountInfoPageSize - max number of accounts returned in one page of account list query
+	MaximumAccountInfoPageSize = 100
+
+	// MaximumTimeEventQueuePageSize - max number of time event lists returned in one time event queue query
+	MaximumTimeEventQueuePageSize = 100
+
+	// MaximumCommentTreeDepth - max depth of nested replies returned in comment tree query
+	MaximumCommentTreeDepth = 5
+
+	// MaximumCommentTreePageSize - max number of comments returned in one level of comment tree query
+	MaximumCommentTreePageSize = 50
+
+	// MaximumCommentTreeNodes - max number of comments loaded in one comment tree query,
+	// root with more direct comments is rejected and replies which don't fit are only counted
+	MaximumCommentTreeNodes = 1000
+
+	// MaximumNumOfCoAuthors - maximum number of co-authors per post, including the author
+	MaximumNumOfCoAuthors = 5
+
+	// ContentHashLength - length of hex encoded sha256 hash of off-chain post content
+	ContentHashLength = 64
+
+	// MaximumContentReferenceSize - maximum size in bytes of off-chain post content
+	MaximumContentReferenceSize = 100 * 1024 * 1024
+
+	// MaximumLengthOfMIMEType - maximum length of MIME type of off-chain post content
+	MaximumLengthOfMIMEType = 100
+
+	// MaximumLengthOfContentURI - maximum length of storage URI of off-chain post content
+	MaximumLengthOfContentURI = 200
+
+	// MaximumNumOfServedContents - maximum number of served contents in one provider report
+	MaximumNumOfServedContents = 50
+
 	// MaximumLengthOfDeveloperWebsite - maximum length of developer website
 	MaximumLengthOfDeveloperWebsite = 100
 
@@ -150,6 +209,9 @@ const (
 	// MaximumLengthOfProposalReason - maximum length of proposal reason
 	MaximumLengthOfProposalReason = 1000
 
+	// MaximumLengthOfUpgradeName - maximum length of protocol upgrade name
+	MaximumLengthOfUpgradeName = 50
+
 	// InitAccountWithFullCoinDayMemo - init account with full coin day memo
 	InitAccountWithFullCoinDayMemo = "open account deposit"
 
@@ -165,6 +227,38 @@ const (
 	// RewardHistoryBundleSize - bundle size for reward history
 	RewardHistoryBundleSize = 100
 
+	// MaximumHistoryPageSize - max number of details returned in one history page
+	MaximumHistoryPageSize = 1000
+
+	// MaximumNumOfCoSigners - max number of co-signers of a multi-signature account
+	MaximumNumOfCoSigners = 10
+
+	// MaximumKeyGracePeriodSec - max seconds an old key keeps valid after key rotation
+	MaximumKeyGracePeriodSec = 7 * 24 * 3600
+
+	// MaximumNumOfScheduledTransfers - max number of active scheduled transfers of an account
+	MaximumNumOfScheduledTransfers = 20
+
+	// MaximumScheduledTransferTimes - max number of executions of one scheduled transfer
+	MaximumScheduledTransferTimes = 1000
+
+	// MinimumScheduledTransferIntervalSec - min interval between two executions of a recurring transfer
+	MinimumScheduledTransferIntervalSec = 3600
+
+	// CommissionChangeIntervalSec - min interval between two validator commission rate changes
+	CommissionChangeIntervalSec = 24 * 3600
+
+	// ValidatorJailDurationSec - validator jailed for missing commits can unjail after this period
+	ValidatorJailDurationSec = 24 * 3600
+
+	// MaximumSignedBlocksWindow - max number of recent blocks in which validator signing is tracked,
+	// missed blocks of the window are stored in signing info updated every block
+	MaximumSignedBlocksWindow = 10000
+
+	// ValidatorSetHistoryHeight - number of recent blocks whose validator sets are kept
+	// to match byzantine evidence, same as default max evidence age of tendermint
+	ValidatorSetHistoryHeight = 100000
+
 	// CoinDayRecordIntervalSec - coin day record in the same interval bucket will be merged
 	CoinDayRecordIntervalSec = 1200
 
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,3 +22,41 @@ func ErrInvalidCoins(msg string) sdk.Error {
 func ErrAmountOverflow() sdk.Error {
 	return NewError(CodeInvalidInt64Number, "coin amount can't be represented as an int64")
 }
+
+// ErrUnknownQueryPath - error if custom query path is not supported by querier
+func ErrUnknownQueryPath(path string) sdk.Error {
+	return NewError(CodeUnknownQueryPath, fmt.Sprintf("unknown query path: %s", path))
+}
+
+// ErrInvalidQueryParams - error if custom query parameters are invalid
+func ErrInvalidQueryParams(msg string) sdk.Error {
+	return NewError(CodeInvalidQueryParams, msg)
+}
+
+// ErrFailedToMarshalQueryResult - error if custom query result marshal failed
+func ErrFailedToMarshalQueryResult(err error) sdk.Error {
+	return NewError(CodeFailedToMarshal, fmt.Sprintf("failed to marshal query result: %s", err.Error()))
+}
+
+// CheckQueryParams - check number of custom query parameters and all of them are non-empty
+func CheckQueryParams(params []string, expected int) sdk.Error {
+	if len(params) != expected {
+		return ErrInvalidQueryParams(
+			fmt.Sprintf("expect %v query parameters, got %v", expected, len(params)))
+	}
+	for _, p := range params {
+		if len(p) == 0 {
+			return ErrInvalidQueryParams("query parameter can't be empty")
+		}
+	}
+	return nil
+}
+
+// MarshalQueryResult - marshal custom query result to JSON with given codec
+func MarshalQueryResult(cdc *wire.Codec, v interface{}) ([]byte, sdk.Error) {
+	res, err := cdc.MarshalJSON(v)
+	if err != nil {
+		return nil, ErrFailedToMarshalQueryResult(err)
+	}
+	return res, nil
+}
@@ -20,6 +20,9 @@ const (
 	CodeDeveloperNotFound   sdk.CodeType = 108
 	CodeInvalidCoins        sdk.CodeType = 109
 	CodeInvalidInt64Number  sdk.CodeType = 110
+	CodeUnknownQueryPath    sdk.CodeType = 111
+	CodeInvalidQueryParams  sdk.CodeType = 112
+	CodeUnknownEvent        sdk.CodeType = 113
 
 	// Lino authenticate errors reserve 150 ~ 199
 	CodeIncorrectStdTxType   sdk.CodeType = 150
@@ -100,6 +103,29 @@ const (
 	CodeGetLastPostAt                        sdk.CodeType = 360
 	CodeUpdateLastPostAt                     sdk.CodeType = 361
 	CodeFrozenMoneyListTooLong               sdk.CodeType = 362
+	CodeFailedToUnmarshalFollowerMeta        sdk.CodeType = 363
+	CodeFailedToUnmarshalFollowingMeta       sdk.CodeType = 364
+	CodeInvalidHistoryFilter                 sdk.CodeType = 365
+	CodeCoSignerAlreadyExist                 sdk.CodeType = 366
+	CodeCoSignerNotFound                     sdk.CodeType = 367
+	CodeInvalidThreshold                     sdk.CodeType = 368
+	CodeTooManyCoSigners                     sdk.CodeType = 369
+	CodeFailedToMarshalMultiSig              sdk.CodeType = 370
+	CodeFailedToUnmarshalMultiSig            sdk.CodeType = 371
+	CodeCheckMultiSigKey                     sdk.CodeType = 372
+	CodeInvalidCoSigner                      sdk.CodeType = 373
+	CodeFailedToMarshalRetiringKey           sdk.CodeType = 374
+	CodeFailedToUnmarshalRetiringKey         sdk.CodeType = 375
+	CodeFailedToMarshalKeyHistory            sdk.CodeType = 376
+	CodeFailedToUnmarshalKeyHistory          sdk.CodeType = 377
+	CodeInvalidGracePeriod                   sdk.CodeType = 378
+	CodeInvalidNewKey                        sdk.CodeType = 379
+	CodeScheduledTransferNotFound            sdk.CodeType = 380
+	CodeInvalidScheduledTransfer             sdk.CodeType = 381
+	CodeTooManyScheduledTransfers            sdk.CodeType = 382
+	CodeFailedToMarshalScheduledTransfer     sdk.CodeType = 383
+	CodeFailedToUnmarshalScheduledTransfer   sdk.CodeType = 384
+	CodeInvalidAccountInfoPage               sdk.CodeType = 385
 
 	// Lino post errors reserve 400 ~ 499
 	CodePostMetaNotFound                     sdk.CodeType = 400
@@ -143,16 +169,65 @@ const (
 	CodeCreatePostSourceInvalid              sdk.CodeType = 438
 	CodeGetSourcePost                        sdk.CodeType = 439
 	CodePostTooOften                         sdk.CodeType = 440
+	CodeFailedToMarshalPendingReward         sdk.CodeType = 441
+	CodeFailedToUnmarshalPendingReward       sdk.CodeType = 442
+	CodeFailedToMarshalTaggedPost            sdk.CodeType = 443
+	CodeFailedToUnmarshalTaggedPost          sdk.CodeType = 444
+	CodeTooManyTags                          sdk.CodeType = 445
+	CodeInvalidTag                           sdk.CodeType = 446
+	CodeInvalidPostsByTagPage                sdk.CodeType = 447
+	CodeInvalidCommentTreeFilter             sdk.CodeType = 448
+	CodeCommentCursorNotFound                sdk.CodeType = 449
+	CodePostVersionNotFound                  sdk.CodeType = 450
+	CodeFailedToMarshalPostVersion           sdk.CodeType = 451
+	CodeFailedToUnmarshalPostVersion         sdk.CodeType = 452
+	CodeInvalidContentReference              sdk.CodeType = 453
+	CodeInvalidPaidPost                      sdk.CodeType = 454
+	CodePostNotPaid                          sdk.CodeType = 455
+	CodePostAlreadyUnlocked                  sdk.CodeType = 456
+	CodeUnlockPriceMismatch                  sdk.CodeType = 457
+	CodeCannotUnlockOwnPost                  sdk.CodeType = 458
+	CodePostPaywallNotFound                  sdk.CodeType = 459
+	CodeFailedToMarshalPostPaywall           sdk.CodeType = 460
+	CodeFailedToUnmarshalPostPaywall         sdk.CodeType = 461
+	CodePostUnlockNotFound                   sdk.CodeType = 462
+	CodeFailedToMarshalPostUnlock            sdk.CodeType = 463
+	CodeFailedToUnmarshalPostUnlock          sdk.CodeType = 464
+	CodeInvalidCoAuthors                     sdk.CodeType = 465
+	CodeNotCoAuthor                          sdk.CodeType = 466
+	CodeCoAuthorAlreadyAccepted              sdk.CodeType = 467
+	CodePostCoAuthorsNotFound                sdk.CodeType = 468
+	CodeFailedToMarshalPostCoAuthors         sdk.CodeType = 469
+	CodeFailedToUnmarshalPostCoAuthors       sdk.CodeType = 470
+	CodeTooManyCommentsInTree                sdk.CodeType = 471
+	CodeUnlockPostIsDeleted                  sdk.CodeType = 472
 
 	// Lino validator errors reserve 500 ~ 599
-	CodeValidatorNotFound              sdk.CodeType = 500
-	CodeValidatorListNotFound          sdk.CodeType = 501
-	CodeFailedToMarshalValidator       sdk.CodeType = 502
-	CodeFailedToMarshalValidatorList   sdk.CodeType = 503
-	CodeFailedToUnmarshalValidator     sdk.CodeType = 504
-	CodeFailedToUnmarshalValidatorList sdk.CodeType = 505
-	CodeUnbalancedAccount              sdk.CodeType = 506
-	CodeValidatorPubKeyAlreadyExist    sdk.CodeType = 507
+	CodeValidatorNotFound                   sdk.CodeType = 500
+	CodeValidatorListNotFound               sdk.CodeType = 501
+	CodeFailedToMarshalValidator            sdk.CodeType = 502
+	CodeFailedToMarshalValidatorList        sdk.CodeType = 503
+	CodeFailedToUnmarshalValidator          sdk.CodeType = 504
+	CodeFailedToUnmarshalValidatorList      sdk.CodeType = 505
+	CodeUnbalancedAccount                   sdk.CodeType = 506
+	CodeValidatorPubKeyAlreadyExist         sdk.CodeType = 507
+	CodeInvalidCommissionRate               sdk.CodeType = 508
+	CodeCommissionAlreadyDeclared           sdk.CodeType = 509
+	CodeCommissionNotDeclared               sdk.CodeType = 510
+	CodeCommissionChangeTooLarge            sdk.CodeType = 511
+	CodeCommissionChangeTooOften            sdk.CodeType = 512
+	CodeFailedToMarshalCommission           sdk.CodeType = 513
+	CodeFailedToUnmarshalCommission         sdk.CodeType = 514
+	CodeValidatorNotJailed                  sdk.CodeType = 515
+	CodeValidatorStillJailed                sdk.CodeType = 516
+	CodeFailedToMarshalPunishmentHistory    sdk.CodeType = 517
+	CodeFailedToUnmarshalPunishmentHistory  sdk.CodeType = 518
+	CodeFailedToMarshalValidatorSetRecord   sdk.CodeType = 519
+	CodeFailedToUnmarshalValidatorSetRecord sdk.CodeType = 520
+	CodeFailedToMarshalUnbondingDeposit     sdk.CodeType = 521
+	CodeFailedToUnmarshalUnbondingDeposit   sdk.CodeType = 522
+	CodeFailedToMarshalSigningInfo          sdk.CodeType = 523
+	CodeFailedToUnmarshalSigningInfo        sdk.CodeType = 524
 
 	// Lino global errors reserve 600 ~ 699
 	CodeInfraInflationCoinConversion           sdk.CodeType = 600
@@ -181,22 +256,31 @@ const (
 	CodeLinoStakeStatisticNotFound             sdk.CodeType = 623
 	CodeFailedToUnmarshalLinoStakeStatistic    sdk.CodeType = 624
 	CodePastDayIsNegative                      sdk.CodeType = 625
+	CodeFailedEventNotFound                    sdk.CodeType = 626
+	CodeFailedToMarshalFailedEvent             sdk.CodeType = 627
+	CodeFailedToUnmarshalFailedEvent           sdk.CodeType = 628
 
 	// Vote errors reserve 700 ~ 799
-	CodeVoterNotFound                  sdk.CodeType = 700
-	CodeVoteNotFound                   sdk.CodeType = 701
-	CodeReferenceListNotFound          sdk.CodeType = 702
-	CodeDelegationNotFound             sdk.CodeType = 703
-	CodeFailedToMarshalVoter           sdk.CodeType = 704
-	CodeFailedToMarshalVote            sdk.CodeType = 705
-	CodeFailedToMarshalDelegation      sdk.CodeType = 706
-	CodeFailedToMarshalReferenceList   sdk.CodeType = 707
-	CodeFailedToUnmarshalVoter         sdk.CodeType = 708
-	CodeFailedToUnmarshalVote          sdk.CodeType = 709
-	CodeFailedToUnmarshalDelegation    sdk.CodeType = 710
-	CodeFailedToUnmarshalReferenceList sdk.CodeType = 711
-	CodeValidatorCannotRevoke          sdk.CodeType = 712
-	CodeVoteAlreadyExist               sdk.CodeType = 713
+	CodeVoterNotFound                    sdk.CodeType = 700
+	CodeVoteNotFound                     sdk.CodeType = 701
+	CodeReferenceListNotFound            sdk.CodeType = 702
+	CodeDelegationNotFound               sdk.CodeType = 703
+	CodeFailedToMarshalVoter             sdk.CodeType = 704
+	CodeFailedToMarshalVote              sdk.CodeType = 705
+	CodeFailedToMarshalDelegation        sdk.CodeType = 706
+	CodeFailedToMarshalReferenceList     sdk.CodeType = 707
+	CodeFailedToUnmarshalVoter           sdk.CodeType = 708
+	CodeFailedToUnmarshalVote            sdk.CodeType = 709
+	CodeFailedToUnmarshalDelegation      sdk.CodeType = 710
+	CodeFailedToUnmarshalReferenceList   sdk.CodeType = 711
+	CodeValidatorCannotRevoke            sdk.CodeType = 712
+	CodeVoteAlreadyExist                 sdk.CodeType = 713
+	CodeFailedToMarshalDelegatorReward   sdk.CodeType = 714
+	CodeFailedToUnmarshalDelegatorReward sdk.CodeType = 715
+	CodeFailedToMarshalRewardPool        sdk.CodeType = 716
+	CodeFailedToUnmarshalRewardPool      sdk.CodeType = 717
+	CodeFailedToMarshalRewardIndex       sdk.CodeType = 718
+	CodeFailedToUnmarshalRewardIndex     sdk.CodeType = 719
 
 	// Lino infra errors reserve 800 ~ 899
 	CodeInfraProviderNotFound              sdk.CodeType = 800
@@ -206,6 +290,11 @@ const (
 	CodeFailedToUnmarshalInfraProvider     sdk.CodeType = 804
 	CodeFailedToUnmarshalInfraProviderList sdk.CodeType = 805
 	CodeInvalidUsage                       sdk.CodeType = 806
+	CodeInvalidContentHash                 sdk.CodeType = 807
+	CodeTooManyServedContents              sdk.CodeType = 808
+	CodeContentNotReferenced               sdk.CodeType = 809
+	CodeFailedToMarshalServedContent       sdk.CodeType = 810
+	CodeFailedToUnmarshalServedContent     sdk.CodeType = 811
 
 	// Lino developer errors reserve 900 ~ 999
 	CodeDeveloperListNotFound          sdk.CodeType = 900
@@ -281,4 +370,13 @@ const (
 	CodeInvalidLink                     sdk.CodeType = 1115
 	CodeIllegalParameter                sdk.CodeType = 1116
 	CodeReasonTooLong                   sdk.CodeType = 1117
+	CodeInvalidUpgradeName              sdk.CodeType = 1118
+	CodeInvalidUpgradeHeight            sdk.CodeType = 1119
+	CodeUpgradeHeightPassed             sdk.CodeType = 1120
+	CodeUpgradeAlreadyScheduled         sdk.CodeType = 1121
+	CodeUnknownUpgrade                  sdk.CodeType = 1122
+	CodeFailedToMarshalUpgradePlan      sdk.CodeType = 1123
+	CodeFailedToUnmarshalUpgradePlan    sdk.CodeType = 1124
+	CodeInvalidFailedEventID            sdk.CodeType = 1125
+	CodeProposalFailedEventNotFound     sdk.CodeType = 1126
 )
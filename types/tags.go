@@ -0,0 +1,75 @@
+package types
+
+// Tag keys attached to the result of each handled msg, indexed by Tendermint tx indexer.
+// For example, all donations to an author can be found by "action='donate' AND author='user'".
+const (
+	TagAction     = "action"
+	TagSender     = "sender"
+	TagReceiver   = "receiver"
+	TagAuthor     = "author"
+	TagPermlink   = "permlink"
+	TagProposalID = "proposal-id"
+	TagAmount     = "amount"
+)
+
+// Values of TagAction, one for each handled msg
+const (
+	// account
+	ActionRegister                = "register"
+	ActionFollow                  = "follow"
+	ActionUnfollow                = "unfollow"
+	ActionTransfer                = "transfer"
+	ActionClaim                   = "claim"
+	ActionRecover                 = "recover"
+	ActionUpdateAccount           = "update-account"
+	ActionAddCoSigner             = "add-co-signer"
+	ActionRemoveCoSigner          = "remove-co-signer"
+	ActionChangeThreshold         = "change-threshold"
+	ActionUpdateTransactionKey    = "update-transaction-key"
+	ActionUpdateAppKey            = "update-app-key"
+	ActionCreateScheduledTransfer = "create-scheduled-transfer"
+	ActionCancelScheduledTransfer = "cancel-scheduled-transfer"
+
+	// post
+	ActionCreatePost     = "create-post"
+	ActionUpdatePost     = "update-post"
+	ActionDeletePost     = "delete-post"
+	ActionDonate         = "donate"
+	ActionView           = "view"
+	ActionReportOrUpvote = "report-or-upvote"
+	ActionUnlockPost     = "unlock-post"
+	ActionAcceptCoAuthor = "accept-co-author"
+
+	// vote
+	ActionStakeIn              = "stake-in"
+	ActionStakeOut             = "stake-out"
+	ActionDelegate             = "delegate"
+	ActionDelegatorWithdraw    = "delegator-withdraw"
+	ActionClaimInterest        = "claim-interest"
+	ActionClaimDelegatorReward = "claim-delegator-reward"
+
+	// validator
+	ActionValidatorDeposit          = "validator-deposit"
+	ActionValidatorWithdraw         = "validator-withdraw"
+	ActionValidatorRevoke           = "validator-revoke"
+	ActionValidatorUpdateCommission = "validator-update-commission"
+	ActionValidatorUnjail           = "validator-unjail"
+
+	// developer
+	ActionDeveloperRegister = "developer-register"
+	ActionDeveloperUpdate   = "developer-update"
+	ActionDeveloperRevoke   = "developer-revoke"
+	ActionGrantPermission   = "grant-permission"
+	ActionRevokePermission  = "revoke-permission"
+	ActionPreAuthorization  = "pre-authorization"
+
+	// infra
+	ActionProviderReport = "provider-report"
+
+	// proposal
+	ActionChangeParam       = "change-param"
+	ActionContentCensorship = "content-censorship"
+	ActionProtocolUpgrade   = "protocol-upgrade"
+	ActionFailedEvent       = "failed-event"
+	ActionVoteProposal      = "vote-proposal"
+)
@@ -0,0 +1,265 @@
+package commands
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/account/model"
+
+	acc "github.com/lino-network/lino/x/account"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// nolint
+const (
+	formatJSON = "json"
+	formatCSV  = "csv"
+)
+
+// detailTypeNames - names accepted by --detail-type flag
+var detailTypeNames = map[string]types.TransferDetailType{
+	"TransferIn":              types.TransferIn,
+	"DonationIn":              types.DonationIn,
+	"ClaimReward":             types.ClaimReward,
+	"ValidatorInflation":      types.ValidatorInflation,
+	"DeveloperInflation":      types.DeveloperInflation,
+	"InfraInflation":          types.InfraInflation,
+	"VoteReturnCoin":          types.VoteReturnCoin,
+	"DelegationReturnCoin":    types.DelegationReturnCoin,
+	"ValidatorReturnCoin":     types.ValidatorReturnCoin,
+	"DeveloperReturnCoin":     types.DeveloperReturnCoin,
+	"InfraReturnCoin":         types.InfraReturnCoin,
+	"ProposalReturnCoin":      types.ProposalReturnCoin,
+	"GenesisCoin":             types.GenesisCoin,
+	"ClaimInterest":           types.ClaimInterest,
+	"ClaimDelegatorReward":    types.ClaimDelegatorReward,
+	"TransferOut":             types.TransferOut,
+	"DonationOut":             types.DonationOut,
+	"Delegate":                types.Delegate,
+	"VoterDeposit":            types.VoterDeposit,
+	"ValidatorDeposit":        types.ValidatorDeposit,
+	"DeveloperDeposit":        types.DeveloperDeposit,
+	"InfraDeposit":            types.InfraDeposit,
+	"ProposalDeposit":         types.ProposalDeposit,
+	"ScheduledTransferFailed": types.ScheduledTransferFailed,
+}
+
+// GetBalanceHistoryCmd returns a query balance history command which walks
+// all history bundles of a given username with filter
+func GetBalanceHistoryCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "balance-history <username>",
+		Short: "Query balance history with time range and detail type filter",
+		RunE:  cmdr.getBalanceHistoryCmd,
+	}
+	addHistoryFlags(cmd)
+	cmd.Flags().String(client.FlagDetailType, "",
+		"comma separated detail types to match, e.g. DonationIn,TransferIn, omit to match all")
+	return cmd
+}
+
+// GetRewardHistoryCmd returns a query reward history command which walks
+// all history bundles of a given username with filter
+func GetRewardHistoryCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "reward-history <username>",
+		Short: "Query reward history with time range filter",
+		RunE:  cmdr.getRewardHistoryCmd,
+	}
+	addHistoryFlags(cmd)
+	return cmd
+}
+
+func addHistoryFlags(cmd *cobra.Command) {
+	cmd.Flags().String(client.FlagStart, "", "start date (2006-01-02 or RFC3339), omit to start from the first record")
+	cmd.Flags().String(client.FlagEnd, "", "end date (2006-01-02 or RFC3339), omit to query until the last record")
+	cmd.Flags().Int64(client.FlagOffset, 0, "number of matched records to skip")
+	cmd.Flags().Int64(client.FlagLimit, 100, "max number of records in one page")
+	cmd.Flags().Bool(client.FlagAll, false, "fetch all pages, useful for export")
+	cmd.Flags().String(client.FlagFormat, formatJSON, "output format, json or csv")
+}
+
+func (c commander) getBalanceHistoryCmd(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+	detailTypes, err := parseDetailTypes(viper.GetString(client.FlagDetailType))
+	if err != nil {
+		return err
+	}
+
+	details := []model.Detail{}
+	err = c.walkHistoryPages(acc.QueryAccountBalanceHistoryPage, args[0], detailTypes,
+		func(res []byte) (bool, error) {
+			page := new(model.BalanceHistoryPage)
+			if err := c.cdc.UnmarshalJSON(res, page); err != nil {
+				return false, err
+			}
+			details = append(details, page.Details...)
+			return page.HasMore, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	if viper.GetString(client.FlagFormat) != formatCSV {
+		return client.PrintIndent(details)
+	}
+	w := csv.NewWriter(os.Stdout)
+	w.Write([]string{"created_at", "detail_type", "from", "to", "amount", "balance", "memo"})
+	for _, detail := range details {
+		w.Write([]string{
+			time.Unix(detail.CreatedAt, 0).UTC().Format(time.RFC3339),
+			detailTypeName(detail.DetailType),
+			string(detail.From),
+			string(detail.To),
+			detail.Amount.Amount.String(),
+			detail.Balance.Amount.String(),
+			detail.Memo,
+		})
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func (c commander) getRewardHistoryCmd(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+
+	details := []model.RewardDetail{}
+	err := c.walkHistoryPages(acc.QueryAccountRewardHistoryPage, args[0], acc.AllDetailTypes,
+		func(res []byte) (bool, error) {
+			page := new(model.RewardHistoryPage)
+			if err := c.cdc.UnmarshalJSON(res, page); err != nil {
+				return false, err
+			}
+			details = append(details, page.Details...)
+			return page.HasMore, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	if viper.GetString(client.FlagFormat) != formatCSV {
+		return client.PrintIndent(details)
+	}
+	w := csv.NewWriter(os.Stdout)
+	w.Write([]string{
+		"created_at", "consumer", "post_author", "post_id",
+		"original_donation", "friction_donation", "actual_reward"})
+	for _, detail := range details {
+		w.Write([]string{
+			time.Unix(detail.CreatedAt, 0).UTC().Format(time.RFC3339),
+			string(detail.Consumer),
+			string(detail.PostAuthor),
+			detail.PostID,
+			detail.OriginalDonation.Amount.String(),
+			detail.FrictionDonation.Amount.String(),
+			detail.ActualReward.Amount.String(),
+		})
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// walkHistoryPages - query history pages from offset, keep querying next page
+// if --all is set and process reports there are more records
+func (c commander) walkHistoryPages(
+	endpoint, username, detailTypes string, process func(res []byte) (bool, error)) error {
+	ctx := client.NewCoreContextFromViper()
+	format := viper.GetString(client.FlagFormat)
+	if format != formatJSON && format != formatCSV {
+		return errors.Errorf("unknown format %s", format)
+	}
+	start, err := parseHistoryTime(viper.GetString(client.FlagStart), 0)
+	if err != nil {
+		return err
+	}
+	end, err := parseHistoryTime(viper.GetString(client.FlagEnd), math.MaxInt64)
+	if err != nil {
+		return err
+	}
+	offset := viper.GetInt64(client.FlagOffset)
+	limit := viper.GetInt64(client.FlagLimit)
+
+	for {
+		res, err := ctx.QueryCustom(c.queryRoute, endpoint, username,
+			strconv.FormatInt(start, 10), strconv.FormatInt(end, 10), detailTypes,
+			strconv.FormatInt(offset, 10), strconv.FormatInt(limit, 10))
+		if err != nil {
+			return err
+		}
+		hasMore, err := process(res)
+		if err != nil {
+			return err
+		}
+		if !hasMore || !viper.GetBool(client.FlagAll) {
+			return nil
+		}
+		offset += limit
+	}
+}
+
+// parse date to unix time, empty input returns default value
+func parseHistoryTime(input string, defaultValue int64) (int64, error) {
+	if input == "" {
+		return defaultValue, nil
+	}
+	if t, err := time.Parse(time.RFC3339, input); err == nil {
+		return t.Unix(), nil
+	}
+	t, err := time.Parse("2006-01-02", input)
+	if err != nil {
+		return 0, errors.Errorf("invalid date %s", input)
+	}
+	return t.Unix(), nil
+}
+
+// parse comma separated detail type names or numbers to query parameter
+func parseDetailTypes(input string) (string, error) {
+	if input == "" {
+		return acc.AllDetailTypes, nil
+	}
+	nums := []string{}
+	for _, name := range strings.Split(input, ",") {
+		name = strings.TrimSpace(name)
+		if detailType, ok := detailTypeNames[name]; ok {
+			nums = append(nums, strconv.Itoa(int(detailType)))
+			continue
+		}
+		if _, err := strconv.Atoi(name); err != nil {
+			return "", errors.Errorf("unknown detail type %s", name)
+		}
+		nums = append(nums, name)
+	}
+	return strings.Join(nums, ","), nil
+}
+
+func detailTypeName(detailType types.TransferDetailType) string {
+	for name, t := range detailTypeNames {
+		if t == detailType {
+			return name
+		}
+	}
+	return strconv.Itoa(int(detailType))
+}
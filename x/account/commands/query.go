@@ -3,21 +3,25 @@ package commands
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/account/model"
 
+	acc "github.com/lino-network/lino/x/account"
+
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // GetBankCmd returns a query bank that will display the
 // state of the bank at a given address
-func GetBankCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetBankCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -29,9 +33,9 @@ func GetBankCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 
 // GetAccountCmd returns a query account that will display the
 // state of the account at a given username
-func GetAccountCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetAccountCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -43,21 +47,38 @@ func GetAccountCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 
 // GetAccountCmd returns a query account that will display the
 // state of the account at a given username
-func GetAccountsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetAccountsCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "accounts",
-		Short: "Query all accounts",
+		Short: "Query accounts ordered by username",
 		RunE:  cmdr.getAccountsCmd,
 	}
+	cmd.Flags().Int64(client.FlagOffset, 0, "number of accounts to skip")
+	cmd.Flags().Int64(client.FlagLimit, 20, "max number of accounts in one page")
+	return cmd
+}
+
+// GetScheduledTransfersCmd returns a query command which lists all
+// active scheduled transfers of a given username
+func GetScheduledTransfersCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "scheduled-transfers <username>",
+		Short: "Query all active scheduled transfers",
+		RunE:  cmdr.getScheduledTransfersCmd,
+	}
 }
 
 type commander struct {
-	storeName string
-	cdc       *wire.Codec
+	queryRoute string
+	cdc        *wire.Codec
 }
 
 func (c commander) getBankCmd(cmd *cobra.Command, args []string) error {
@@ -68,7 +89,7 @@ func (c commander) getBankCmd(cmd *cobra.Command, args []string) error {
 
 	username := types.AccountKey(args[0])
 
-	res, err := ctx.Query(model.GetAccountBankKey(username), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, acc.QueryAccountBank, string(username))
 	if err != nil {
 		return err
 	}
@@ -96,7 +117,7 @@ func (c commander) getAccountCmd(cmd *cobra.Command, args []string) error {
 	// find the key to look up the account
 	accKey := types.AccountKey(args[0])
 
-	res, err := ctx.Query(model.GetAccountInfoKey(accKey), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, acc.QueryAccountInfo, string(accKey))
 	if err != nil {
 		return err
 	}
@@ -105,7 +126,7 @@ func (c commander) getAccountCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	res, err = ctx.Query(model.GetAccountBankKey(accKey), c.storeName)
+	res, err = ctx.QueryCustom(c.queryRoute, acc.QueryAccountBank, string(accKey))
 	if err != nil {
 		return err
 	}
@@ -114,7 +135,7 @@ func (c commander) getAccountCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	res, err = ctx.Query(model.GetAccountMetaKey(accKey), c.storeName)
+	res, err = ctx.QueryCustom(c.queryRoute, acc.QueryAccountMeta, string(accKey))
 	if err != nil {
 		return err
 	}
@@ -132,20 +153,40 @@ func (c commander) getAccountCmd(cmd *cobra.Command, args []string) error {
 func (c commander) getAccountsCmd(cmd *cobra.Command, args []string) error {
 	ctx := client.NewCoreContextFromViper()
 
-	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetAccountInfoPrefix(), c.storeName)
+	res, err := ctx.QueryCustom(
+		c.queryRoute, acc.QueryAllAccountInfo,
+		strconv.FormatInt(viper.GetInt64(client.FlagOffset), 10),
+		strconv.FormatInt(viper.GetInt64(client.FlagLimit), 10))
 	if err != nil {
 		return err
 	}
-	var accounts []model.AccountInfo
-	for _, KV := range resKVs {
-		var info model.AccountInfo
-		if err := c.cdc.UnmarshalJSON(KV.Value, &info); err != nil {
-			return err
-		}
-		accounts = append(accounts, info)
+	page := new(model.AccountInfoPage)
+	if err := c.cdc.UnmarshalJSON(res, page); err != nil {
+		return err
+	}
+
+	if err := client.PrintIndent(page); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getScheduledTransfersCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+
+	res, err := ctx.QueryCustom(c.queryRoute, acc.QueryAccountScheduledTransfers, args[0])
+	if err != nil {
+		return err
+	}
+	var transfers []model.ScheduledTransfer
+	if err := c.cdc.UnmarshalJSON(res, &transfers); err != nil {
+		return err
 	}
 
-	if err := client.PrintIndent(accounts); err != nil {
+	if err := client.PrintIndent(transfers); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	acc "github.com/lino-network/lino/x/account"
+)
+
+// CreateScheduledTransferTxCmd will create a scheduled transfer tx and sign it with the given key
+func CreateScheduledTransferTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-transfer",
+		Short: "Create and sign a scheduled or recurring transfer tx",
+		RunE:  sendCreateScheduledTransferTx(cdc),
+	}
+	cmd.Flags().String(client.FlagSender, "", "money sender")
+	cmd.Flags().String(client.FlagReceiver, "", "receiver username")
+	cmd.Flags().String(client.FlagAmount, "", "amount to transfer in each round")
+	cmd.Flags().String(client.FlagMemo, "", "memo msg")
+	cmd.Flags().String(client.FlagStartTime, "", "time of first round (2006-01-02 or RFC3339)")
+	cmd.Flags().Int64(client.FlagInterval, 0, "seconds between two rounds")
+	cmd.Flags().Int64(client.FlagTimes, 1, "number of rounds")
+	return cmd
+}
+
+// CancelScheduledTransferTxCmd will create a cancel scheduled transfer tx and sign it with the given key
+func CancelScheduledTransferTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-transfer",
+		Short: "Create and sign a cancel scheduled transfer tx",
+		RunE:  sendCancelScheduledTransferTx(cdc),
+	}
+	cmd.Flags().String(client.FlagSender, "", "money sender")
+	cmd.Flags().Int64(client.FlagScheduleID, 0, "id of scheduled transfer")
+	return cmd
+}
+
+// send create scheduled transfer transaction to the blockchain
+func sendCreateScheduledTransferTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		if viper.GetString(client.FlagStartTime) == "" {
+			return errors.New("You must provide start time")
+		}
+		startTime, err := parseHistoryTime(viper.GetString(client.FlagStartTime), 0)
+		if err != nil {
+			return err
+		}
+		msg := acc.NewCreateScheduledTransferMsg(
+			viper.GetString(client.FlagSender), viper.GetString(client.FlagReceiver),
+			types.LNO(viper.GetString(client.FlagAmount)), viper.GetString(client.FlagMemo),
+			startTime, viper.GetInt64(client.FlagInterval), viper.GetInt64(client.FlagTimes))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+// send cancel scheduled transfer transaction to the blockchain
+func sendCancelScheduledTransferTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := acc.NewCancelScheduledTransferMsg(
+			viper.GetString(client.FlagSender), viper.GetInt64(client.FlagScheduleID))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
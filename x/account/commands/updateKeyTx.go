@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	acc "github.com/lino-network/lino/x/account"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// UpdateTransactionKeyTxCmd will create an update transaction key tx and sign it with reset key
+func UpdateTransactionKeyTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-tx-key",
+		Short: "Create an update transaction key tx, need to be signed by reset key",
+		RunE:  sendUpdateTransactionKeyTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "user of this transaction")
+	cmd.Flags().Int64(client.FlagGracePeriod, 0, "seconds the old transaction key keeps valid")
+	return cmd
+}
+
+// UpdateAppKeyTxCmd will create an update app key tx and sign it with transaction key
+func UpdateAppKeyTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-app-key",
+		Short: "Create an update app key tx, need to be signed by transaction key",
+		RunE:  sendUpdateAppKeyTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "user of this transaction")
+	cmd.Flags().Int64(client.FlagGracePeriod, 0, "seconds the old app key keeps valid")
+	return cmd
+}
+
+// send update transaction key transaction to the blockchain
+func sendUpdateTransactionKeyTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		name := viper.GetString(client.FlagUser)
+
+		transactionPriv := secp256k1.GenPrivKey()
+		fmt.Println("new transaction private key is:", strings.ToUpper(hex.EncodeToString(transactionPriv.Bytes())))
+
+		// create the message
+		msg := acc.NewUpdateTransactionKeyMsg(
+			name, transactionPriv.PubKey(), viper.GetInt64(client.FlagGracePeriod))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+// send update app key transaction to the blockchain
+func sendUpdateAppKeyTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		name := viper.GetString(client.FlagUser)
+
+		appPriv := secp256k1.GenPrivKey()
+		fmt.Println("new app private key is:", strings.ToUpper(hex.EncodeToString(appPriv.Bytes())))
+
+		// create the message
+		msg := acc.NewUpdateAppKeyMsg(name, appPriv.PubKey(), viper.GetInt64(client.FlagGracePeriod))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
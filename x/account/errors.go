@@ -204,3 +204,69 @@ func ErrInvalidMemo() sdk.Error {
 func ErrInvalidJSONMeta() sdk.Error {
 	return types.NewError(types.CodeInvalidJSONMeta, fmt.Sprintf("invalid account JSON meta"))
 }
+
+// ErrInvalidHistoryFilter - error when balance or reward history filter is invalid
+func ErrInvalidHistoryFilter(msg string) sdk.Error {
+	return types.NewError(types.CodeInvalidHistoryFilter, msg)
+}
+
+// ErrCoSignerAlreadyExist - error when co-signer is already added to the account
+func ErrCoSignerAlreadyExist(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeCoSignerAlreadyExist, fmt.Sprintf("co-signer already exists in account %v", username))
+}
+
+// ErrCoSignerNotFound - error when co-signer is not found in the account
+func ErrCoSignerNotFound(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeCoSignerNotFound, fmt.Sprintf("co-signer not found in account %v", username))
+}
+
+// ErrInvalidThreshold - error when multi-signature threshold is invalid
+func ErrInvalidThreshold(msg string) sdk.Error {
+	return types.NewError(types.CodeInvalidThreshold, msg)
+}
+
+// ErrTooManyCoSigners - error when number of co-signers exceeds the limitation
+func ErrTooManyCoSigners(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeTooManyCoSigners, fmt.Sprintf("account %v has too many co-signers", username))
+}
+
+// ErrCheckMultiSigKey - error when multi-signature is not signed by distinct co-signers
+func ErrCheckMultiSigKey(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeCheckMultiSigKey, fmt.Sprintf("transaction needs distinct co-signers of account %v", username))
+}
+
+// ErrInvalidCoSigner - error when co-signer public key is invalid
+func ErrInvalidCoSigner() sdk.Error {
+	return types.NewError(types.CodeInvalidCoSigner, fmt.Sprintf("invalid co-signer"))
+}
+
+// ErrInvalidGracePeriod - error when grace period of key rotation is invalid
+func ErrInvalidGracePeriod(gracePeriodSec int64) sdk.Error {
+	return types.NewError(types.CodeInvalidGracePeriod, fmt.Sprintf("invalid grace period %v", gracePeriodSec))
+}
+
+// ErrInvalidNewKey - error when new key of key rotation is invalid
+func ErrInvalidNewKey(msg string) sdk.Error {
+	return types.NewError(types.CodeInvalidNewKey, msg)
+}
+
+// ErrScheduledTransferNotFound - error when scheduled transfer is not found
+func ErrScheduledTransferNotFound(username types.AccountKey, id int64) sdk.Error {
+	return types.NewError(types.CodeScheduledTransferNotFound, fmt.Sprintf("scheduled transfer %v of account %v not found", id, username))
+}
+
+// ErrInvalidScheduledTransfer - error when scheduled transfer is invalid
+func ErrInvalidScheduledTransfer(msg string) sdk.Error {
+	return types.NewError(types.CodeInvalidScheduledTransfer, msg)
+}
+
+// ErrTooManyScheduledTransfers - error when number of scheduled transfers exceeds the limitation
+func ErrTooManyScheduledTransfers(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeTooManyScheduledTransfers, fmt.Sprintf("account %v has too many scheduled transfers", username))
+}
+
+// ErrInvalidAccountInfoPage - error when offset or limit of account list query is invalid
+func ErrInvalidAccountInfoPage(offset, limit int64) sdk.Error {
+	return types.NewError(
+		types.CodeInvalidAccountInfoPage, fmt.Sprintf("invalid offset %v or limit %v", offset, limit))
+}
@@ -3,6 +3,7 @@ package account
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global"
 )
 
 // ReturnCoinEvent - return a certain amount of coin to an account
@@ -26,6 +27,25 @@ func (event ReturnCoinEvent) Execute(ctx sdk.Context, am AccountManager) sdk.Err
 	return nil
 }
 
+// ScheduledTransferEvent - execute one round of a scheduled transfer
+type ScheduledTransferEvent struct {
+	Sender     types.AccountKey `json:"sender"`
+	ScheduleID int64            `json:"schedule_id"`
+}
+
+// Execute - execute scheduled transfer and register event for next round if any
+func (event ScheduledTransferEvent) Execute(
+	ctx sdk.Context, am AccountManager, gm global.GlobalManager) sdk.Error {
+	transfer, err := am.ExecuteScheduledTransfer(ctx, event.Sender, event.ScheduleID)
+	if err != nil {
+		return err
+	}
+	if transfer == nil {
+		return nil
+	}
+	return gm.RegisterScheduledTransferEvent(ctx, transfer.NextExecuteAt, event)
+}
+
 // CreateCoinReturnEvents - create coin return events
 func CreateCoinReturnEvents(
 	ctx sdk.Context, username types.AccountKey, times int64, interval int64, coin types.Coin,
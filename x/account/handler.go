@@ -28,6 +28,20 @@ func NewHandler(am AccountManager, gm global.GlobalManager) sdk.Handler {
 			return handleRegisterMsg(ctx, am, gm, msg)
 		case UpdateAccountMsg:
 			return handleUpdateAccountMsg(ctx, am, msg)
+		case AddCoSignerMsg:
+			return handleAddCoSignerMsg(ctx, am, msg)
+		case RemoveCoSignerMsg:
+			return handleRemoveCoSignerMsg(ctx, am, msg)
+		case ChangeThresholdMsg:
+			return handleChangeThresholdMsg(ctx, am, msg)
+		case UpdateTransactionKeyMsg:
+			return handleUpdateTransactionKeyMsg(ctx, am, msg)
+		case UpdateAppKeyMsg:
+			return handleUpdateAppKeyMsg(ctx, am, msg)
+		case CreateScheduledTransferMsg:
+			return handleCreateScheduledTransferMsg(ctx, am, gm, msg)
+		case CancelScheduledTransferMsg:
+			return handleCancelScheduledTransferMsg(ctx, am, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized account msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -53,7 +67,13 @@ func handleFollowMsg(ctx sdk.Context, am AccountManager, msg FollowMsg) sdk.Resu
 	if err := am.SetFollowing(ctx, msg.Follower, msg.Followee); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionFollow),
+			types.TagSender, []byte(msg.Follower),
+			types.TagReceiver, []byte(msg.Followee),
+		),
+	}
 }
 
 func handleUnfollowMsg(ctx sdk.Context, am AccountManager, msg UnfollowMsg) sdk.Result {
@@ -75,7 +95,13 @@ func handleUnfollowMsg(ctx sdk.Context, am AccountManager, msg UnfollowMsg) sdk.
 	if err := am.RemoveFollowing(ctx, msg.Follower, msg.Followee); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionUnfollow),
+			types.TagSender, []byte(msg.Follower),
+			types.TagReceiver, []byte(msg.Followee),
+		),
+	}
 }
 
 func handleTransferMsg(ctx sdk.Context, am AccountManager, msg TransferMsg) sdk.Result {
@@ -101,7 +127,14 @@ func handleTransferMsg(ctx sdk.Context, am AccountManager, msg TransferMsg) sdk.
 		ctx, msg.Receiver, coin, msg.Sender, msg.Memo, types.TransferIn); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionTransfer),
+			types.TagSender, []byte(msg.Sender),
+			types.TagReceiver, []byte(msg.Receiver),
+			types.TagAmount, []byte(msg.Amount),
+		),
+	}
 }
 
 func handleClaimMsg(ctx sdk.Context, am AccountManager, msg ClaimMsg) sdk.Result {
@@ -109,7 +142,12 @@ func handleClaimMsg(ctx sdk.Context, am AccountManager, msg ClaimMsg) sdk.Result
 	if err := am.ClaimReward(ctx, msg.Username); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionClaim),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
 }
 
 func handleRecoverMsg(ctx sdk.Context, am AccountManager, msg RecoverMsg) sdk.Result {
@@ -122,7 +160,12 @@ func handleRecoverMsg(ctx sdk.Context, am AccountManager, msg RecoverMsg) sdk.Re
 		msg.NewAppPubKey); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionRecover),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
 }
 
 // Handle RegisterMsg
@@ -155,7 +198,14 @@ func handleRegisterMsg(ctx sdk.Context, am AccountManager, gm global.GlobalManag
 		msg.NewAppPubKey, coin.Minus(accParams.RegisterFee)); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionRegister),
+			types.TagSender, []byte(msg.Referrer),
+			types.TagReceiver, []byte(msg.NewUser),
+			types.TagAmount, []byte(msg.RegisterFee),
+		),
+	}
 }
 
 // Handle RegisterMsg
@@ -166,5 +216,110 @@ func handleUpdateAccountMsg(ctx sdk.Context, am AccountManager, msg UpdateAccoun
 	if err := am.UpdateJSONMeta(ctx, msg.Username, msg.JSONMeta); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionUpdateAccount),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
+}
+
+func handleAddCoSignerMsg(ctx sdk.Context, am AccountManager, msg AddCoSignerMsg) sdk.Result {
+	if err := am.AddCoSigner(ctx, msg.Username, msg.CoSigner); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionAddCoSigner),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
+}
+
+func handleRemoveCoSignerMsg(ctx sdk.Context, am AccountManager, msg RemoveCoSignerMsg) sdk.Result {
+	if err := am.RemoveCoSigner(ctx, msg.Username, msg.CoSigner); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionRemoveCoSigner),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
+}
+
+func handleChangeThresholdMsg(ctx sdk.Context, am AccountManager, msg ChangeThresholdMsg) sdk.Result {
+	if err := am.ChangeThreshold(ctx, msg.Username, msg.Threshold); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionChangeThreshold),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
+}
+
+func handleUpdateTransactionKeyMsg(ctx sdk.Context, am AccountManager, msg UpdateTransactionKeyMsg) sdk.Result {
+	if err := am.UpdateTransactionKey(
+		ctx, msg.Username, msg.NewTransactionPubKey, msg.GracePeriodSec); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionUpdateTransactionKey),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
+}
+
+func handleUpdateAppKeyMsg(ctx sdk.Context, am AccountManager, msg UpdateAppKeyMsg) sdk.Result {
+	if err := am.UpdateAppKey(ctx, msg.Username, msg.NewAppPubKey, msg.GracePeriodSec); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionUpdateAppKey),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
+}
+
+func handleCreateScheduledTransferMsg(
+	ctx sdk.Context, am AccountManager, gm global.GlobalManager, msg CreateScheduledTransferMsg) sdk.Result {
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	transfer, err := am.CreateScheduledTransfer(
+		ctx, msg.Sender, msg.Receiver, coin, msg.Memo, msg.StartTime, msg.IntervalSec, msg.Times)
+	if err != nil {
+		return err.Result()
+	}
+	if err := gm.RegisterScheduledTransferEvent(
+		ctx, transfer.NextExecuteAt,
+		ScheduledTransferEvent{Sender: transfer.Sender, ScheduleID: transfer.ID}); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionCreateScheduledTransfer),
+			types.TagSender, []byte(msg.Sender),
+			types.TagReceiver, []byte(msg.Receiver),
+			types.TagAmount, []byte(msg.Amount),
+		),
+	}
+}
+
+func handleCancelScheduledTransferMsg(
+	ctx sdk.Context, am AccountManager, msg CancelScheduledTransferMsg) sdk.Result {
+	if err := am.CancelScheduledTransfer(ctx, msg.Sender, msg.ScheduleID); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionCancelScheduledTransfer),
+			types.TagSender, []byte(msg.Sender),
+		),
+	}
 }
@@ -31,7 +31,7 @@ func TestFollow(t *testing.T) {
 	// let user1 follows user2
 	msg := NewFollowMsg("user1", "user2")
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, followResult(types.ActionFollow, "user1", "user2"), result)
 
 	// check user1 in the user2's follower list
 	assert.True(t, am.IsMyFollowing(ctx, types.AccountKey("user1"), types.AccountKey("user2")))
@@ -72,11 +72,11 @@ func TestFollowAgain(t *testing.T) {
 	// let user1 follows user2 twice
 	msg := NewFollowMsg("user1", "user2")
 	result := handler(ctx, msg)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, followResult(types.ActionFollow, "user1", "user2"))
 
 	msg = NewFollowMsg("user1", "user2")
 	result = handler(ctx, msg)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, followResult(types.ActionFollow, "user1", "user2"))
 
 	// check user1 is user2's only follower
 	assert.True(t, am.IsMyFollower(ctx, types.AccountKey("user2"), types.AccountKey("user1")))
@@ -96,12 +96,12 @@ func TestUnfollow(t *testing.T) {
 	// let user1 follows user2
 	msg := NewFollowMsg("user1", "user2")
 	result := handler(ctx, msg)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, followResult(types.ActionFollow, "user1", "user2"))
 
 	// let user1 unfollows user2
 	msg2 := NewUnfollowMsg("user1", "user2")
 	result = handler(ctx, msg2)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, followResult(types.ActionUnfollow, "user1", "user2"))
 
 	// check user1 is not in the user2's follower list
 	assert.False(t, am.IsMyFollower(ctx, types.AccountKey("user2"), types.AccountKey("user1")))
@@ -138,17 +138,17 @@ func TestInvalidUnfollow(t *testing.T) {
 	// let user1 follows user2
 	msg := NewFollowMsg("user1", "user2")
 	result := handler(ctx, msg)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, followResult(types.ActionFollow, "user1", "user2"))
 
 	// let user3 unfollows user1 and user2 unfollows user3 (invalid)
 	//this won't make any changes
 	msg2 := NewUnfollowMsg("user3", "user1")
 	result = handler(ctx, msg2)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, followResult(types.ActionUnfollow, "user3", "user1"))
 
 	msg3 := NewUnfollowMsg("user2", "user3")
 	result = handler(ctx, msg3)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, followResult(types.ActionUnfollow, "user2", "user3"))
 
 	// check user1 in the user2's follower list
 	assert.True(t, am.IsMyFollower(ctx, types.AccountKey("user2"), types.AccountKey("user1")))
@@ -264,9 +264,15 @@ func TestHandleAccountRecover(t *testing.T) {
 
 	for testName, tc := range testCases {
 		msg := NewRecoverMsg(tc.user, tc.newResetKey, tc.newTransactionKey, tc.newAppKey)
+		wantResult := sdk.Result{
+			Tags: sdk.NewTags(
+				types.TagAction, []byte(types.ActionRecover),
+				types.TagSender, []byte(tc.user),
+			),
+		}
 		result := handler(ctx, msg)
-		if !assert.Equal(t, sdk.Result{}, result) {
-			t.Errorf("%s: diff result, got %v, want %v", testName, result, sdk.Result{})
+		if !assert.Equal(t, wantResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", testName, result, wantResult)
 		}
 
 		accInfo := model.AccountInfo{
@@ -315,7 +321,7 @@ func TestHandleRegister(t *testing.T) {
 				secp256k1.GenPrivKey().PubKey(),
 				secp256k1.GenPrivKey().PubKey(),
 			),
-			expectResult:            sdk.Result{},
+			expectResult:            registerResult("referrer", "user1", "1"),
 			expectReferrerSaving:    c100,
 			expectNewAccountSaving:  c0,
 			expectNewAccountCoinDay: c0,
@@ -367,7 +373,7 @@ func TestHandleRegister(t *testing.T) {
 				secp256k1.GenPrivKey().PubKey(),
 				secp256k1.GenPrivKey().PubKey(),
 			),
-			expectResult:            sdk.Result{},
+			expectResult:            registerResult("referrer", "user3", "1.5"),
 			expectReferrerSaving:    types.NewCoinFromInt64(9750000),
 			expectNewAccountSaving:  types.NewCoinFromInt64(50000),
 			expectNewAccountCoinDay: types.NewCoinFromInt64(50000),
@@ -380,7 +386,7 @@ func TestHandleRegister(t *testing.T) {
 				secp256k1.GenPrivKey().PubKey(),
 				secp256k1.GenPrivKey().PubKey(),
 			),
-			expectResult:            sdk.Result{},
+			expectResult:            registerResult("referrer", "user4", "2.5"),
 			expectReferrerSaving:    types.NewCoinFromInt64(95 * types.Decimals),
 			expectNewAccountSaving:  types.NewCoinFromInt64(150000),
 			expectNewAccountCoinDay: types.NewCoinFromInt64(1 * types.Decimals),
@@ -471,7 +477,12 @@ func TestHandleUpdateAccountMsg(t *testing.T) {
 		{
 			testName:         "normal update",
 			updateAccountMsg: NewUpdateAccountMsg("accKey", "{'link':'https://lino.network'}"),
-			expectResult:     sdk.Result{},
+			expectResult: sdk.Result{
+				Tags: sdk.NewTags(
+					types.TagAction, []byte(types.ActionUpdateAccount),
+					types.TagSender, []byte("accKey"),
+				),
+			},
 		},
 		{
 			testName:         "invalid username",
@@ -486,3 +497,249 @@ func TestHandleUpdateAccountMsg(t *testing.T) {
 		}
 	}
 }
+
+func TestHandleMultiSigMsgs(t *testing.T) {
+	ctx, am, gm := setupTest(t, 1)
+	handler := NewHandler(am, gm)
+
+	createTestAccount(ctx, am, "accKey")
+	coSigner1 := secp256k1.GenPrivKey().PubKey()
+	coSigner2 := secp256k1.GenPrivKey().PubKey()
+
+	multiSigResult := func(action string) sdk.Result {
+		return sdk.Result{
+			Tags: sdk.NewTags(
+				types.TagAction, []byte(action),
+				types.TagSender, []byte("accKey"),
+			),
+		}
+	}
+
+	testCases := []struct {
+		testName       string
+		msg            sdk.Msg
+		expectResult   sdk.Result
+		expectMultiSig model.MultiSig
+	}{
+		{
+			testName:     "threshold exceeds number of co-signers",
+			msg:          NewChangeThresholdMsg("accKey", 1),
+			expectResult: ErrInvalidThreshold("threshold 1 out of range [0, 0]").Result(),
+			expectMultiSig: model.MultiSig{
+				Threshold: 0, CoSigners: []crypto.PubKey{}},
+		},
+		{
+			testName:     "add first co-signer",
+			msg:          NewAddCoSignerMsg("accKey", coSigner1),
+			expectResult: multiSigResult(types.ActionAddCoSigner),
+			expectMultiSig: model.MultiSig{
+				Threshold: 0, CoSigners: []crypto.PubKey{coSigner1}},
+		},
+		{
+			testName:     "add co-signer again",
+			msg:          NewAddCoSignerMsg("accKey", coSigner1),
+			expectResult: ErrCoSignerAlreadyExist("accKey").Result(),
+			expectMultiSig: model.MultiSig{
+				Threshold: 0, CoSigners: []crypto.PubKey{coSigner1}},
+		},
+		{
+			testName:     "add second co-signer",
+			msg:          NewAddCoSignerMsg("accKey", coSigner2),
+			expectResult: multiSigResult(types.ActionAddCoSigner),
+			expectMultiSig: model.MultiSig{
+				Threshold: 0, CoSigners: []crypto.PubKey{coSigner1, coSigner2}},
+		},
+		{
+			testName:     "enable 2-of-2 multi-signature",
+			msg:          NewChangeThresholdMsg("accKey", 2),
+			expectResult: multiSigResult(types.ActionChangeThreshold),
+			expectMultiSig: model.MultiSig{
+				Threshold: 2, CoSigners: []crypto.PubKey{coSigner1, coSigner2}},
+		},
+		{
+			testName:     "remove co-signer breaks threshold",
+			msg:          NewRemoveCoSignerMsg("accKey", coSigner1),
+			expectResult: ErrInvalidThreshold("threshold 2 can't be reached after removing co-signer").Result(),
+			expectMultiSig: model.MultiSig{
+				Threshold: 2, CoSigners: []crypto.PubKey{coSigner1, coSigner2}},
+		},
+		{
+			testName:     "lower threshold",
+			msg:          NewChangeThresholdMsg("accKey", 1),
+			expectResult: multiSigResult(types.ActionChangeThreshold),
+			expectMultiSig: model.MultiSig{
+				Threshold: 1, CoSigners: []crypto.PubKey{coSigner1, coSigner2}},
+		},
+		{
+			testName:     "remove co-signer",
+			msg:          NewRemoveCoSignerMsg("accKey", coSigner1),
+			expectResult: multiSigResult(types.ActionRemoveCoSigner),
+			expectMultiSig: model.MultiSig{
+				Threshold: 1, CoSigners: []crypto.PubKey{coSigner2}},
+		},
+		{
+			testName:     "remove co-signer not found",
+			msg:          NewRemoveCoSignerMsg("accKey", coSigner1),
+			expectResult: ErrCoSignerNotFound("accKey").Result(),
+			expectMultiSig: model.MultiSig{
+				Threshold: 1, CoSigners: []crypto.PubKey{coSigner2}},
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+		multiSig, err := am.storage.GetMultiSig(ctx, "accKey")
+		if err != nil {
+			t.Errorf("%s: failed to get multi-signature, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.expectMultiSig, *multiSig) {
+			t.Errorf("%s: diff multi-signature, got %v, want %v", tc.testName, *multiSig, tc.expectMultiSig)
+		}
+	}
+}
+
+func TestHandleUpdateKeyMsgs(t *testing.T) {
+	ctx, am, gm := setupTest(t, 1)
+	handler := NewHandler(am, gm)
+
+	_, txPriv, appPriv := createTestAccount(ctx, am, "accKey")
+	newTxKey := secp256k1.GenPrivKey().PubKey()
+	newAppKey := secp256k1.GenPrivKey().PubKey()
+
+	updateKeyResult := func(action string) sdk.Result {
+		return sdk.Result{
+			Tags: sdk.NewTags(
+				types.TagAction, []byte(action),
+				types.TagSender, []byte("accKey"),
+			),
+		}
+	}
+
+	testCases := []struct {
+		testName        string
+		msg             sdk.Msg
+		expectResult    sdk.Result
+		expectTxKey     crypto.PubKey
+		expectAppKey    crypto.PubKey
+		expectNumOfKeys int
+	}{
+		{
+			testName:        "update transaction key with grace period",
+			msg:             NewUpdateTransactionKeyMsg("accKey", newTxKey, 3600),
+			expectResult:    updateKeyResult(types.ActionUpdateTransactionKey),
+			expectTxKey:     newTxKey,
+			expectAppKey:    appPriv.PubKey(),
+			expectNumOfKeys: 1,
+		},
+		{
+			testName:        "update transaction key to the same key",
+			msg:             NewUpdateTransactionKeyMsg("accKey", newTxKey, 0),
+			expectResult:    ErrInvalidNewKey("new key is the same as current key").Result(),
+			expectTxKey:     newTxKey,
+			expectAppKey:    appPriv.PubKey(),
+			expectNumOfKeys: 1,
+		},
+		{
+			testName:        "update app key without grace period",
+			msg:             NewUpdateAppKeyMsg("accKey", newAppKey, 0),
+			expectResult:    updateKeyResult(types.ActionUpdateAppKey),
+			expectTxKey:     newTxKey,
+			expectAppKey:    newAppKey,
+			expectNumOfKeys: 2,
+		},
+		{
+			testName:        "update key of non-exist account",
+			msg:             NewUpdateAppKeyMsg("invalid", newAppKey, 0),
+			expectResult:    ErrAccountNotFound("invalid").Result(),
+			expectTxKey:     newTxKey,
+			expectAppKey:    newAppKey,
+			expectNumOfKeys: 2,
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+		txKey, _ := am.GetTransactionKey(ctx, "accKey")
+		if !assert.Equal(t, tc.expectTxKey, txKey) {
+			t.Errorf("%s: diff transaction key, got %v, want %v", tc.testName, txKey, tc.expectTxKey)
+		}
+		appKey, _ := am.GetAppKey(ctx, "accKey")
+		if !assert.Equal(t, tc.expectAppKey, appKey) {
+			t.Errorf("%s: diff app key, got %v, want %v", tc.testName, appKey, tc.expectAppKey)
+		}
+		history, _ := am.GetKeyHistory(ctx, "accKey")
+		if len(history.Records) != tc.expectNumOfKeys {
+			t.Errorf("%s: diff num of key changes, got %v, want %v",
+				tc.testName, len(history.Records), tc.expectNumOfKeys)
+		}
+	}
+
+	// old transaction key is still valid during grace period, old app key is revoked immediately
+	_, err := am.CheckSigningPubKeyOwner(
+		ctx, "accKey", txPriv.PubKey(), types.TransactionPermission, types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+	_, err = am.CheckSigningPubKeyOwner(
+		ctx, "accKey", appPriv.PubKey(), types.AppPermission, types.NewCoinFromInt64(0))
+	assert.NotNil(t, err)
+}
+
+func TestHandleScheduledTransferMsgs(t *testing.T) {
+	ctx, am, gm := setupTest(t, 1)
+	handler := NewHandler(am, gm)
+	createTestAccount(ctx, am, "sender")
+	createTestAccount(ctx, am, "receiver")
+	startTime := ctx.BlockHeader().Time.Unix() + 100
+
+	msg := NewCreateScheduledTransferMsg("sender", "receiver", l100, memo, startTime, 3600, 2)
+	result := handler(ctx, msg)
+	assert.Equal(t, sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionCreateScheduledTransfer),
+			types.TagSender, []byte("sender"),
+			types.TagReceiver, []byte("receiver"),
+			types.TagAmount, []byte(l100),
+		),
+	}, result)
+	assert.Equal(t,
+		[]types.Event{ScheduledTransferEvent{Sender: "sender", ScheduleID: 1}},
+		gm.GetTimeEventListAtTime(ctx, startTime).Events)
+
+	result = handler(ctx, NewCancelScheduledTransferMsg("sender", 1))
+	assert.Equal(t, sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionCancelScheduledTransfer),
+			types.TagSender, []byte("sender"),
+		),
+	}, result)
+	transfers, err := am.GetScheduledTransfers(ctx, "sender")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(transfers))
+
+	result = handler(ctx, NewCancelScheduledTransferMsg("sender", 1))
+	assert.Equal(t, ErrScheduledTransferNotFound("sender", 1).Result(), result)
+}
+
+func followResult(action string, follower, followee types.AccountKey) sdk.Result {
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(action),
+			types.TagSender, []byte(follower),
+			types.TagReceiver, []byte(followee),
+		),
+	}
+}
+
+func registerResult(referrer, newUser types.AccountKey, registerFee types.LNO) sdk.Result {
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionRegister),
+			types.TagSender, []byte(referrer),
+			types.TagReceiver, []byte(newUser),
+			types.TagAmount, []byte(registerFee),
+		),
+	}
+}
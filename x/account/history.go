@@ -0,0 +1,149 @@
+package account
+
+import (
+	"fmt"
+
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/account/model"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HistoryFilter - filter used to walk balance and reward history across bundles,
+// details created in [StartTime, EndTime] are matched, empty DetailTypes matches
+// all detail types. Offset and Limit are applied after filtering.
+type HistoryFilter struct {
+	StartTime   int64                      `json:"start_time"`
+	EndTime     int64                      `json:"end_time"`
+	DetailTypes []types.TransferDetailType `json:"detail_types"`
+	Offset      int64                      `json:"offset"`
+	Limit       int64                      `json:"limit"`
+}
+
+// ValidateBasic - check history filter is valid
+func (filter HistoryFilter) ValidateBasic() sdk.Error {
+	if filter.StartTime < 0 || filter.EndTime < filter.StartTime {
+		return ErrInvalidHistoryFilter(
+			fmt.Sprintf("invalid time range [%v, %v]", filter.StartTime, filter.EndTime))
+	}
+	if filter.Offset < 0 {
+		return ErrInvalidHistoryFilter(fmt.Sprintf("invalid offset %v", filter.Offset))
+	}
+	if filter.Limit <= 0 || filter.Limit > types.MaximumHistoryPageSize {
+		return ErrInvalidHistoryFilter(fmt.Sprintf("invalid limit %v", filter.Limit))
+	}
+	return nil
+}
+
+func (filter HistoryFilter) inTimeRange(createdAt int64) bool {
+	return createdAt >= filter.StartTime && createdAt <= filter.EndTime
+}
+
+func (filter HistoryFilter) matchDetailType(detailType types.TransferDetailType) bool {
+	if len(filter.DetailTypes) == 0 {
+		return true
+	}
+	for _, t := range filter.DetailTypes {
+		if t == detailType {
+			return true
+		}
+	}
+	return false
+}
+
+// GetBalanceHistoryPage - walk balance history bundles in chronological order and
+// return the page of details matching the filter
+func (accManager AccountManager) GetBalanceHistoryPage(
+	ctx sdk.Context, username types.AccountKey, filter HistoryFilter) (*model.BalanceHistoryPage, sdk.Error) {
+	if err := filter.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	bank, err := accManager.storage.GetBankFromAccountKey(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.BalanceHistoryPage{Details: []model.Detail{}}
+	skipped := int64(0)
+	lastBundle := bank.NumOfTx / types.BalanceHistoryBundleSize
+	for bundle := int64(0); bundle <= lastBundle; bundle++ {
+		history, err := accManager.storage.GetBalanceHistory(ctx, username, bundle)
+		if err != nil {
+			return nil, err
+		}
+		if history == nil || len(history.Details) == 0 {
+			continue
+		}
+		// details are appended in block time order, skip the whole bundle
+		// if it ends before the time range
+		if history.Details[len(history.Details)-1].CreatedAt < filter.StartTime {
+			continue
+		}
+		for _, detail := range history.Details {
+			if detail.CreatedAt > filter.EndTime {
+				return page, nil
+			}
+			if !filter.inTimeRange(detail.CreatedAt) || !filter.matchDetailType(detail.DetailType) {
+				continue
+			}
+			if skipped < filter.Offset {
+				skipped++
+				continue
+			}
+			if int64(len(page.Details)) == filter.Limit {
+				page.HasMore = true
+				return page, nil
+			}
+			page.Details = append(page.Details, detail)
+		}
+	}
+	return page, nil
+}
+
+// GetRewardHistoryPage - walk reward history bundles in chronological order and
+// return the page of details in the time range of filter, detail types of
+// filter are ignored since reward details have no transfer type
+func (accManager AccountManager) GetRewardHistoryPage(
+	ctx sdk.Context, username types.AccountKey, filter HistoryFilter) (*model.RewardHistoryPage, sdk.Error) {
+	if err := filter.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	bank, err := accManager.storage.GetBankFromAccountKey(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.RewardHistoryPage{Details: []model.RewardDetail{}}
+	skipped := int64(0)
+	lastBundle := bank.NumOfReward / types.RewardHistoryBundleSize
+	for bundle := int64(0); bundle <= lastBundle; bundle++ {
+		history, err := accManager.storage.GetRewardHistory(ctx, username, bundle)
+		if err != nil {
+			return nil, err
+		}
+		if history == nil || len(history.Details) == 0 {
+			continue
+		}
+		if history.Details[len(history.Details)-1].CreatedAt < filter.StartTime {
+			continue
+		}
+		for _, detail := range history.Details {
+			if detail.CreatedAt > filter.EndTime {
+				return page, nil
+			}
+			if !filter.inTimeRange(detail.CreatedAt) {
+				continue
+			}
+			if skipped < filter.Offset {
+				skipped++
+				continue
+			}
+			if int64(len(page.Details)) == filter.Limit {
+				page.HasMore = true
+				return page, nil
+			}
+			page.Details = append(page.Details, detail)
+		}
+	}
+	return page, nil
+}
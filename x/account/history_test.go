@@ -0,0 +1,165 @@
+package account
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/lino-network/lino/types"
+	"github.com/stretchr/testify/assert"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestGetBalanceHistoryPage(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0)})
+	user1 := types.AccountKey("user1")
+	createTestAccount(ctx, am, string(user1))
+
+	// 150 details cross the bundle boundary, donation and transfer alternately
+	for i := int64(0); i < 150; i++ {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(100+10*i, 0)})
+		detailType := types.TransferIn
+		if i%2 == 0 {
+			detailType = types.DonationIn
+		}
+		err := am.AddSavingCoin(ctx, user1, c100, "", "", detailType)
+		assert.Nil(t, err)
+	}
+
+	testCases := []struct {
+		testName        string
+		filter          HistoryFilter
+		expectNum       int
+		expectFirstTime int64
+		expectHasMore   bool
+	}{
+		{
+			testName: "all details after first income",
+			filter: HistoryFilter{
+				StartTime: 100, EndTime: math.MaxInt64, Limit: types.MaximumHistoryPageSize},
+			expectNum:       150,
+			expectFirstTime: 100,
+			expectHasMore:   false,
+		},
+		{
+			testName: "donation in time range",
+			filter: HistoryFilter{
+				StartTime: 200, EndTime: 1000, Limit: types.MaximumHistoryPageSize,
+				DetailTypes: []types.TransferDetailType{types.DonationIn}},
+			expectNum:       41,
+			expectFirstTime: 200,
+			expectHasMore:   false,
+		},
+		{
+			testName: "page across bundle boundary",
+			filter: HistoryFilter{
+				StartTime: 100, EndTime: math.MaxInt64, Offset: 95, Limit: 10},
+			expectNum:       10,
+			expectFirstTime: 1050,
+			expectHasMore:   true,
+		},
+		{
+			testName: "last page",
+			filter: HistoryFilter{
+				StartTime: 100, EndTime: math.MaxInt64, Offset: 145, Limit: 10},
+			expectNum:       5,
+			expectFirstTime: 1550,
+			expectHasMore:   false,
+		},
+	}
+	for _, tc := range testCases {
+		page, err := am.GetBalanceHistoryPage(ctx, user1, tc.filter)
+		if err != nil {
+			t.Errorf("%s: failed to get balance history page, got err %v", tc.testName, err)
+			continue
+		}
+		if len(page.Details) != tc.expectNum {
+			t.Errorf("%s: diff num of details, got %v, want %v", tc.testName, len(page.Details), tc.expectNum)
+			continue
+		}
+		if page.Details[0].CreatedAt != tc.expectFirstTime {
+			t.Errorf("%s: diff first detail time, got %v, want %v",
+				tc.testName, page.Details[0].CreatedAt, tc.expectFirstTime)
+		}
+		if page.HasMore != tc.expectHasMore {
+			t.Errorf("%s: diff has more, got %v, want %v", tc.testName, page.HasMore, tc.expectHasMore)
+		}
+		for _, detail := range page.Details {
+			if !tc.filter.matchDetailType(detail.DetailType) {
+				t.Errorf("%s: detail type %v doesn't match filter", tc.testName, detail.DetailType)
+			}
+		}
+	}
+}
+
+func TestGetRewardHistoryPage(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0)})
+	user1 := types.AccountKey("user1")
+	createTestAccount(ctx, am, string(user1))
+
+	for i := int64(1); i <= 3; i++ {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(100*i, 0)})
+		err := am.AddIncomeAndReward(ctx, user1, c100, c100, c100, "donor", "author", "post")
+		assert.Nil(t, err)
+	}
+
+	page, err := am.GetRewardHistoryPage(ctx, user1, HistoryFilter{StartTime: 150, EndTime: 300, Limit: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(page.Details))
+	assert.Equal(t, int64(200), page.Details[0].CreatedAt)
+	assert.False(t, page.HasMore)
+
+	page, err = am.GetRewardHistoryPage(ctx, user1, HistoryFilter{StartTime: 0, EndTime: 300, Limit: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(page.Details))
+	assert.True(t, page.HasMore)
+}
+
+func TestHistoryFilterValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		filter      HistoryFilter
+		expectedErr error
+	}{
+		{
+			testName:    "valid filter",
+			filter:      HistoryFilter{StartTime: 0, EndTime: 100, Limit: 10},
+			expectedErr: nil,
+		},
+		{
+			testName:    "end time before start time",
+			filter:      HistoryFilter{StartTime: 100, EndTime: 0, Limit: 10},
+			expectedErr: ErrInvalidHistoryFilter("invalid time range [100, 0]"),
+		},
+		{
+			testName:    "negative offset",
+			filter:      HistoryFilter{StartTime: 0, EndTime: 100, Offset: -1, Limit: 10},
+			expectedErr: ErrInvalidHistoryFilter("invalid offset -1"),
+		},
+		{
+			testName:    "zero limit",
+			filter:      HistoryFilter{StartTime: 0, EndTime: 100},
+			expectedErr: ErrInvalidHistoryFilter("invalid limit 0"),
+		},
+		{
+			testName:    "limit exceeds maximum",
+			filter:      HistoryFilter{StartTime: 0, EndTime: 100, Limit: types.MaximumHistoryPageSize + 1},
+			expectedErr: ErrInvalidHistoryFilter("invalid limit 1001"),
+		},
+	}
+	for _, tc := range testCases {
+		err := tc.filter.ValidateBasic()
+		if tc.expectedErr == nil {
+			if err != nil {
+				t.Errorf("%s: got unexpected err %v", tc.testName, err)
+			}
+			continue
+		}
+		if !assert.Equal(t, tc.expectedErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectedErr)
+		}
+	}
+}
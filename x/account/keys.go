@@ -0,0 +1,113 @@
+package account
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/account/model"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdateTransactionKey - replace transaction key of the account, the old key keeps
+// valid during the grace period
+func (accManager AccountManager) UpdateTransactionKey(
+	ctx sdk.Context, username types.AccountKey, newTransactionPubKey crypto.PubKey, gracePeriodSec int64) sdk.Error {
+	return accManager.updateKey(ctx, username, types.TransactionPermission, newTransactionPubKey, gracePeriodSec)
+}
+
+// UpdateAppKey - replace app key of the account, the old key keeps valid
+// during the grace period
+func (accManager AccountManager) UpdateAppKey(
+	ctx sdk.Context, username types.AccountKey, newAppPubKey crypto.PubKey, gracePeriodSec int64) sdk.Error {
+	return accManager.updateKey(ctx, username, types.AppPermission, newAppPubKey, gracePeriodSec)
+}
+
+// GetKeyHistory - get all key changes of the account
+func (accManager AccountManager) GetKeyHistory(
+	ctx sdk.Context, username types.AccountKey) (*model.KeyHistory, sdk.Error) {
+	if !accManager.DoesAccountExist(ctx, username) {
+		return nil, ErrAccountNotFound(username)
+	}
+	return accManager.storage.GetKeyHistory(ctx, username)
+}
+
+func (accManager AccountManager) updateKey(
+	ctx sdk.Context, username types.AccountKey, permission types.Permission,
+	newKey crypto.PubKey, gracePeriodSec int64) sdk.Error {
+	if gracePeriodSec < 0 || gracePeriodSec > types.MaximumKeyGracePeriodSec {
+		return ErrInvalidGracePeriod(gracePeriodSec)
+	}
+	if !accManager.DoesAccountExist(ctx, username) {
+		return ErrAccountNotFound(username)
+	}
+	accInfo, err := accManager.storage.GetInfo(ctx, username)
+	if err != nil {
+		return err
+	}
+	var oldKey crypto.PubKey
+	switch permission {
+	case types.TransactionPermission:
+		oldKey = accInfo.TransactionKey
+		accInfo.TransactionKey = newKey
+	case types.AppPermission:
+		oldKey = accInfo.AppKey
+		accInfo.AppKey = newKey
+	default:
+		return ErrInvalidNewKey(fmt.Sprintf("key of permission %v can't be updated", permission))
+	}
+	if reflect.DeepEqual(oldKey, newKey) {
+		return ErrInvalidNewKey("new key is the same as current key")
+	}
+	if err := accManager.storage.SetInfo(ctx, username, accInfo); err != nil {
+		return err
+	}
+
+	now := ctx.BlockHeader().Time.Unix()
+	record := model.KeyChangeRecord{
+		Permission: permission,
+		OldKey:     oldKey,
+		NewKey:     newKey,
+		ChangedAt:  now,
+		GraceUntil: now + gracePeriodSec,
+	}
+	if gracePeriodSec > 0 {
+		retiringKey := &model.RetiringKey{PubKey: oldKey, ExpiresAt: record.GraceUntil}
+		if err := accManager.storage.SetRetiringKey(ctx, username, permission, retiringKey); err != nil {
+			return err
+		}
+	} else {
+		accManager.storage.DeleteRetiringKey(ctx, username, permission)
+	}
+	return accManager.addKeyChangeRecords(ctx, username, record)
+}
+
+// isRetiringKey - check if sign key is the unexpired retiring key of given permission,
+// expired retiring key is removed
+func (accManager AccountManager) isRetiringKey(
+	ctx sdk.Context, me types.AccountKey, permission types.Permission, signKey crypto.PubKey) (bool, sdk.Error) {
+	retiringKey, err := accManager.storage.GetRetiringKey(ctx, me, permission)
+	if err != nil {
+		return false, err
+	}
+	if retiringKey == nil {
+		return false, nil
+	}
+	if retiringKey.ExpiresAt < ctx.BlockHeader().Time.Unix() {
+		accManager.storage.DeleteRetiringKey(ctx, me, permission)
+		return false, nil
+	}
+	return reflect.DeepEqual(retiringKey.PubKey, signKey), nil
+}
+
+func (accManager AccountManager) addKeyChangeRecords(
+	ctx sdk.Context, username types.AccountKey, records ...model.KeyChangeRecord) sdk.Error {
+	history, err := accManager.storage.GetKeyHistory(ctx, username)
+	if err != nil {
+		return err
+	}
+	history.Records = append(history.Records, records...)
+	return accManager.storage.SetKeyHistory(ctx, username, history)
+}
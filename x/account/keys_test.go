@@ -0,0 +1,140 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/account/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestUpdateKeyWithGracePeriod(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1000, 0)})
+	user1 := types.AccountKey("user1")
+	_, txPriv, appPriv := createTestAccount(ctx, am, string(user1))
+	newTxPriv := secp256k1.GenPrivKey()
+	newAppPriv := secp256k1.GenPrivKey()
+
+	err := am.UpdateTransactionKey(ctx, user1, newTxPriv.PubKey(), 100)
+	assert.Nil(t, err)
+	err = am.UpdateAppKey(ctx, user1, newAppPriv.PubKey(), 200)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName     string
+		atWhen       int64
+		signKey      secp256k1.PrivKeySecp256k1
+		permission   types.Permission
+		expectResult bool
+	}{
+		{
+			testName:     "new transaction key is valid",
+			atWhen:       1000,
+			signKey:      newTxPriv,
+			permission:   types.TransactionPermission,
+			expectResult: true,
+		},
+		{
+			testName:     "old transaction key is valid in grace period",
+			atWhen:       1100,
+			signKey:      txPriv,
+			permission:   types.TransactionPermission,
+			expectResult: true,
+		},
+		{
+			testName:     "old transaction key can't sign reset permission msg",
+			atWhen:       1100,
+			signKey:      txPriv,
+			permission:   types.ResetPermission,
+			expectResult: false,
+		},
+		{
+			testName:     "old app key is valid in grace period",
+			atWhen:       1150,
+			signKey:      appPriv,
+			permission:   types.AppPermission,
+			expectResult: true,
+		},
+		{
+			testName:     "old app key can't sign transaction permission msg",
+			atWhen:       1150,
+			signKey:      appPriv,
+			permission:   types.TransactionPermission,
+			expectResult: false,
+		},
+		{
+			testName:     "old transaction key expired",
+			atWhen:       1101,
+			signKey:      txPriv,
+			permission:   types.AppPermission,
+			expectResult: false,
+		},
+		{
+			testName:     "old app key expired",
+			atWhen:       1201,
+			signKey:      appPriv,
+			permission:   types.AppPermission,
+			expectResult: false,
+		},
+	}
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(tc.atWhen, 0)})
+		_, err := am.CheckSigningPubKeyOwner(
+			ctx, user1, tc.signKey.PubKey(), tc.permission, types.NewCoinFromInt64(0))
+		if tc.expectResult != (err == nil) {
+			t.Errorf("%s: diff result, got err %v, want valid %v", tc.testName, err, tc.expectResult)
+		}
+	}
+
+	history, err := am.GetKeyHistory(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.KeyChangeRecord{
+		{
+			Permission: types.TransactionPermission,
+			OldKey:     txPriv.PubKey(),
+			NewKey:     newTxPriv.PubKey(),
+			ChangedAt:  1000,
+			GraceUntil: 1100,
+		},
+		{
+			Permission: types.AppPermission,
+			OldKey:     appPriv.PubKey(),
+			NewKey:     newAppPriv.PubKey(),
+			ChangedAt:  1000,
+			GraceUntil: 1200,
+		},
+	}, history.Records)
+}
+
+func TestRecoverAccountRevokesRetiringKey(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1000, 0)})
+	user1 := types.AccountKey("user1")
+	resetPriv, txPriv, _ := createTestAccount(ctx, am, string(user1))
+
+	err := am.UpdateTransactionKey(ctx, user1, secp256k1.GenPrivKey().PubKey(), 100)
+	assert.Nil(t, err)
+	_, err = am.CheckSigningPubKeyOwner(
+		ctx, user1, txPriv.PubKey(), types.TransactionPermission, types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+
+	newResetKey := secp256k1.GenPrivKey().PubKey()
+	err = am.RecoverAccount(ctx, user1, newResetKey,
+		secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey())
+	assert.Nil(t, err)
+	_, err = am.CheckSigningPubKeyOwner(
+		ctx, user1, txPriv.PubKey(), types.TransactionPermission, types.NewCoinFromInt64(0))
+	assert.Equal(t, ErrCheckTransactionKey(), err)
+
+	history, err := am.GetKeyHistory(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(history.Records))
+	assert.Equal(t, types.ResetPermission, history.Records[1].Permission)
+	assert.Equal(t, resetPriv.PubKey(), history.Records[1].OldKey)
+	assert.Equal(t, newResetKey, history.Records[1].NewKey)
+}
@@ -595,6 +595,7 @@ func (accManager AccountManager) AddIncomeAndReward(
 		Consumer:         consumer,
 		PostAuthor:       postAuthor,
 		PostID:           postID,
+		CreatedAt:        ctx.BlockHeader().Time.Unix(),
 	}
 	if err := accManager.AddRewardHistory(ctx, username, bank.NumOfReward,
 		rewardDetail); err != nil {
@@ -862,6 +863,14 @@ func (accManager AccountManager) CheckSigningPubKeyOwner(
 	if !accManager.DoesAccountExist(ctx, me) {
 		return "", ErrAccountNotFound(me)
 	}
+	// a single key can't sign for account which needs multi-signature for the permission
+	threshold, err := accManager.GetSignatureThreshold(ctx, me, permission)
+	if err != nil {
+		return "", err
+	}
+	if threshold > 0 {
+		return "", ErrCheckMultiSigKey(me)
+	}
 	// if permission is reset, only reset key can sign for the msg
 	if permission == types.ResetPermission {
 		pubKey, err := accManager.GetResetKey(ctx, me)
@@ -882,6 +891,14 @@ func (accManager AccountManager) CheckSigningPubKeyOwner(
 	if reflect.DeepEqual(pubKey, signKey) {
 		return me, nil
 	}
+	// old transaction key keeps valid during the grace period of key rotation
+	isRetiring, err := accManager.isRetiringKey(ctx, me, types.TransactionPermission, signKey)
+	if err != nil {
+		return "", err
+	}
+	if isRetiring {
+		return me, nil
+	}
 	if permission == types.TransactionPermission {
 		return "", ErrCheckTransactionKey()
 	}
@@ -895,6 +912,13 @@ func (accManager AccountManager) CheckSigningPubKeyOwner(
 		if reflect.DeepEqual(pubKey, signKey) {
 			return me, nil
 		}
+		isRetiring, err = accManager.isRetiringKey(ctx, me, types.AppPermission, signKey)
+		if err != nil {
+			return "", err
+		}
+		if isRetiring {
+			return me, nil
+		}
 	}
 
 	if permission == types.GrantAppPermission {
@@ -979,7 +1003,7 @@ func (accManager AccountManager) addPendingCoinDayToQueue(
 	return accManager.storage.SetPendingCoinDayQueue(ctx, username, pendingCoinDayQueue)
 }
 
-// RecoverAccount - reset three public key pairs
+// RecoverAccount - reset three public key pairs, retiring keys are revoked immediately
 func (accManager AccountManager) RecoverAccount(
 	ctx sdk.Context, username types.AccountKey,
 	newResetPubKey, newTransactionPubKey, newAppPubKey crypto.PubKey) sdk.Error {
@@ -988,13 +1012,26 @@ func (accManager AccountManager) RecoverAccount(
 		return err
 	}
 
+	now := ctx.BlockHeader().Time.Unix()
+	records := []model.KeyChangeRecord{
+		{Permission: types.ResetPermission, OldKey: accInfo.ResetKey, NewKey: newResetPubKey},
+		{Permission: types.TransactionPermission, OldKey: accInfo.TransactionKey, NewKey: newTransactionPubKey},
+		{Permission: types.AppPermission, OldKey: accInfo.AppKey, NewKey: newAppPubKey},
+	}
+	for i := range records {
+		records[i].ChangedAt = now
+		records[i].GraceUntil = now
+	}
+
 	accInfo.ResetKey = newResetPubKey
 	accInfo.TransactionKey = newTransactionPubKey
 	accInfo.AppKey = newAppPubKey
 	if err := accManager.storage.SetInfo(ctx, username, accInfo); err != nil {
 		return err
 	}
-	return nil
+	accManager.storage.DeleteRetiringKey(ctx, username, types.TransactionPermission)
+	accManager.storage.DeleteRetiringKey(ctx, username, types.AppPermission)
+	return accManager.addKeyChangeRecords(ctx, username, records...)
 }
 
 func (accManager AccountManager) updateTXFromPendingCoinDayQueue(
@@ -1100,6 +1137,25 @@ func (accManager AccountManager) IterateAccounts(ctx sdk.Context, process func(m
 	accManager.storage.IterateAccounts(ctx, process)
 }
 
+// GetAccountInfoPage - get a page of account info ordered by username
+func (accManager AccountManager) GetAccountInfoPage(
+	ctx sdk.Context, offset, limit int64) (*model.AccountInfoPage, sdk.Error) {
+	if offset < 0 || limit <= 0 || limit > types.MaximumAccountInfoPageSize {
+		return nil, ErrInvalidAccountInfoPage(offset, limit)
+	}
+	return accManager.storage.GetAccountInfoPage(ctx, offset, limit)
+}
+
+// Export - export account KVStore for genesis
+func (accManager AccountManager) Export(ctx sdk.Context) (*model.AccountTables, sdk.Error) {
+	return accManager.storage.Export(ctx)
+}
+
+// Import - import account KVStore from exported genesis
+func (accManager AccountManager) Import(ctx sdk.Context, tables *model.AccountTables) sdk.Error {
+	return accManager.storage.Import(ctx, tables)
+}
+
 func min(a, b int64) int64 {
 	if a < b {
 		return a
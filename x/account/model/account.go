@@ -17,6 +17,47 @@ type AccountInfo struct {
 	AppKey         crypto.PubKey    `json:"app_key"`
 }
 
+// MultiSig - M-of-N threshold of an account, if threshold is positive, transaction
+// and reset permission msgs must be signed by threshold distinct co-signers
+type MultiSig struct {
+	Threshold int64           `json:"threshold"`
+	CoSigners []crypto.PubKey `json:"co_signers"`
+}
+
+// RetiringKey - key replaced by key rotation, it keeps valid until expires
+type RetiringKey struct {
+	PubKey    crypto.PubKey `json:"pub_key"`
+	ExpiresAt int64         `json:"expires_at"`
+}
+
+// KeyChangeRecord - record of one key change, permission indicates which key is changed
+type KeyChangeRecord struct {
+	Permission types.Permission `json:"permission"`
+	OldKey     crypto.PubKey    `json:"old_key"`
+	NewKey     crypto.PubKey    `json:"new_key"`
+	ChangedAt  int64            `json:"changed_at"`
+	GraceUntil int64            `json:"grace_until"`
+}
+
+// KeyHistory - all key changes of an account for audit
+type KeyHistory struct {
+	Records []KeyChangeRecord `json:"records"`
+}
+
+// ScheduledTransfer - transfer executed at NextExecuteAt, repeated every IntervalSec
+// until RemainingTimes reaches zero. Funds are checked at execution time
+type ScheduledTransfer struct {
+	ID             int64            `json:"id"`
+	Sender         types.AccountKey `json:"sender"`
+	Receiver       types.AccountKey `json:"receiver"`
+	Amount         types.Coin       `json:"amount"`
+	Memo           string           `json:"memo"`
+	NextExecuteAt  int64            `json:"next_execute_at"`
+	IntervalSec    int64            `json:"interval_sec"`
+	RemainingTimes int64            `json:"remaining_times"`
+	CreatedAt      int64            `json:"created_at"`
+}
+
 // AccountBank - user balance
 type AccountBank struct {
 	Saving          types.Coin    `json:"saving"`
@@ -103,6 +144,7 @@ type RewardDetail struct {
 	Consumer         types.AccountKey `json:"consumer"`
 	PostAuthor       types.AccountKey `json:"post_author"`
 	PostID           string           `json:"post_id"`
+	CreatedAt        int64            `json:"created_at"`
 }
 
 // RewardHistory - reward history
@@ -110,6 +152,12 @@ type RewardHistory struct {
 	Details []RewardDetail `json:"details"`
 }
 
+// RewardHistoryPage - a page of reward details matching the history filter
+type RewardHistoryPage struct {
+	Details []RewardDetail `json:"details"`
+	HasMore bool           `json:"has_more"`
+}
+
 // Relationship - relation between two users
 type Relationship struct {
 	DonationTimes int64 `json:"donation_times"`
@@ -134,3 +182,15 @@ type Detail struct {
 	CreatedAt  int64                    `json:"created_at"`
 	Memo       string                   `json:"memo"`
 }
+
+// AccountInfoPage - a page of account info ordered by username
+type AccountInfoPage struct {
+	Accounts []AccountInfo `json:"accounts"`
+	HasMore  bool          `json:"has_more"`
+}
+
+// BalanceHistoryPage - a page of balance details matching the history filter
+type BalanceHistoryPage struct {
+	Details []Detail `json:"details"`
+	HasMore bool     `json:"has_more"`
+}
@@ -137,3 +137,53 @@ func ErrFailedToUnmarshalBalanceHistory(err error) sdk.Error {
 func ErrFailedToUnmarshalRewardHistory(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalRewardHistory, fmt.Sprintf("failed to unmarshal reward history: %s", err.Error()))
 }
+
+// ErrFailedToUnmarshalFollowerMeta - error if unmarshal follower meta failed
+func ErrFailedToUnmarshalFollowerMeta(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalFollowerMeta, fmt.Sprintf("failed to unmarshal follower meta: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalFollowingMeta - error if unmarshal following meta failed
+func ErrFailedToUnmarshalFollowingMeta(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalFollowingMeta, fmt.Sprintf("failed to unmarshal following meta: %s", err.Error()))
+}
+
+// ErrFailedToMarshalMultiSig - error if marshal multi-signature config failed
+func ErrFailedToMarshalMultiSig(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalMultiSig, fmt.Sprintf("failed to marshal multi-signature: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalMultiSig - error if unmarshal multi-signature config failed
+func ErrFailedToUnmarshalMultiSig(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalMultiSig, fmt.Sprintf("failed to unmarshal multi-signature: %s", err.Error()))
+}
+
+// ErrFailedToMarshalRetiringKey - error if marshal retiring key failed
+func ErrFailedToMarshalRetiringKey(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalRetiringKey, fmt.Sprintf("failed to marshal retiring key: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalRetiringKey - error if unmarshal retiring key failed
+func ErrFailedToUnmarshalRetiringKey(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalRetiringKey, fmt.Sprintf("failed to unmarshal retiring key: %s", err.Error()))
+}
+
+// ErrFailedToMarshalKeyHistory - error if marshal key history failed
+func ErrFailedToMarshalKeyHistory(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalKeyHistory, fmt.Sprintf("failed to marshal key history: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalKeyHistory - error if unmarshal key history failed
+func ErrFailedToUnmarshalKeyHistory(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalKeyHistory, fmt.Sprintf("failed to unmarshal key history: %s", err.Error()))
+}
+
+// ErrFailedToMarshalScheduledTransfer - error if marshal scheduled transfer failed
+func ErrFailedToMarshalScheduledTransfer(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalScheduledTransfer, fmt.Sprintf("failed to marshal scheduled transfer: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalScheduledTransfer - error if unmarshal scheduled transfer failed
+func ErrFailedToUnmarshalScheduledTransfer(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalScheduledTransfer, fmt.Sprintf("failed to unmarshal scheduled transfer: %s", err.Error()))
+}
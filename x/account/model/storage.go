@@ -3,6 +3,7 @@ package model
 import (
 	"encoding/hex"
 	"strconv"
+	"strings"
 
 	"github.com/lino-network/lino/types"
 	crypto "github.com/tendermint/tendermint/crypto"
@@ -23,6 +24,11 @@ var (
 	accountBalanceHistorySubstore      = []byte{0x08}
 	accountGrantPubKeySubstore         = []byte{0x09}
 	accountRewardHistorySubstore       = []byte{0x0a}
+	accountMultiSigSubstore            = []byte{0x0b}
+	accountRetiringKeySubstore         = []byte{0x0c}
+	accountKeyHistorySubstore          = []byte{0x0d}
+	accountScheduledTransferSubstore   = []byte{0x0e}
+	accountScheduledTransferIDSubstore = []byte{0x0f}
 )
 
 // AccountStorage - account storage
@@ -180,6 +186,199 @@ func (as AccountStorage) RemoveFollowingMeta(ctx sdk.Context, me types.AccountKe
 	return
 }
 
+// GetFollowers - returns all follower meta of a given account.
+func (as AccountStorage) GetFollowers(ctx sdk.Context, me types.AccountKey) ([]FollowerMeta, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	iter := sdk.KVStorePrefixIterator(store, getFollowerPrefix(me))
+	defer iter.Close()
+	followers := []FollowerMeta{}
+	for ; iter.Valid(); iter.Next() {
+		meta := FollowerMeta{}
+		if err := as.cdc.UnmarshalJSON(iter.Value(), &meta); err != nil {
+			return nil, ErrFailedToUnmarshalFollowerMeta(err)
+		}
+		followers = append(followers, meta)
+	}
+	return followers, nil
+}
+
+// GetMultiSig - returns multi-signature config of a given account, an empty config
+// with zero threshold is returned if the account doesn't enable multi-signature.
+func (as AccountStorage) GetMultiSig(ctx sdk.Context, me types.AccountKey) (*MultiSig, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	multiSigByte := store.Get(getMultiSigKey(me))
+	if multiSigByte == nil {
+		return &MultiSig{CoSigners: []crypto.PubKey{}}, nil
+	}
+	multiSig := new(MultiSig)
+	if err := as.cdc.UnmarshalJSON(multiSigByte, multiSig); err != nil {
+		return nil, ErrFailedToUnmarshalMultiSig(err)
+	}
+	return multiSig, nil
+}
+
+// SetMultiSig - sets multi-signature config of a given account.
+func (as AccountStorage) SetMultiSig(ctx sdk.Context, me types.AccountKey, multiSig *MultiSig) sdk.Error {
+	store := ctx.KVStore(as.key)
+	multiSigByte, err := as.cdc.MarshalJSON(*multiSig)
+	if err != nil {
+		return ErrFailedToMarshalMultiSig(err)
+	}
+	store.Set(getMultiSigKey(me), multiSigByte)
+	return nil
+}
+
+// GetRetiringKey - returns retiring key of given permission, returns nil if not found.
+func (as AccountStorage) GetRetiringKey(
+	ctx sdk.Context, me types.AccountKey, permission types.Permission) (*RetiringKey, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	retiringKeyByte := store.Get(getRetiringKeyKey(me, permission))
+	if retiringKeyByte == nil {
+		return nil, nil
+	}
+	retiringKey := new(RetiringKey)
+	if err := as.cdc.UnmarshalJSON(retiringKeyByte, retiringKey); err != nil {
+		return nil, ErrFailedToUnmarshalRetiringKey(err)
+	}
+	return retiringKey, nil
+}
+
+// SetRetiringKey - sets retiring key of given permission.
+func (as AccountStorage) SetRetiringKey(
+	ctx sdk.Context, me types.AccountKey, permission types.Permission, retiringKey *RetiringKey) sdk.Error {
+	store := ctx.KVStore(as.key)
+	retiringKeyByte, err := as.cdc.MarshalJSON(*retiringKey)
+	if err != nil {
+		return ErrFailedToMarshalRetiringKey(err)
+	}
+	store.Set(getRetiringKeyKey(me, permission), retiringKeyByte)
+	return nil
+}
+
+// DeleteRetiringKey - deletes retiring key of given permission.
+func (as AccountStorage) DeleteRetiringKey(ctx sdk.Context, me types.AccountKey, permission types.Permission) {
+	store := ctx.KVStore(as.key)
+	store.Delete(getRetiringKeyKey(me, permission))
+}
+
+// GetKeyHistory - returns key change history of a given account.
+func (as AccountStorage) GetKeyHistory(ctx sdk.Context, me types.AccountKey) (*KeyHistory, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	historyByte := store.Get(getKeyHistoryKey(me))
+	if historyByte == nil {
+		return &KeyHistory{Records: []KeyChangeRecord{}}, nil
+	}
+	history := new(KeyHistory)
+	if err := as.cdc.UnmarshalJSON(historyByte, history); err != nil {
+		return nil, ErrFailedToUnmarshalKeyHistory(err)
+	}
+	return history, nil
+}
+
+// SetKeyHistory - sets key change history of a given account.
+func (as AccountStorage) SetKeyHistory(ctx sdk.Context, me types.AccountKey, history *KeyHistory) sdk.Error {
+	store := ctx.KVStore(as.key)
+	historyByte, err := as.cdc.MarshalJSON(*history)
+	if err != nil {
+		return ErrFailedToMarshalKeyHistory(err)
+	}
+	store.Set(getKeyHistoryKey(me), historyByte)
+	return nil
+}
+
+// GetScheduledTransfer - returns scheduled transfer of given id, returns nil if not found.
+func (as AccountStorage) GetScheduledTransfer(
+	ctx sdk.Context, me types.AccountKey, id int64) (*ScheduledTransfer, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	transferByte := store.Get(getScheduledTransferKey(me, id))
+	if transferByte == nil {
+		return nil, nil
+	}
+	transfer := new(ScheduledTransfer)
+	if err := as.cdc.UnmarshalJSON(transferByte, transfer); err != nil {
+		return nil, ErrFailedToUnmarshalScheduledTransfer(err)
+	}
+	return transfer, nil
+}
+
+// SetScheduledTransfer - sets scheduled transfer, indexed by sender and id.
+func (as AccountStorage) SetScheduledTransfer(ctx sdk.Context, transfer *ScheduledTransfer) sdk.Error {
+	store := ctx.KVStore(as.key)
+	transferByte, err := as.cdc.MarshalJSON(*transfer)
+	if err != nil {
+		return ErrFailedToMarshalScheduledTransfer(err)
+	}
+	store.Set(getScheduledTransferKey(transfer.Sender, transfer.ID), transferByte)
+	return nil
+}
+
+// DeleteScheduledTransfer - deletes scheduled transfer of given id.
+func (as AccountStorage) DeleteScheduledTransfer(ctx sdk.Context, me types.AccountKey, id int64) {
+	store := ctx.KVStore(as.key)
+	store.Delete(getScheduledTransferKey(me, id))
+}
+
+// GetScheduledTransfers - returns all scheduled transfers of a given account.
+func (as AccountStorage) GetScheduledTransfers(
+	ctx sdk.Context, me types.AccountKey) ([]ScheduledTransfer, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	prefix := getScheduledTransferPrefix(me)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	transfers := []ScheduledTransfer{}
+	for ; iter.Valid(); iter.Next() {
+		transfer := ScheduledTransfer{}
+		if err := as.cdc.UnmarshalJSON(iter.Value(), &transfer); err != nil {
+			return nil, ErrFailedToUnmarshalScheduledTransfer(err)
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers, nil
+}
+
+// GetLastScheduledTransferID - returns last scheduled transfer id of a given account,
+// ids are never reused even if the scheduled transfer is cancelled.
+func (as AccountStorage) GetLastScheduledTransferID(ctx sdk.Context, me types.AccountKey) (int64, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	idByte := store.Get(getScheduledTransferIDKey(me))
+	if idByte == nil {
+		return 0, nil
+	}
+	var id int64
+	if err := as.cdc.UnmarshalJSON(idByte, &id); err != nil {
+		return 0, ErrFailedToUnmarshalScheduledTransfer(err)
+	}
+	return id, nil
+}
+
+// SetLastScheduledTransferID - sets last scheduled transfer id of a given account.
+func (as AccountStorage) SetLastScheduledTransferID(ctx sdk.Context, me types.AccountKey, id int64) sdk.Error {
+	store := ctx.KVStore(as.key)
+	idByte, err := as.cdc.MarshalJSON(id)
+	if err != nil {
+		return ErrFailedToMarshalScheduledTransfer(err)
+	}
+	store.Set(getScheduledTransferIDKey(me), idByte)
+	return nil
+}
+
+// GetFollowings - returns all following meta of a given account.
+func (as AccountStorage) GetFollowings(ctx sdk.Context, me types.AccountKey) ([]FollowingMeta, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	iter := sdk.KVStorePrefixIterator(store, getFollowingPrefix(me))
+	defer iter.Close()
+	followings := []FollowingMeta{}
+	for ; iter.Valid(); iter.Next() {
+		meta := FollowingMeta{}
+		if err := as.cdc.UnmarshalJSON(iter.Value(), &meta); err != nil {
+			return nil, ErrFailedToUnmarshalFollowingMeta(err)
+		}
+		followings = append(followings, meta)
+	}
+	return followings, nil
+}
+
 // GetReward - returns reward info of a given account, returns error if any.
 func (as AccountStorage) GetReward(ctx sdk.Context, accKey types.AccountKey) (*Reward, sdk.Error) {
 	store := ctx.KVStore(as.key)
@@ -426,26 +625,333 @@ func getRewardHistoryKey(me types.AccountKey, bucketSlot int64) []byte {
 	return strconv.AppendInt(getRewardHistoryPrefix(me), bucketSlot, 10)
 }
 
+func getMultiSigKey(me types.AccountKey) []byte {
+	return append(accountMultiSigSubstore, me...)
+}
+
+func getRetiringKeyKey(me types.AccountKey, permission types.Permission) []byte {
+	prefix := append(append(accountRetiringKeySubstore, me...), types.KeySeparator...)
+	return strconv.AppendInt(prefix, int64(permission), 10)
+}
+
+func getKeyHistoryKey(me types.AccountKey) []byte {
+	return append(accountKeyHistorySubstore, me...)
+}
+
+func getScheduledTransferPrefix(me types.AccountKey) []byte {
+	return append(append(accountScheduledTransferSubstore, me...), types.KeySeparator...)
+}
+
+func getScheduledTransferKey(me types.AccountKey, id int64) []byte {
+	return strconv.AppendInt(getScheduledTransferPrefix(me), id, 10)
+}
+
+func getScheduledTransferIDKey(me types.AccountKey) []byte {
+	return append(accountScheduledTransferIDSubstore, me...)
+}
+
 // IterateAccounts - iterate accounts in KVStore
 func (as AccountStorage) IterateAccounts(ctx sdk.Context, process func(AccountInfo, AccountBank) (stop bool)) {
 	store := ctx.KVStore(as.key)
 	iter := sdk.KVStorePrefixIterator(store, accountInfoSubstore)
-	for {
-		if !iter.Valid() {
-			return
-		}
-		val := iter.Value()
-		accInfo, err := as.GetInfo(ctx, types.AccountKey(val))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		accKey := types.AccountKey(iter.Key()[len(accountInfoSubstore):])
+		accInfo, err := as.GetInfo(ctx, accKey)
 		if err != nil {
 			panic(err)
 		}
-		accBank, err := as.GetBankFromAccountKey(ctx, types.AccountKey(val))
+		accBank, err := as.GetBankFromAccountKey(ctx, accKey)
 		if err != nil {
 			panic(err)
 		}
 		if process(*accInfo, *accBank) {
 			return
 		}
-		iter.Next()
 	}
 }
+
+// GetAccountInfoPage - get at most limit account info after skipping offset accounts,
+// ordered by username
+func (as AccountStorage) GetAccountInfoPage(ctx sdk.Context, offset, limit int64) (*AccountInfoPage, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	iter := sdk.KVStorePrefixIterator(store, accountInfoSubstore)
+	defer iter.Close()
+	page := &AccountInfoPage{Accounts: []AccountInfo{}}
+	for skipped := int64(0); iter.Valid() && skipped < offset; iter.Next() {
+		skipped++
+	}
+	for ; iter.Valid(); iter.Next() {
+		if int64(len(page.Accounts)) == limit {
+			page.HasMore = true
+			break
+		}
+		info := new(AccountInfo)
+		if err := as.cdc.UnmarshalJSON(iter.Value(), info); err != nil {
+			return nil, ErrFailedToUnmarshalAccountInfo(err)
+		}
+		page.Accounts = append(page.Accounts, *info)
+	}
+	return page, nil
+}
+
+// Export - export all records in account KVStore
+func (as AccountStorage) Export(ctx sdk.Context) (*AccountTables, sdk.Error) {
+	tables := &AccountTables{}
+	store := ctx.KVStore(as.key)
+
+	infoIter := sdk.KVStorePrefixIterator(store, accountInfoSubstore)
+	defer infoIter.Close()
+	for ; infoIter.Valid(); infoIter.Next() {
+		accKey := types.AccountKey(infoIter.Key()[len(accountInfoSubstore):])
+		info, err := as.GetInfo(ctx, accKey)
+		if err != nil {
+			return nil, err
+		}
+		bank, err := as.GetBankFromAccountKey(ctx, accKey)
+		if err != nil {
+			return nil, err
+		}
+		meta, err := as.GetMeta(ctx, accKey)
+		if err != nil {
+			return nil, err
+		}
+		reward, err := as.GetReward(ctx, accKey)
+		if err != nil {
+			return nil, err
+		}
+		queue, err := as.GetPendingCoinDayQueue(ctx, accKey)
+		if err != nil {
+			return nil, err
+		}
+		tables.Accounts = append(tables.Accounts, AccountRow{
+			Username:            accKey,
+			Info:                *info,
+			Bank:                *bank,
+			Meta:                *meta,
+			Reward:              *reward,
+			PendingCoinDayQueue: *queue,
+		})
+	}
+
+	followerIter := sdk.KVStorePrefixIterator(store, accountFollowerSubstore)
+	defer followerIter.Close()
+	for ; followerIter.Valid(); followerIter.Next() {
+		me, _ := splitKey(followerIter.Key(), accountFollowerSubstore)
+		row := FollowerRow{Me: me}
+		if err := as.cdc.UnmarshalJSON(followerIter.Value(), &row.Meta); err != nil {
+			return nil, ErrFailedToUnmarshalFollowerMeta(err)
+		}
+		tables.Followers = append(tables.Followers, row)
+	}
+
+	followingIter := sdk.KVStorePrefixIterator(store, accountFollowingSubstore)
+	defer followingIter.Close()
+	for ; followingIter.Valid(); followingIter.Next() {
+		me, _ := splitKey(followingIter.Key(), accountFollowingSubstore)
+		row := FollowingRow{Me: me}
+		if err := as.cdc.UnmarshalJSON(followingIter.Value(), &row.Meta); err != nil {
+			return nil, ErrFailedToUnmarshalFollowingMeta(err)
+		}
+		tables.Followings = append(tables.Followings, row)
+	}
+
+	relationshipIter := sdk.KVStorePrefixIterator(store, accountRelationshipSubstore)
+	defer relationshipIter.Close()
+	for ; relationshipIter.Valid(); relationshipIter.Next() {
+		me, other := splitKey(relationshipIter.Key(), accountRelationshipSubstore)
+		row := RelationshipRow{Me: me, Other: types.AccountKey(other)}
+		if err := as.cdc.UnmarshalJSON(relationshipIter.Value(), &row.Relationship); err != nil {
+			return nil, ErrFailedToUnmarshalRelationship(err)
+		}
+		tables.Relationships = append(tables.Relationships, row)
+	}
+
+	grantIter := sdk.KVStorePrefixIterator(store, accountGrantPubKeySubstore)
+	defer grantIter.Close()
+	for ; grantIter.Valid(); grantIter.Next() {
+		me, pubKey := splitKey(grantIter.Key(), accountGrantPubKeySubstore)
+		row := GrantPubKeyRow{Username: me, PubKey: pubKey}
+		if err := as.cdc.UnmarshalJSON(grantIter.Value(), &row.GrantPubKey); err != nil {
+			return nil, ErrFailedToUnmarshalGrantPubKey(err)
+		}
+		tables.GrantPubKeys = append(tables.GrantPubKeys, row)
+	}
+
+	balanceIter := sdk.KVStorePrefixIterator(store, accountBalanceHistorySubstore)
+	defer balanceIter.Close()
+	for ; balanceIter.Valid(); balanceIter.Next() {
+		me, slot := splitKey(balanceIter.Key(), accountBalanceHistorySubstore)
+		bucket, parseErr := strconv.ParseInt(slot, 10, 64)
+		if parseErr != nil {
+			return nil, ErrFailedToUnmarshalBalanceHistory(parseErr)
+		}
+		row := BalanceHistoryRow{Username: me, Bucket: bucket}
+		if err := as.cdc.UnmarshalJSON(balanceIter.Value(), &row.History); err != nil {
+			return nil, ErrFailedToUnmarshalBalanceHistory(err)
+		}
+		tables.BalanceHistories = append(tables.BalanceHistories, row)
+	}
+
+	rewardIter := sdk.KVStorePrefixIterator(store, accountRewardHistorySubstore)
+	defer rewardIter.Close()
+	for ; rewardIter.Valid(); rewardIter.Next() {
+		me, slot := splitKey(rewardIter.Key(), accountRewardHistorySubstore)
+		bucket, parseErr := strconv.ParseInt(slot, 10, 64)
+		if parseErr != nil {
+			return nil, ErrFailedToUnmarshalRewardHistory(parseErr)
+		}
+		row := RewardHistoryRow{Username: me, Bucket: bucket}
+		if err := as.cdc.UnmarshalJSON(rewardIter.Value(), &row.History); err != nil {
+			return nil, ErrFailedToUnmarshalRewardHistory(err)
+		}
+		tables.RewardHistories = append(tables.RewardHistories, row)
+	}
+
+	multiSigIter := sdk.KVStorePrefixIterator(store, accountMultiSigSubstore)
+	defer multiSigIter.Close()
+	for ; multiSigIter.Valid(); multiSigIter.Next() {
+		row := MultiSigRow{Username: types.AccountKey(multiSigIter.Key()[len(accountMultiSigSubstore):])}
+		if err := as.cdc.UnmarshalJSON(multiSigIter.Value(), &row.MultiSig); err != nil {
+			return nil, ErrFailedToUnmarshalMultiSig(err)
+		}
+		tables.MultiSigs = append(tables.MultiSigs, row)
+	}
+
+	retiringKeyIter := sdk.KVStorePrefixIterator(store, accountRetiringKeySubstore)
+	defer retiringKeyIter.Close()
+	for ; retiringKeyIter.Valid(); retiringKeyIter.Next() {
+		me, permissionStr := splitKey(retiringKeyIter.Key(), accountRetiringKeySubstore)
+		permission, parseErr := strconv.Atoi(permissionStr)
+		if parseErr != nil {
+			return nil, ErrFailedToUnmarshalRetiringKey(parseErr)
+		}
+		row := RetiringKeyRow{Username: me, Permission: types.Permission(permission)}
+		if err := as.cdc.UnmarshalJSON(retiringKeyIter.Value(), &row.RetiringKey); err != nil {
+			return nil, ErrFailedToUnmarshalRetiringKey(err)
+		}
+		tables.RetiringKeys = append(tables.RetiringKeys, row)
+	}
+
+	keyHistoryIter := sdk.KVStorePrefixIterator(store, accountKeyHistorySubstore)
+	defer keyHistoryIter.Close()
+	for ; keyHistoryIter.Valid(); keyHistoryIter.Next() {
+		row := KeyHistoryRow{Username: types.AccountKey(keyHistoryIter.Key()[len(accountKeyHistorySubstore):])}
+		if err := as.cdc.UnmarshalJSON(keyHistoryIter.Value(), &row.History); err != nil {
+			return nil, ErrFailedToUnmarshalKeyHistory(err)
+		}
+		tables.KeyHistories = append(tables.KeyHistories, row)
+	}
+
+	scheduledTransferIter := sdk.KVStorePrefixIterator(store, accountScheduledTransferSubstore)
+	defer scheduledTransferIter.Close()
+	for ; scheduledTransferIter.Valid(); scheduledTransferIter.Next() {
+		transfer := ScheduledTransfer{}
+		if err := as.cdc.UnmarshalJSON(scheduledTransferIter.Value(), &transfer); err != nil {
+			return nil, ErrFailedToUnmarshalScheduledTransfer(err)
+		}
+		tables.ScheduledTransfers = append(tables.ScheduledTransfers, transfer)
+	}
+
+	scheduledTransferIDIter := sdk.KVStorePrefixIterator(store, accountScheduledTransferIDSubstore)
+	defer scheduledTransferIDIter.Close()
+	for ; scheduledTransferIDIter.Valid(); scheduledTransferIDIter.Next() {
+		row := ScheduledTransferIDRow{
+			Username: types.AccountKey(scheduledTransferIDIter.Key()[len(accountScheduledTransferIDSubstore):])}
+		if err := as.cdc.UnmarshalJSON(scheduledTransferIDIter.Value(), &row.LastID); err != nil {
+			return nil, ErrFailedToUnmarshalScheduledTransfer(err)
+		}
+		tables.ScheduledTransferIDs = append(tables.ScheduledTransferIDs, row)
+	}
+	return tables, nil
+}
+
+// Import - import all records exported by Export to account KVStore
+func (as AccountStorage) Import(ctx sdk.Context, tables *AccountTables) sdk.Error {
+	for _, row := range tables.Accounts {
+		if err := as.SetInfo(ctx, row.Username, &row.Info); err != nil {
+			return err
+		}
+		if err := as.SetBankFromAccountKey(ctx, row.Username, &row.Bank); err != nil {
+			return err
+		}
+		if err := as.SetMeta(ctx, row.Username, &row.Meta); err != nil {
+			return err
+		}
+		if err := as.SetReward(ctx, row.Username, &row.Reward); err != nil {
+			return err
+		}
+		if err := as.SetPendingCoinDayQueue(ctx, row.Username, &row.PendingCoinDayQueue); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.Followers {
+		if err := as.SetFollowerMeta(ctx, row.Me, row.Meta); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.Followings {
+		if err := as.SetFollowingMeta(ctx, row.Me, row.Meta); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.Relationships {
+		if err := as.SetRelationship(ctx, row.Me, row.Other, &row.Relationship); err != nil {
+			return err
+		}
+	}
+	store := ctx.KVStore(as.key)
+	for _, row := range tables.GrantPubKeys {
+		grantPubKeyByte, err := as.cdc.MarshalJSON(row.GrantPubKey)
+		if err != nil {
+			return ErrFailedToMarshalGrantPubKey(err)
+		}
+		// pubkey is kept hex encoded as in KVStore key
+		store.Set(append(getGrantPubKeyPrefix(row.Username), row.PubKey...), grantPubKeyByte)
+	}
+	for _, row := range tables.BalanceHistories {
+		if err := as.SetBalanceHistory(ctx, row.Username, row.Bucket, &row.History); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.RewardHistories {
+		if err := as.SetRewardHistory(ctx, row.Username, row.Bucket, &row.History); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.MultiSigs {
+		if err := as.SetMultiSig(ctx, row.Username, &row.MultiSig); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.RetiringKeys {
+		if err := as.SetRetiringKey(ctx, row.Username, row.Permission, &row.RetiringKey); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.KeyHistories {
+		if err := as.SetKeyHistory(ctx, row.Username, &row.History); err != nil {
+			return err
+		}
+	}
+	for i := range tables.ScheduledTransfers {
+		if err := as.SetScheduledTransfer(ctx, &tables.ScheduledTransfers[i]); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.ScheduledTransferIDs {
+		if err := as.SetLastScheduledTransferID(ctx, row.Username, row.LastID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitKey - split "substore" + "me" + "separator" + "other" into me and other
+func splitKey(key []byte, substore []byte) (types.AccountKey, string) {
+	parts := strings.SplitN(string(key[len(substore):]), types.KeySeparator, 2)
+	if len(parts) < 2 {
+		return types.AccountKey(parts[0]), ""
+	}
+	return types.AccountKey(parts[0]), parts[1]
+}
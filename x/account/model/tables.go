@@ -0,0 +1,96 @@
+package model
+
+import (
+	"github.com/lino-network/lino/types"
+)
+
+// AccountRow - all per-account records, keyed by username
+type AccountRow struct {
+	Username            types.AccountKey    `json:"username"`
+	Info                AccountInfo         `json:"info"`
+	Bank                AccountBank         `json:"bank"`
+	Meta                AccountMeta         `json:"meta"`
+	Reward              Reward              `json:"reward"`
+	PendingCoinDayQueue PendingCoinDayQueue `json:"pending_coin_day_queue"`
+}
+
+// FollowerRow - follower of an account
+type FollowerRow struct {
+	Me   types.AccountKey `json:"me"`
+	Meta FollowerMeta     `json:"meta"`
+}
+
+// FollowingRow - following of an account
+type FollowingRow struct {
+	Me   types.AccountKey `json:"me"`
+	Meta FollowingMeta    `json:"meta"`
+}
+
+// RelationshipRow - relationship between two accounts
+type RelationshipRow struct {
+	Me           types.AccountKey `json:"me"`
+	Other        types.AccountKey `json:"other"`
+	Relationship Relationship     `json:"relationship"`
+}
+
+// GrantPubKeyRow - pubkey granted by an account, pubkey is hex encoded as in KVStore key
+type GrantPubKeyRow struct {
+	Username    types.AccountKey `json:"username"`
+	PubKey      string           `json:"pub_key"`
+	GrantPubKey GrantPubKey      `json:"grant_pub_key"`
+}
+
+// BalanceHistoryRow - balance history bucket of an account
+type BalanceHistoryRow struct {
+	Username types.AccountKey `json:"username"`
+	Bucket   int64            `json:"bucket"`
+	History  BalanceHistory   `json:"history"`
+}
+
+// RewardHistoryRow - reward history bucket of an account
+type RewardHistoryRow struct {
+	Username types.AccountKey `json:"username"`
+	Bucket   int64            `json:"bucket"`
+	History  RewardHistory    `json:"history"`
+}
+
+// MultiSigRow - multi-signature config of an account
+type MultiSigRow struct {
+	Username types.AccountKey `json:"username"`
+	MultiSig MultiSig         `json:"multi_sig"`
+}
+
+// RetiringKeyRow - retiring key of an account, permission indicates which key is retiring
+type RetiringKeyRow struct {
+	Username    types.AccountKey `json:"username"`
+	Permission  types.Permission `json:"permission"`
+	RetiringKey RetiringKey      `json:"retiring_key"`
+}
+
+// KeyHistoryRow - key change history of an account
+type KeyHistoryRow struct {
+	Username types.AccountKey `json:"username"`
+	History  KeyHistory       `json:"history"`
+}
+
+// ScheduledTransferIDRow - last scheduled transfer id of an account
+type ScheduledTransferIDRow struct {
+	Username types.AccountKey `json:"username"`
+	LastID   int64            `json:"last_id"`
+}
+
+// AccountTables - state of account KVStore
+type AccountTables struct {
+	Accounts             []AccountRow             `json:"accounts"`
+	Followers            []FollowerRow            `json:"followers"`
+	Followings           []FollowingRow           `json:"followings"`
+	Relationships        []RelationshipRow        `json:"relationships"`
+	GrantPubKeys         []GrantPubKeyRow         `json:"grant_pub_keys"`
+	BalanceHistories     []BalanceHistoryRow      `json:"balance_histories"`
+	RewardHistories      []RewardHistoryRow       `json:"reward_histories"`
+	MultiSigs            []MultiSigRow            `json:"multi_sigs"`
+	RetiringKeys         []RetiringKeyRow         `json:"retiring_keys"`
+	KeyHistories         []KeyHistoryRow          `json:"key_histories"`
+	ScheduledTransfers   []ScheduledTransfer      `json:"scheduled_transfers"`
+	ScheduledTransferIDs []ScheduledTransferIDRow `json:"scheduled_transfer_ids"`
+}
@@ -18,6 +18,13 @@ var _ types.Msg = TransferMsg{}
 var _ types.Msg = RecoverMsg{}
 var _ types.Msg = RegisterMsg{}
 var _ types.Msg = UpdateAccountMsg{}
+var _ types.Msg = AddCoSignerMsg{}
+var _ types.Msg = RemoveCoSignerMsg{}
+var _ types.Msg = ChangeThresholdMsg{}
+var _ types.Msg = UpdateTransactionKeyMsg{}
+var _ types.Msg = UpdateAppKeyMsg{}
+var _ types.Msg = CreateScheduledTransferMsg{}
+var _ types.Msg = CancelScheduledTransferMsg{}
 
 // RegisterMsg - bind username with public key, need to be referred by others (pay for it)
 type RegisterMsg struct {
@@ -68,6 +75,59 @@ type UpdateAccountMsg struct {
 	JSONMeta string           `json:"json_meta"`
 }
 
+// AddCoSignerMsg - add a co-signer public key to multi-signature account
+type AddCoSignerMsg struct {
+	Username types.AccountKey `json:"username"`
+	CoSigner crypto.PubKey    `json:"co_signer"`
+}
+
+// RemoveCoSignerMsg - remove a co-signer public key from multi-signature account
+type RemoveCoSignerMsg struct {
+	Username types.AccountKey `json:"username"`
+	CoSigner crypto.PubKey    `json:"co_signer"`
+}
+
+// ChangeThresholdMsg - change the number of co-signers needed to sign
+// transaction and reset permission msgs, zero threshold disables multi-signature
+type ChangeThresholdMsg struct {
+	Username  types.AccountKey `json:"username"`
+	Threshold int64            `json:"threshold"`
+}
+
+// UpdateTransactionKeyMsg - replace transaction key, signed by reset key.
+// Old transaction key keeps valid during the grace period
+type UpdateTransactionKeyMsg struct {
+	Username             types.AccountKey `json:"username"`
+	NewTransactionPubKey crypto.PubKey    `json:"new_transaction_public_key"`
+	GracePeriodSec       int64            `json:"grace_period_sec"`
+}
+
+// UpdateAppKeyMsg - replace app key, signed by transaction key.
+// Old app key keeps valid during the grace period
+type UpdateAppKeyMsg struct {
+	Username       types.AccountKey `json:"username"`
+	NewAppPubKey   crypto.PubKey    `json:"new_app_public_key"`
+	GracePeriodSec int64            `json:"grace_period_sec"`
+}
+
+// CreateScheduledTransferMsg - sender schedules a transfer to receiver, executed
+// first at start time and then every interval until it's executed given times
+type CreateScheduledTransferMsg struct {
+	Sender      types.AccountKey `json:"sender"`
+	Receiver    types.AccountKey `json:"receiver"`
+	Amount      types.LNO        `json:"amount"`
+	Memo        string           `json:"memo"`
+	StartTime   int64            `json:"start_time"`
+	IntervalSec int64            `json:"interval_sec"`
+	Times       int64            `json:"times"`
+}
+
+// CancelScheduledTransferMsg - sender cancels a scheduled transfer
+type CancelScheduledTransferMsg struct {
+	Sender     types.AccountKey `json:"sender"`
+	ScheduleID int64            `json:"schedule_id"`
+}
+
 // NewFollowMsg - return a FollowMsg
 func NewFollowMsg(follower string, followee string) FollowMsg {
 	return FollowMsg{
@@ -460,3 +520,394 @@ func (msg UpdateAccountMsg) GetSigners() []sdk.AccAddress {
 func (msg UpdateAccountMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// NewAddCoSignerMsg - construct add co-signer msg
+func NewAddCoSignerMsg(username string, coSigner crypto.PubKey) AddCoSignerMsg {
+	return AddCoSignerMsg{
+		Username: types.AccountKey(username),
+		CoSigner: coSigner,
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg AddCoSignerMsg) Type() string { return types.AccountRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg AddCoSignerMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername("illegal length")
+	}
+	if msg.CoSigner == nil {
+		return ErrInvalidCoSigner()
+	}
+	return nil
+}
+
+func (msg AddCoSignerMsg) String() string {
+	return fmt.Sprintf("AddCoSignerMsg{User:%v, Co-signer:%v}", msg.Username, msg.CoSigner)
+}
+
+// GetPermission - implements types.Msg
+func (msg AddCoSignerMsg) GetPermission() types.Permission {
+	return types.ResetPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg AddCoSignerMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg AddCoSignerMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg AddCoSignerMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// NewRemoveCoSignerMsg - construct remove co-signer msg
+func NewRemoveCoSignerMsg(username string, coSigner crypto.PubKey) RemoveCoSignerMsg {
+	return RemoveCoSignerMsg{
+		Username: types.AccountKey(username),
+		CoSigner: coSigner,
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg RemoveCoSignerMsg) Type() string { return types.AccountRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg RemoveCoSignerMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername("illegal length")
+	}
+	if msg.CoSigner == nil {
+		return ErrInvalidCoSigner()
+	}
+	return nil
+}
+
+func (msg RemoveCoSignerMsg) String() string {
+	return fmt.Sprintf("RemoveCoSignerMsg{User:%v, Co-signer:%v}", msg.Username, msg.CoSigner)
+}
+
+// GetPermission - implements types.Msg
+func (msg RemoveCoSignerMsg) GetPermission() types.Permission {
+	return types.ResetPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg RemoveCoSignerMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg RemoveCoSignerMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg RemoveCoSignerMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// NewChangeThresholdMsg - construct change threshold msg
+func NewChangeThresholdMsg(username string, threshold int64) ChangeThresholdMsg {
+	return ChangeThresholdMsg{
+		Username:  types.AccountKey(username),
+		Threshold: threshold,
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg ChangeThresholdMsg) Type() string { return types.AccountRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg ChangeThresholdMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername("illegal length")
+	}
+	if msg.Threshold < 0 || msg.Threshold > types.MaximumNumOfCoSigners {
+		return ErrInvalidThreshold(fmt.Sprintf("invalid threshold %v", msg.Threshold))
+	}
+	return nil
+}
+
+func (msg ChangeThresholdMsg) String() string {
+	return fmt.Sprintf("ChangeThresholdMsg{User:%v, Threshold:%v}", msg.Username, msg.Threshold)
+}
+
+// GetPermission - implements types.Msg
+func (msg ChangeThresholdMsg) GetPermission() types.Permission {
+	return types.ResetPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ChangeThresholdMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ChangeThresholdMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg ChangeThresholdMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// NewUpdateTransactionKeyMsg - construct update transaction key msg
+func NewUpdateTransactionKeyMsg(
+	username string, newTransactionPubKey crypto.PubKey, gracePeriodSec int64) UpdateTransactionKeyMsg {
+	return UpdateTransactionKeyMsg{
+		Username:             types.AccountKey(username),
+		NewTransactionPubKey: newTransactionPubKey,
+		GracePeriodSec:       gracePeriodSec,
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg UpdateTransactionKeyMsg) Type() string { return types.AccountRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg UpdateTransactionKeyMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername("illegal length")
+	}
+	if msg.NewTransactionPubKey == nil {
+		return ErrInvalidNewKey("new transaction key is empty")
+	}
+	if msg.GracePeriodSec < 0 || msg.GracePeriodSec > types.MaximumKeyGracePeriodSec {
+		return ErrInvalidGracePeriod(msg.GracePeriodSec)
+	}
+	return nil
+}
+
+func (msg UpdateTransactionKeyMsg) String() string {
+	return fmt.Sprintf("UpdateTransactionKeyMsg{User:%v, New transaction key:%v, Grace period:%v}",
+		msg.Username, msg.NewTransactionPubKey, msg.GracePeriodSec)
+}
+
+// GetPermission - implements types.Msg
+func (msg UpdateTransactionKeyMsg) GetPermission() types.Permission {
+	return types.ResetPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg UpdateTransactionKeyMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg UpdateTransactionKeyMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg UpdateTransactionKeyMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// NewUpdateAppKeyMsg - construct update app key msg
+func NewUpdateAppKeyMsg(username string, newAppPubKey crypto.PubKey, gracePeriodSec int64) UpdateAppKeyMsg {
+	return UpdateAppKeyMsg{
+		Username:       types.AccountKey(username),
+		NewAppPubKey:   newAppPubKey,
+		GracePeriodSec: gracePeriodSec,
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg UpdateAppKeyMsg) Type() string { return types.AccountRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg UpdateAppKeyMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername("illegal length")
+	}
+	if msg.NewAppPubKey == nil {
+		return ErrInvalidNewKey("new app key is empty")
+	}
+	if msg.GracePeriodSec < 0 || msg.GracePeriodSec > types.MaximumKeyGracePeriodSec {
+		return ErrInvalidGracePeriod(msg.GracePeriodSec)
+	}
+	return nil
+}
+
+func (msg UpdateAppKeyMsg) String() string {
+	return fmt.Sprintf("UpdateAppKeyMsg{User:%v, New app key:%v, Grace period:%v}",
+		msg.Username, msg.NewAppPubKey, msg.GracePeriodSec)
+}
+
+// GetPermission - implements types.Msg
+func (msg UpdateAppKeyMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg UpdateAppKeyMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg UpdateAppKeyMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg UpdateAppKeyMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// NewCreateScheduledTransferMsg - construct create scheduled transfer msg
+func NewCreateScheduledTransferMsg(
+	sender, receiver string, amount types.LNO, memo string,
+	startTime, intervalSec, times int64) CreateScheduledTransferMsg {
+	return CreateScheduledTransferMsg{
+		Sender:      types.AccountKey(sender),
+		Receiver:    types.AccountKey(receiver),
+		Amount:      amount,
+		Memo:        memo,
+		StartTime:   startTime,
+		IntervalSec: intervalSec,
+		Times:       times,
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg CreateScheduledTransferMsg) Type() string { return types.AccountRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg CreateScheduledTransferMsg) ValidateBasic() sdk.Error {
+	if len(msg.Sender) < types.MinimumUsernameLength ||
+		len(msg.Sender) > types.MaximumUsernameLength ||
+		len(msg.Receiver) < types.MinimumUsernameLength ||
+		len(msg.Receiver) > types.MaximumUsernameLength {
+		return ErrInvalidUsername("illegal length")
+	}
+	if _, err := types.LinoToCoin(msg.Amount); err != nil {
+		return err
+	}
+	if len(msg.Memo) > types.MaximumMemoLength {
+		return ErrInvalidMemo()
+	}
+	if msg.StartTime < 0 {
+		return ErrInvalidScheduledTransfer(fmt.Sprintf("invalid start time %v", msg.StartTime))
+	}
+	if msg.Times <= 0 || msg.Times > types.MaximumScheduledTransferTimes {
+		return ErrInvalidScheduledTransfer(fmt.Sprintf("invalid times %v", msg.Times))
+	}
+	if msg.Times > 1 && msg.IntervalSec < types.MinimumScheduledTransferIntervalSec {
+		return ErrInvalidScheduledTransfer(fmt.Sprintf("invalid interval %v", msg.IntervalSec))
+	}
+	return nil
+}
+
+func (msg CreateScheduledTransferMsg) String() string {
+	return fmt.Sprintf("CreateScheduledTransferMsg{Sender:%v, Receiver:%v, Amount:%v, Memo:%v, "+
+		"Start time:%v, Interval:%v, Times:%v}", msg.Sender, msg.Receiver, msg.Amount, msg.Memo,
+		msg.StartTime, msg.IntervalSec, msg.Times)
+}
+
+// GetPermission - implements types.Msg
+func (msg CreateScheduledTransferMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg CreateScheduledTransferMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg CreateScheduledTransferMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Sender)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg CreateScheduledTransferMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// NewCancelScheduledTransferMsg - construct cancel scheduled transfer msg
+func NewCancelScheduledTransferMsg(sender string, scheduleID int64) CancelScheduledTransferMsg {
+	return CancelScheduledTransferMsg{
+		Sender:     types.AccountKey(sender),
+		ScheduleID: scheduleID,
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg CancelScheduledTransferMsg) Type() string { return types.AccountRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg CancelScheduledTransferMsg) ValidateBasic() sdk.Error {
+	if len(msg.Sender) < types.MinimumUsernameLength ||
+		len(msg.Sender) > types.MaximumUsernameLength {
+		return ErrInvalidUsername("illegal length")
+	}
+	if msg.ScheduleID <= 0 {
+		return ErrInvalidScheduledTransfer(fmt.Sprintf("invalid schedule id %v", msg.ScheduleID))
+	}
+	return nil
+}
+
+func (msg CancelScheduledTransferMsg) String() string {
+	return fmt.Sprintf("CancelScheduledTransferMsg{Sender:%v, Schedule id:%v}", msg.Sender, msg.ScheduleID)
+}
+
+// GetPermission - implements types.Msg
+func (msg CancelScheduledTransferMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg CancelScheduledTransferMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg CancelScheduledTransferMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Sender)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg CancelScheduledTransferMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
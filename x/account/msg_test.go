@@ -305,6 +305,181 @@ func TestUpdateAccountMsg(t *testing.T) {
 	}
 }
 
+func TestMultiSigMsgs(t *testing.T) {
+	testCases := map[string]struct {
+		msg      types.Msg
+		wantCode sdk.CodeType
+	}{
+		"normal case - add co-signer": {
+			msg:      NewAddCoSignerMsg("userA", secp256k1.GenPrivKey().PubKey()),
+			wantCode: sdk.CodeOK,
+		},
+		"add co-signer with invalid username": {
+			msg:      NewAddCoSignerMsg("us", secp256k1.GenPrivKey().PubKey()),
+			wantCode: types.CodeInvalidUsername,
+		},
+		"add nil co-signer": {
+			msg:      NewAddCoSignerMsg("userA", nil),
+			wantCode: types.CodeInvalidCoSigner,
+		},
+		"normal case - remove co-signer": {
+			msg:      NewRemoveCoSignerMsg("userA", secp256k1.GenPrivKey().PubKey()),
+			wantCode: sdk.CodeOK,
+		},
+		"remove nil co-signer": {
+			msg:      NewRemoveCoSignerMsg("userA", nil),
+			wantCode: types.CodeInvalidCoSigner,
+		},
+		"normal case - change threshold": {
+			msg:      NewChangeThresholdMsg("userA", 2),
+			wantCode: sdk.CodeOK,
+		},
+		"normal case - disable multi-signature": {
+			msg:      NewChangeThresholdMsg("userA", 0),
+			wantCode: sdk.CodeOK,
+		},
+		"negative threshold": {
+			msg:      NewChangeThresholdMsg("userA", -1),
+			wantCode: types.CodeInvalidThreshold,
+		},
+		"threshold exceeds max number of co-signers": {
+			msg:      NewChangeThresholdMsg("userA", types.MaximumNumOfCoSigners+1),
+			wantCode: types.CodeInvalidThreshold,
+		},
+	}
+
+	for testName, tc := range testCases {
+		got := tc.msg.ValidateBasic()
+		if got == nil {
+			if tc.wantCode != sdk.CodeOK {
+				t.Errorf("%s: diff error: got %v, want %v", testName, sdk.CodeOK, tc.wantCode)
+			}
+			continue
+		}
+		if got.Code() != tc.wantCode {
+			t.Errorf("%s: diff error code: got %v, want %v", testName, got.Code(), tc.wantCode)
+		}
+	}
+}
+
+func TestUpdateKeyMsgs(t *testing.T) {
+	testCases := map[string]struct {
+		msg      types.Msg
+		wantCode sdk.CodeType
+	}{
+		"normal case - update transaction key": {
+			msg:      NewUpdateTransactionKeyMsg("userA", secp256k1.GenPrivKey().PubKey(), 3600),
+			wantCode: sdk.CodeOK,
+		},
+		"update transaction key without grace period": {
+			msg:      NewUpdateTransactionKeyMsg("userA", secp256k1.GenPrivKey().PubKey(), 0),
+			wantCode: sdk.CodeOK,
+		},
+		"update transaction key with invalid username": {
+			msg:      NewUpdateTransactionKeyMsg("us", secp256k1.GenPrivKey().PubKey(), 0),
+			wantCode: types.CodeInvalidUsername,
+		},
+		"update transaction key with nil key": {
+			msg:      NewUpdateTransactionKeyMsg("userA", nil, 0),
+			wantCode: types.CodeInvalidNewKey,
+		},
+		"update transaction key with negative grace period": {
+			msg:      NewUpdateTransactionKeyMsg("userA", secp256k1.GenPrivKey().PubKey(), -1),
+			wantCode: types.CodeInvalidGracePeriod,
+		},
+		"normal case - update app key": {
+			msg:      NewUpdateAppKeyMsg("userA", secp256k1.GenPrivKey().PubKey(), types.MaximumKeyGracePeriodSec),
+			wantCode: sdk.CodeOK,
+		},
+		"update app key with nil key": {
+			msg:      NewUpdateAppKeyMsg("userA", nil, 0),
+			wantCode: types.CodeInvalidNewKey,
+		},
+		"update app key with grace period exceeds maximum": {
+			msg:      NewUpdateAppKeyMsg("userA", secp256k1.GenPrivKey().PubKey(), types.MaximumKeyGracePeriodSec+1),
+			wantCode: types.CodeInvalidGracePeriod,
+		},
+	}
+
+	for testName, tc := range testCases {
+		got := tc.msg.ValidateBasic()
+		if got == nil {
+			if tc.wantCode != sdk.CodeOK {
+				t.Errorf("%s: diff error: got %v, want %v", testName, sdk.CodeOK, tc.wantCode)
+			}
+			continue
+		}
+		if got.Code() != tc.wantCode {
+			t.Errorf("%s: diff error code: got %v, want %v", testName, got.Code(), tc.wantCode)
+		}
+	}
+}
+
+func TestScheduledTransferMsgs(t *testing.T) {
+	testCases := map[string]struct {
+		msg      types.Msg
+		wantCode sdk.CodeType
+	}{
+		"normal case - one-off scheduled transfer": {
+			msg:      NewCreateScheduledTransferMsg("userA", "userB", "1", "memo", 1000, 0, 1),
+			wantCode: sdk.CodeOK,
+		},
+		"normal case - recurring transfer": {
+			msg: NewCreateScheduledTransferMsg(
+				"userA", "userB", "1", "memo", 1000, types.MinimumScheduledTransferIntervalSec, 12),
+			wantCode: sdk.CodeOK,
+		},
+		"invalid receiver": {
+			msg:      NewCreateScheduledTransferMsg("userA", "us", "1", "memo", 1000, 0, 1),
+			wantCode: types.CodeInvalidUsername,
+		},
+		"invalid amount": {
+			msg:      NewCreateScheduledTransferMsg("userA", "userB", "-1", "memo", 1000, 0, 1),
+			wantCode: types.CodeInvalidCoins,
+		},
+		"zero times": {
+			msg:      NewCreateScheduledTransferMsg("userA", "userB", "1", "memo", 1000, 0, 0),
+			wantCode: types.CodeInvalidScheduledTransfer,
+		},
+		"times exceeds maximum": {
+			msg: NewCreateScheduledTransferMsg(
+				"userA", "userB", "1", "memo", 1000, types.MinimumScheduledTransferIntervalSec,
+				types.MaximumScheduledTransferTimes+1),
+			wantCode: types.CodeInvalidScheduledTransfer,
+		},
+		"recurring interval too short": {
+			msg: NewCreateScheduledTransferMsg(
+				"userA", "userB", "1", "memo", 1000, types.MinimumScheduledTransferIntervalSec-1, 2),
+			wantCode: types.CodeInvalidScheduledTransfer,
+		},
+		"negative start time": {
+			msg:      NewCreateScheduledTransferMsg("userA", "userB", "1", "memo", -1, 0, 1),
+			wantCode: types.CodeInvalidScheduledTransfer,
+		},
+		"normal case - cancel scheduled transfer": {
+			msg:      NewCancelScheduledTransferMsg("userA", 1),
+			wantCode: sdk.CodeOK,
+		},
+		"cancel invalid schedule id": {
+			msg:      NewCancelScheduledTransferMsg("userA", 0),
+			wantCode: types.CodeInvalidScheduledTransfer,
+		},
+	}
+
+	for testName, tc := range testCases {
+		got := tc.msg.ValidateBasic()
+		if got == nil {
+			if tc.wantCode != sdk.CodeOK {
+				t.Errorf("%s: diff error: got %v, want %v", testName, sdk.CodeOK, tc.wantCode)
+			}
+			continue
+		}
+		if got.Code() != tc.wantCode {
+			t.Errorf("%s: diff error code: got %v, want %v", testName, got.Code(), tc.wantCode)
+		}
+	}
+}
+
 func TestRegisterUsername(t *testing.T) {
 	testCases := map[string]struct {
 		msg      RegisterMsg
@@ -428,6 +603,34 @@ func TestMsgPermission(t *testing.T) {
 			msg:              NewUpdateAccountMsg("user", "{'test':'test'}"),
 			expectPermission: types.AppPermission,
 		},
+		"add co-signer": {
+			msg:              NewAddCoSignerMsg("userA", secp256k1.GenPrivKey().PubKey()),
+			expectPermission: types.ResetPermission,
+		},
+		"remove co-signer": {
+			msg:              NewRemoveCoSignerMsg("userA", secp256k1.GenPrivKey().PubKey()),
+			expectPermission: types.ResetPermission,
+		},
+		"change threshold": {
+			msg:              NewChangeThresholdMsg("userA", 2),
+			expectPermission: types.ResetPermission,
+		},
+		"update transaction key": {
+			msg:              NewUpdateTransactionKeyMsg("userA", secp256k1.GenPrivKey().PubKey(), 0),
+			expectPermission: types.ResetPermission,
+		},
+		"update app key": {
+			msg:              NewUpdateAppKeyMsg("userA", secp256k1.GenPrivKey().PubKey(), 0),
+			expectPermission: types.TransactionPermission,
+		},
+		"create scheduled transfer": {
+			msg:              NewCreateScheduledTransferMsg("userA", "userB", "1", "", 1000, 0, 1),
+			expectPermission: types.TransactionPermission,
+		},
+		"cancel scheduled transfer": {
+			msg:              NewCancelScheduledTransferMsg("userA", 1),
+			expectPermission: types.TransactionPermission,
+		},
 	}
 
 	for testName, tc := range cases {
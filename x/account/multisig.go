@@ -0,0 +1,126 @@
+package account
+
+import (
+	"fmt"
+
+	"github.com/lino-network/lino/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddCoSigner - add a co-signer public key to the multi-signature config of the account
+func (accManager AccountManager) AddCoSigner(
+	ctx sdk.Context, username types.AccountKey, coSigner crypto.PubKey) sdk.Error {
+	if !accManager.DoesAccountExist(ctx, username) {
+		return ErrAccountNotFound(username)
+	}
+	multiSig, err := accManager.storage.GetMultiSig(ctx, username)
+	if err != nil {
+		return err
+	}
+	if indexOfPubKey(multiSig.CoSigners, coSigner) != -1 {
+		return ErrCoSignerAlreadyExist(username)
+	}
+	if len(multiSig.CoSigners) >= types.MaximumNumOfCoSigners {
+		return ErrTooManyCoSigners(username)
+	}
+	multiSig.CoSigners = append(multiSig.CoSigners, coSigner)
+	return accManager.storage.SetMultiSig(ctx, username, multiSig)
+}
+
+// RemoveCoSigner - remove a co-signer public key from the multi-signature config of
+// the account, the remaining co-signers must be enough to reach the threshold
+func (accManager AccountManager) RemoveCoSigner(
+	ctx sdk.Context, username types.AccountKey, coSigner crypto.PubKey) sdk.Error {
+	if !accManager.DoesAccountExist(ctx, username) {
+		return ErrAccountNotFound(username)
+	}
+	multiSig, err := accManager.storage.GetMultiSig(ctx, username)
+	if err != nil {
+		return err
+	}
+	idx := indexOfPubKey(multiSig.CoSigners, coSigner)
+	if idx == -1 {
+		return ErrCoSignerNotFound(username)
+	}
+	if int64(len(multiSig.CoSigners)-1) < multiSig.Threshold {
+		return ErrInvalidThreshold(
+			fmt.Sprintf("threshold %v can't be reached after removing co-signer", multiSig.Threshold))
+	}
+	multiSig.CoSigners = append(multiSig.CoSigners[:idx], multiSig.CoSigners[idx+1:]...)
+	return accManager.storage.SetMultiSig(ctx, username, multiSig)
+}
+
+// ChangeThreshold - change the multi-signature threshold of the account,
+// zero threshold disables multi-signature
+func (accManager AccountManager) ChangeThreshold(
+	ctx sdk.Context, username types.AccountKey, threshold int64) sdk.Error {
+	if !accManager.DoesAccountExist(ctx, username) {
+		return ErrAccountNotFound(username)
+	}
+	multiSig, err := accManager.storage.GetMultiSig(ctx, username)
+	if err != nil {
+		return err
+	}
+	if threshold < 0 || threshold > int64(len(multiSig.CoSigners)) {
+		return ErrInvalidThreshold(
+			fmt.Sprintf("threshold %v out of range [0, %v]", threshold, len(multiSig.CoSigners)))
+	}
+	multiSig.Threshold = threshold
+	return accManager.storage.SetMultiSig(ctx, username, multiSig)
+}
+
+// GetSignatureThreshold - get number of co-signers' signatures the account needs to
+// sign a msg with given permission. Permissions able to move coins or change keys need
+// threshold signatures if multi-signature is enabled, zero is returned if the msg is
+// signed by a single key of the account.
+func (accManager AccountManager) GetSignatureThreshold(
+	ctx sdk.Context, username types.AccountKey, permission types.Permission) (int64, sdk.Error) {
+	if !isMultiSigPermission(permission) {
+		return 0, nil
+	}
+	multiSig, err := accManager.storage.GetMultiSig(ctx, username)
+	if err != nil {
+		return 0, err
+	}
+	return multiSig.Threshold, nil
+}
+
+// isMultiSigPermission - transaction, reset and pre-authorization permission can move
+// coins out of the account or change its keys, they are guarded by multi-signature
+func isMultiSigPermission(permission types.Permission) bool {
+	return permission == types.TransactionPermission || permission == types.ResetPermission ||
+		permission == types.PreAuthorizationPermission
+}
+
+// CheckMultiSigPubKeys - check all sign keys are distinct co-signers of the account
+// and the number of sign keys reaches the threshold
+func (accManager AccountManager) CheckMultiSigPubKeys(
+	ctx sdk.Context, username types.AccountKey, signKeys []crypto.PubKey) sdk.Error {
+	multiSig, err := accManager.storage.GetMultiSig(ctx, username)
+	if err != nil {
+		return err
+	}
+	if multiSig.Threshold <= 0 || int64(len(signKeys)) < multiSig.Threshold {
+		return ErrCheckMultiSigKey(username)
+	}
+	for i, signKey := range signKeys {
+		if indexOfPubKey(multiSig.CoSigners, signKey) == -1 {
+			return ErrCheckMultiSigKey(username)
+		}
+		if indexOfPubKey(signKeys[:i], signKey) != -1 {
+			return ErrCheckMultiSigKey(username)
+		}
+	}
+	return nil
+}
+
+func indexOfPubKey(pubKeys []crypto.PubKey, pubKey crypto.PubKey) int {
+	for i, key := range pubKeys {
+		if key.Equals(pubKey) {
+			return i
+		}
+	}
+	return -1
+}
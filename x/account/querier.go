@@ -0,0 +1,341 @@
+package account
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/account/model"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryAccountInfo - query account info, path: info/<username>
+	QueryAccountInfo = "info"
+	// QueryAccountBank - query account bank, path: bank/<username>
+	QueryAccountBank = "bank"
+	// QueryAccountMeta - query account meta, path: meta/<username>
+	QueryAccountMeta = "meta"
+	// QueryAccountReward - query account reward, path: reward/<username>
+	QueryAccountReward = "reward"
+	// QueryAccountPendingCoinDayQueue - query pending coin day queue, path: pendingCoinDayQueue/<username>
+	QueryAccountPendingCoinDayQueue = "pendingCoinDayQueue"
+	// QueryAccountRelationship - query relationship between two users, path: relationship/<me>/<other>
+	QueryAccountRelationship = "relationship"
+	// QueryAccountBalanceHistory - query balance history bundle, path: balanceHistory/<username>/<bundle>
+	QueryAccountBalanceHistory = "balanceHistory"
+	// QueryAccountRewardHistory - query reward history bundle, path: rewardHistory/<username>/<bundle>
+	QueryAccountRewardHistory = "rewardHistory"
+	// QueryAllAccountInfo - query a page of account info ordered by username, path: allInfo/<offset>/<limit>
+	QueryAllAccountInfo = "allInfo"
+	// QueryAccountFollowers - query all followers of a user, path: followers/<username>
+	QueryAccountFollowers = "followers"
+	// QueryAccountFollowings - query all followings of a user, path: followings/<username>
+	QueryAccountFollowings = "followings"
+	// QueryAccountBalanceHistoryPage - query filtered balance history across bundles,
+	// path: balanceHistoryPage/<username>/<start time>/<end time>/<detail types>/<offset>/<limit>
+	QueryAccountBalanceHistoryPage = "balanceHistoryPage"
+	// QueryAccountRewardHistoryPage - query filtered reward history across bundles,
+	// path: rewardHistoryPage/<username>/<start time>/<end time>/<detail types>/<offset>/<limit>
+	QueryAccountRewardHistoryPage = "rewardHistoryPage"
+	// QueryAccountKeyHistory - query all key changes of a user, path: keyHistory/<username>
+	QueryAccountKeyHistory = "keyHistory"
+	// QueryAccountScheduledTransfers - query all active scheduled transfers of a user,
+	// path: scheduledTransfers/<username>
+	QueryAccountScheduledTransfers = "scheduledTransfers"
+
+	// AllDetailTypes - detail types parameter which matches all transfer detail types
+	AllDetailTypes = "all"
+)
+
+// NewQuerier - create a querier which serves custom queries under account route
+func NewQuerier(am AccountManager) sdk.Querier {
+	cdc := wire.NewCodec()
+	wire.RegisterCrypto(cdc)
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) == 0 {
+			return nil, types.ErrUnknownQueryPath("")
+		}
+		switch path[0] {
+		case QueryAccountInfo:
+			return queryAccountInfo(ctx, cdc, path[1:], am)
+		case QueryAccountBank:
+			return queryAccountBank(ctx, cdc, path[1:], am)
+		case QueryAccountMeta:
+			return queryAccountMeta(ctx, cdc, path[1:], am)
+		case QueryAccountReward:
+			return queryAccountReward(ctx, cdc, path[1:], am)
+		case QueryAccountPendingCoinDayQueue:
+			return queryAccountPendingCoinDayQueue(ctx, cdc, path[1:], am)
+		case QueryAccountRelationship:
+			return queryAccountRelationship(ctx, cdc, path[1:], am)
+		case QueryAccountBalanceHistory:
+			return queryAccountBalanceHistory(ctx, cdc, path[1:], am)
+		case QueryAccountRewardHistory:
+			return queryAccountRewardHistory(ctx, cdc, path[1:], am)
+		case QueryAllAccountInfo:
+			return queryAllAccountInfo(ctx, cdc, path[1:], am)
+		case QueryAccountFollowers:
+			return queryAccountFollowers(ctx, cdc, path[1:], am)
+		case QueryAccountFollowings:
+			return queryAccountFollowings(ctx, cdc, path[1:], am)
+		case QueryAccountBalanceHistoryPage:
+			return queryAccountBalanceHistoryPage(ctx, cdc, path[1:], am)
+		case QueryAccountRewardHistoryPage:
+			return queryAccountRewardHistoryPage(ctx, cdc, path[1:], am)
+		case QueryAccountKeyHistory:
+			return queryAccountKeyHistory(ctx, cdc, path[1:], am)
+		case QueryAccountScheduledTransfers:
+			return queryAccountScheduledTransfers(ctx, cdc, path[1:], am)
+		default:
+			return nil, types.ErrUnknownQueryPath(path[0])
+		}
+	}
+}
+
+func queryAccountInfo(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	info, err := am.storage.GetInfo(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, info)
+}
+
+func queryAccountBank(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	bank, err := am.storage.GetBankFromAccountKey(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, bank)
+}
+
+func queryAccountMeta(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	meta, err := am.storage.GetMeta(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, meta)
+}
+
+func queryAccountReward(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	reward, err := am.storage.GetReward(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, reward)
+}
+
+func queryAccountPendingCoinDayQueue(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	queue, err := am.storage.GetPendingCoinDayQueue(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, queue)
+}
+
+func queryAccountRelationship(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 2); err != nil {
+		return nil, err
+	}
+	relationship, err := am.storage.GetRelationship(
+		ctx, types.AccountKey(path[0]), types.AccountKey(path[1]))
+	if err != nil {
+		return nil, err
+	}
+	if relationship == nil {
+		relationship = &model.Relationship{}
+	}
+	return types.MarshalQueryResult(cdc, relationship)
+}
+
+func queryAccountBalanceHistory(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 2); err != nil {
+		return nil, err
+	}
+	bundle, parseErr := strconv.ParseInt(path[1], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	history, err := am.storage.GetBalanceHistory(ctx, types.AccountKey(path[0]), bundle)
+	if err != nil {
+		return nil, err
+	}
+	if history == nil {
+		history = &model.BalanceHistory{Details: []model.Detail{}}
+	}
+	return types.MarshalQueryResult(cdc, history)
+}
+
+func queryAccountRewardHistory(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 2); err != nil {
+		return nil, err
+	}
+	bundle, parseErr := strconv.ParseInt(path[1], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	history, err := am.storage.GetRewardHistory(ctx, types.AccountKey(path[0]), bundle)
+	if err != nil {
+		return nil, err
+	}
+	if history == nil {
+		history = &model.RewardHistory{Details: []model.RewardDetail{}}
+	}
+	return types.MarshalQueryResult(cdc, history)
+}
+
+func queryAllAccountInfo(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 2); err != nil {
+		return nil, err
+	}
+	offset, parseErr := strconv.ParseInt(path[0], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	limit, parseErr := strconv.ParseInt(path[1], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	page, err := am.GetAccountInfoPage(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, page)
+}
+
+func queryAccountFollowers(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	followers, err := am.storage.GetFollowers(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, followers)
+}
+
+func queryAccountFollowings(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	followings, err := am.storage.GetFollowings(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, followings)
+}
+
+func queryAccountBalanceHistoryPage(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 6); err != nil {
+		return nil, err
+	}
+	filter, err := parseHistoryFilter(path[1:])
+	if err != nil {
+		return nil, err
+	}
+	page, err := am.GetBalanceHistoryPage(ctx, types.AccountKey(path[0]), *filter)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, page)
+}
+
+func queryAccountRewardHistoryPage(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 6); err != nil {
+		return nil, err
+	}
+	filter, err := parseHistoryFilter(path[1:])
+	if err != nil {
+		return nil, err
+	}
+	page, err := am.GetRewardHistoryPage(ctx, types.AccountKey(path[0]), *filter)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, page)
+}
+
+// parse <start time>/<end time>/<detail types>/<offset>/<limit> to history filter,
+// detail types are comma separated integers or "all"
+func parseHistoryFilter(params []string) (*HistoryFilter, sdk.Error) {
+	nums := make([]int64, 0, 4)
+	for _, i := range []int{0, 1, 3, 4} {
+		num, err := strconv.ParseInt(params[i], 10, 64)
+		if err != nil {
+			return nil, types.ErrInvalidQueryParams(err.Error())
+		}
+		nums = append(nums, num)
+	}
+	filter := &HistoryFilter{
+		StartTime:   nums[0],
+		EndTime:     nums[1],
+		DetailTypes: []types.TransferDetailType{},
+		Offset:      nums[2],
+		Limit:       nums[3],
+	}
+	if params[2] != AllDetailTypes {
+		for _, t := range strings.Split(params[2], ",") {
+			detailType, err := strconv.Atoi(t)
+			if err != nil {
+				return nil, types.ErrInvalidQueryParams(err.Error())
+			}
+			filter.DetailTypes = append(filter.DetailTypes, types.TransferDetailType(detailType))
+		}
+	}
+	return filter, nil
+}
+
+func queryAccountKeyHistory(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	history, err := am.GetKeyHistory(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, history)
+}
+
+func queryAccountScheduledTransfers(
+	ctx sdk.Context, cdc *wire.Codec, path []string, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	transfers, err := am.GetScheduledTransfers(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, transfers)
+}
@@ -0,0 +1,154 @@
+package account
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/account/model"
+	"github.com/stretchr/testify/assert"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestQueryAccount(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	querier := NewQuerier(am)
+	cdc := wire.NewCodec()
+	wire.RegisterCrypto(cdc)
+
+	user1 := types.AccountKey("user1")
+	createTestAccount(ctx, am, string(user1))
+
+	res, err := querier(ctx, []string{QueryAccountInfo, string(user1)}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	info := new(model.AccountInfo)
+	assert.Nil(t, cdc.UnmarshalJSON(res, info))
+	assert.Equal(t, user1, info.Username)
+
+	res, err = querier(ctx, []string{QueryAccountBank, string(user1)}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	bank := new(model.AccountBank)
+	assert.Nil(t, cdc.UnmarshalJSON(res, bank))
+	saving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.Equal(t, saving, bank.Saving)
+
+	res, err = querier(ctx, []string{QueryAccountBalanceHistory, string(user1), "0"}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	history := new(model.BalanceHistory)
+	assert.Nil(t, cdc.UnmarshalJSON(res, history))
+	expectHistory, _ := am.storage.GetBalanceHistory(ctx, user1, 0)
+	assert.Equal(t, *expectHistory, *history)
+
+	res, err = querier(ctx, []string{
+		QueryAccountBalanceHistoryPage, string(user1), "0", "9223372036854775807", AllDetailTypes, "0", "10"},
+		abci.RequestQuery{})
+	assert.Nil(t, err)
+	page := new(model.BalanceHistoryPage)
+	assert.Nil(t, cdc.UnmarshalJSON(res, page))
+	assert.Equal(t, expectHistory.Details, page.Details)
+	assert.False(t, page.HasMore)
+
+	_, err = querier(ctx, []string{
+		QueryAccountBalanceHistoryPage, string(user1), "0", "100", "x", "0", "10"},
+		abci.RequestQuery{})
+	assert.NotNil(t, err)
+
+	user2 := types.AccountKey("user2")
+	createTestAccount(ctx, am, string(user2))
+	res, err = querier(ctx, []string{QueryAllAccountInfo, "0", "1"}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	accounts := new(model.AccountInfoPage)
+	assert.Nil(t, cdc.UnmarshalJSON(res, accounts))
+	assert.Equal(t, 1, len(accounts.Accounts))
+	assert.Equal(t, user1, accounts.Accounts[0].Username)
+	assert.True(t, accounts.HasMore)
+	res, err = querier(ctx, []string{QueryAllAccountInfo, "1", "1"}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	accounts = new(model.AccountInfoPage)
+	assert.Nil(t, cdc.UnmarshalJSON(res, accounts))
+	assert.Equal(t, 1, len(accounts.Accounts))
+	assert.Equal(t, user2, accounts.Accounts[0].Username)
+	assert.False(t, accounts.HasMore)
+
+	_, err = querier(ctx, []string{QueryAllAccountInfo}, abci.RequestQuery{})
+	assert.NotNil(t, err)
+	_, err = querier(ctx, []string{QueryAllAccountInfo, "-1", "1"}, abci.RequestQuery{})
+	assert.Equal(t, ErrInvalidAccountInfoPage(-1, 1), err)
+	_, err = querier(ctx, []string{
+		QueryAllAccountInfo, "0", strconv.Itoa(types.MaximumAccountInfoPageSize + 1)}, abci.RequestQuery{})
+	assert.Equal(t, ErrInvalidAccountInfoPage(0, types.MaximumAccountInfoPageSize+1), err)
+}
+
+func TestQueryAccountFollowers(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	querier := NewQuerier(am)
+	cdc := wire.NewCodec()
+	wire.RegisterCrypto(cdc)
+
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	createTestAccount(ctx, am, string(user1))
+	createTestAccount(ctx, am, string(user2))
+
+	res, err := querier(ctx, []string{QueryAccountFollowers, string(user1)}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	var followers []model.FollowerMeta
+	assert.Nil(t, cdc.UnmarshalJSON(res, &followers))
+	assert.Equal(t, 0, len(followers))
+
+	assert.Nil(t, am.SetFollower(ctx, user1, user2))
+	assert.Nil(t, am.SetFollowing(ctx, user2, user1))
+
+	res, err = querier(ctx, []string{QueryAccountFollowers, string(user1)}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	assert.Nil(t, cdc.UnmarshalJSON(res, &followers))
+	assert.Equal(t, 1, len(followers))
+	assert.Equal(t, user2, followers[0].FollowerName)
+
+	res, err = querier(ctx, []string{QueryAccountFollowings, string(user2)}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	var followings []model.FollowingMeta
+	assert.Nil(t, cdc.UnmarshalJSON(res, &followings))
+	assert.Equal(t, 1, len(followings))
+	assert.Equal(t, user1, followings[0].FollowingName)
+}
+
+func TestQueryAccountInvalidPath(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	querier := NewQuerier(am)
+
+	testCases := []struct {
+		testName    string
+		path        []string
+		expectedErr error
+	}{
+		{
+			testName:    "unknown endpoint",
+			path:        []string{"unknown", "user1"},
+			expectedErr: types.ErrUnknownQueryPath("unknown"),
+		},
+		{
+			testName:    "missing username",
+			path:        []string{QueryAccountInfo},
+			expectedErr: types.ErrInvalidQueryParams("expect 1 query parameters, got 0"),
+		},
+		{
+			testName:    "empty username",
+			path:        []string{QueryAccountBank, ""},
+			expectedErr: types.ErrInvalidQueryParams("query parameter can't be empty"),
+		},
+		{
+			testName:    "account doesn't exist",
+			path:        []string{QueryAccountInfo, "user1"},
+			expectedErr: model.ErrAccountInfoNotFound(),
+		},
+	}
+	for _, tc := range testCases {
+		_, err := querier(ctx, tc.path, abci.RequestQuery{})
+		if !assert.Equal(t, tc.expectedErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectedErr)
+		}
+	}
+}
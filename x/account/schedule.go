@@ -0,0 +1,152 @@
+package account
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/account/model"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateScheduledTransfer - create a transfer executed first at start time and then
+// every interval until it's executed given times, funds are checked at execution time
+func (accManager AccountManager) CreateScheduledTransfer(
+	ctx sdk.Context, sender, receiver types.AccountKey, amount types.Coin, memo string,
+	startTime, intervalSec, times int64) (*model.ScheduledTransfer, sdk.Error) {
+	if !accManager.DoesAccountExist(ctx, sender) {
+		return nil, ErrSenderNotFound(sender)
+	}
+	if !accManager.DoesAccountExist(ctx, receiver) {
+		return nil, ErrReceiverNotFound(receiver)
+	}
+	if startTime < ctx.BlockHeader().Time.Unix() {
+		return nil, ErrInvalidScheduledTransfer(fmt.Sprintf("start time %v is in the past", startTime))
+	}
+	transfers, err := accManager.storage.GetScheduledTransfers(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	if len(transfers) >= types.MaximumNumOfScheduledTransfers {
+		return nil, ErrTooManyScheduledTransfers(sender)
+	}
+
+	lastID, err := accManager.storage.GetLastScheduledTransferID(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	transfer := &model.ScheduledTransfer{
+		ID:             lastID + 1,
+		Sender:         sender,
+		Receiver:       receiver,
+		Amount:         amount,
+		Memo:           memo,
+		NextExecuteAt:  startTime,
+		IntervalSec:    intervalSec,
+		RemainingTimes: times,
+		CreatedAt:      ctx.BlockHeader().Time.Unix(),
+	}
+	if err := accManager.storage.SetLastScheduledTransferID(ctx, sender, transfer.ID); err != nil {
+		return nil, err
+	}
+	if err := accManager.storage.SetScheduledTransfer(ctx, transfer); err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+// CancelScheduledTransfer - cancel a scheduled transfer, the pending event of
+// the transfer becomes no-op
+func (accManager AccountManager) CancelScheduledTransfer(
+	ctx sdk.Context, sender types.AccountKey, id int64) sdk.Error {
+	transfer, err := accManager.storage.GetScheduledTransfer(ctx, sender, id)
+	if err != nil {
+		return err
+	}
+	if transfer == nil {
+		return ErrScheduledTransferNotFound(sender, id)
+	}
+	accManager.storage.DeleteScheduledTransfer(ctx, sender, id)
+	return nil
+}
+
+// GetScheduledTransfers - get all active scheduled transfers of the account ordered by id
+func (accManager AccountManager) GetScheduledTransfers(
+	ctx sdk.Context, sender types.AccountKey) ([]model.ScheduledTransfer, sdk.Error) {
+	transfers, err := accManager.storage.GetScheduledTransfers(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(transfers, func(i, j int) bool { return transfers[i].ID < transfers[j].ID })
+	return transfers, nil
+}
+
+// ExecuteScheduledTransfer - execute one round of the scheduled transfer. If sender's
+// saving is not enough, this round is skipped and recorded in sender's balance history.
+// Returns the updated transfer if there are more rounds, nil otherwise
+func (accManager AccountManager) ExecuteScheduledTransfer(
+	ctx sdk.Context, sender types.AccountKey, id int64) (*model.ScheduledTransfer, sdk.Error) {
+	transfer, err := accManager.storage.GetScheduledTransfer(ctx, sender, id)
+	if err != nil {
+		return nil, err
+	}
+	// scheduled transfer is cancelled
+	if transfer == nil {
+		return nil, nil
+	}
+
+	if err := accManager.MinusSavingCoin(
+		ctx, transfer.Sender, transfer.Amount, transfer.Receiver,
+		transfer.Memo, types.TransferOut); err != nil {
+		if err.Code() != types.CodeAccountSavingCoinNotEnough {
+			return nil, err
+		}
+		if err := accManager.addFailedScheduledTransferHistory(ctx, transfer); err != nil {
+			return nil, err
+		}
+	} else if err := accManager.AddSavingCoin(
+		ctx, transfer.Receiver, transfer.Amount, transfer.Sender,
+		transfer.Memo, types.TransferIn); err != nil {
+		return nil, err
+	}
+
+	transfer.RemainingTimes--
+	if transfer.RemainingTimes <= 0 {
+		accManager.storage.DeleteScheduledTransfer(ctx, sender, id)
+		return nil, nil
+	}
+	// if the blockchain is halted longer than the interval, next round is executed in next block
+	transfer.NextExecuteAt += transfer.IntervalSec
+	if now := ctx.BlockHeader().Time.Unix(); transfer.NextExecuteAt < now {
+		transfer.NextExecuteAt = now
+	}
+	if err := accManager.storage.SetScheduledTransfer(ctx, transfer); err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+// addFailedScheduledTransferHistory - record the skipped round in balance history
+// without changing the balance
+func (accManager AccountManager) addFailedScheduledTransferHistory(
+	ctx sdk.Context, transfer *model.ScheduledTransfer) sdk.Error {
+	bank, err := accManager.storage.GetBankFromAccountKey(ctx, transfer.Sender)
+	if err != nil {
+		return err
+	}
+	if err := accManager.AddBalanceHistory(
+		ctx, transfer.Sender, bank.NumOfTx, model.Detail{
+			Amount:     transfer.Amount,
+			DetailType: types.ScheduledTransferFailed,
+			To:         transfer.Receiver,
+			From:       transfer.Sender,
+			Balance:    bank.Saving,
+			CreatedAt:  ctx.BlockHeader().Time.Unix(),
+			Memo:       transfer.Memo,
+		}); err != nil {
+		return err
+	}
+	bank.NumOfTx++
+	return accManager.storage.SetBankFromAccountKey(ctx, transfer.Sender, bank)
+}
@@ -0,0 +1,119 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/account/model"
+	"github.com/stretchr/testify/assert"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestCreateAndCancelScheduledTransfer(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1000, 0)})
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	createTestAccount(ctx, am, string(user1))
+	createTestAccount(ctx, am, string(user2))
+
+	_, err := am.CreateScheduledTransfer(ctx, user1, "invalid", c100, "", 1000, 3600, 1)
+	assert.Equal(t, ErrReceiverNotFound("invalid"), err)
+	_, err = am.CreateScheduledTransfer(ctx, user1, user2, c100, "", 999, 3600, 1)
+	assert.Equal(t, ErrInvalidScheduledTransfer("start time 999 is in the past"), err)
+
+	for i := 1; i <= types.MaximumNumOfScheduledTransfers; i++ {
+		transfer, err := am.CreateScheduledTransfer(ctx, user1, user2, c100, "memo", 2000, 3600, 12)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(i), transfer.ID)
+	}
+	_, err = am.CreateScheduledTransfer(ctx, user1, user2, c100, "memo", 2000, 3600, 12)
+	assert.Equal(t, ErrTooManyScheduledTransfers(user1), err)
+
+	err = am.CancelScheduledTransfer(ctx, user1, 2)
+	assert.Nil(t, err)
+	err = am.CancelScheduledTransfer(ctx, user1, 2)
+	assert.Equal(t, ErrScheduledTransferNotFound(user1, 2), err)
+
+	transfers, err := am.GetScheduledTransfers(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, types.MaximumNumOfScheduledTransfers-1, len(transfers))
+	for i := 1; i < len(transfers); i++ {
+		assert.True(t, transfers[i-1].ID < transfers[i].ID)
+	}
+
+	// id of cancelled transfer is not reused
+	transfer, err := am.CreateScheduledTransfer(ctx, user1, user2, c100, "memo", 2000, 3600, 12)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(types.MaximumNumOfScheduledTransfers+1), transfer.ID)
+}
+
+func TestScheduledTransferEvent(t *testing.T) {
+	ctx, am, gm := setupTest(t, 1)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1000, 0)})
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	createTestAccount(ctx, am, string(user1))
+	createTestAccount(ctx, am, string(user2))
+	err := am.AddSavingCoin(ctx, user1, c100, "", "", types.TransferIn)
+	assert.Nil(t, err)
+
+	transfer, err := am.CreateScheduledTransfer(ctx, user1, user2, c100, "monthly", 2000, 3600, 4)
+	assert.Nil(t, err)
+	event := ScheduledTransferEvent{Sender: user1, ScheduleID: transfer.ID}
+
+	// first round succeeds and registers next round
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(2001, 0)})
+	err = event.Execute(ctx, am, gm)
+	assert.Nil(t, err)
+	saving, _ := am.GetSavingFromBank(ctx, user2)
+	assert.Equal(t, c100.Plus(types.NewCoinFromInt64(1*types.Decimals)), saving)
+	assert.Equal(t, []types.Event{event}, gm.GetTimeEventListAtTime(ctx, 5600).Events)
+
+	// second round fails since saving is not enough, failure is recorded in sender's history
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(5601, 0)})
+	err = event.Execute(ctx, am, gm)
+	assert.Nil(t, err)
+	saving, _ = am.GetSavingFromBank(ctx, user2)
+	assert.Equal(t, c100.Plus(types.NewCoinFromInt64(1*types.Decimals)), saving)
+	page, err := am.GetBalanceHistoryPage(ctx, user1, HistoryFilter{
+		StartTime: 5601, EndTime: 5601, Limit: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Detail{{
+		DetailType: types.ScheduledTransferFailed,
+		From:       user1,
+		To:         user2,
+		Amount:     c100,
+		Balance:    types.NewCoinFromInt64(1 * types.Decimals),
+		CreatedAt:  5601,
+		Memo:       "monthly",
+	}}, page.Details)
+
+	// chain is halted longer than interval, next round is executed in next block
+	assert.Equal(t, []types.Event{event}, gm.GetTimeEventListAtTime(ctx, 9200).Events)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(20000, 0)})
+	err = event.Execute(ctx, am, gm)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Event{event}, gm.GetTimeEventListAtTime(ctx, 20000).Events)
+
+	// last round removes the scheduled transfer
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(20001, 0)})
+	err = event.Execute(ctx, am, gm)
+	assert.Nil(t, err)
+	transfers, err := am.GetScheduledTransfers(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(transfers))
+	assert.Nil(t, gm.GetTimeEventListAtTime(ctx, 20001))
+
+	// cancelled transfer is not executed
+	transfer, err = am.CreateScheduledTransfer(ctx, user1, user2, coin1, "", 20001, 0, 1)
+	assert.Nil(t, err)
+	err = am.CancelScheduledTransfer(ctx, user1, transfer.ID)
+	assert.Nil(t, err)
+	err = ScheduledTransferEvent{Sender: user1, ScheduleID: transfer.ID}.Execute(ctx, am, gm)
+	assert.Nil(t, err)
+	saving, _ = am.GetSavingFromBank(ctx, user1)
+	assert.Equal(t, types.NewCoinFromInt64(1*types.Decimals), saving)
+}
@@ -75,6 +75,7 @@ func setupTest(t *testing.T, height int64) (sdk.Context, AccountManager, global.
 	cdc := globalManager.WireCodec()
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(ReturnCoinEvent{}, "event/return", nil)
+	cdc.RegisterConcrete(ScheduledTransferEvent{}, "event/scheduledTransfer", nil)
 
 	err := initGlobalManager(ctx, globalManager)
 	assert.Nil(t, err)
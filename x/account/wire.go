@@ -13,6 +13,13 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(ClaimMsg{}, "lino/claim", nil)
 	cdc.RegisterConcrete(RecoverMsg{}, "lino/recover", nil)
 	cdc.RegisterConcrete(UpdateAccountMsg{}, "lino/updateAcc", nil)
+	cdc.RegisterConcrete(AddCoSignerMsg{}, "lino/addCoSigner", nil)
+	cdc.RegisterConcrete(RemoveCoSignerMsg{}, "lino/removeCoSigner", nil)
+	cdc.RegisterConcrete(ChangeThresholdMsg{}, "lino/changeThreshold", nil)
+	cdc.RegisterConcrete(UpdateTransactionKeyMsg{}, "lino/updateTxKey", nil)
+	cdc.RegisterConcrete(UpdateAppKeyMsg{}, "lino/updateAppKey", nil)
+	cdc.RegisterConcrete(CreateScheduledTransferMsg{}, "lino/createScheduledTransfer", nil)
+	cdc.RegisterConcrete(CancelScheduledTransferMsg{}, "lino/cancelScheduledTransfer", nil)
 }
 
 var msgCdc = wire.NewCodec()
@@ -7,6 +7,7 @@ import (
 	"github.com/lino-network/lino/x/global"
 
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acc "github.com/lino-network/lino/x/account"
@@ -51,42 +52,73 @@ func NewAnteHandler(am acc.AccountManager, gm global.GlobalManager) sdk.AnteHand
 
 		sdkMsgs := tx.GetMsgs()
 
-		var signers []sdk.AccAddress
+		// each signer consumes one signature, or threshold signatures of
+		// co-signers if the msg needs multi-signature of the signer
+		numOfSigs := 0
+		thresholds := [][]int64{}
 		for _, msg := range sdkMsgs {
-			for _, signer := range msg.GetSigners() {
-				signers = append(signers, signer)
+			msg, ok := msg.(types.Msg)
+			if !ok {
+				return ctx, ErrUnknownMsgType().Result(), true
+			}
+			msgThresholds := []int64{}
+			for _, msgSigner := range msg.GetSigners() {
+				threshold, err := am.GetSignatureThreshold(
+					ctx, types.AccountKey(msgSigner), msg.GetPermission())
+				if err != nil {
+					return ctx, err.Result(), true
+				}
+				msgThresholds = append(msgThresholds, threshold)
+				if threshold > 0 {
+					numOfSigs += int(threshold)
+				} else {
+					numOfSigs++
+				}
 			}
+			thresholds = append(thresholds, msgThresholds)
 		}
-		if len(signers) != len(sigs) {
+		if numOfSigs != len(sigs) {
 			return ctx,
 				ErrWrongNumberOfSigners().Result(),
 				true
 		}
 		// signers get from msg should be verify first
 		var idx = 0
-		for _, msg := range sdkMsgs {
-			msg, ok := msg.(types.Msg)
-			if !ok {
-				return ctx, ErrUnknownMsgType().Result(), true
-			}
+		for i, msg := range sdkMsgs {
+			msg := msg.(types.Msg)
 			permission := msg.GetPermission()
 			msgSigners := msg.GetSigners()
 			consumeAmount := msg.GetConsumeAmount()
-			for _, msgSigner := range msgSigners {
-				// check public key is valid to sign this msg
-				_, err := am.CheckSigningPubKeyOwner(ctx, types.AccountKey(msgSigner), sigs[idx].PubKey, permission, consumeAmount)
-				if err != nil {
-					return ctx, err.Result(), true
+			for j, msgSigner := range msgSigners {
+				signerSigs := sigs[idx : idx+1]
+				if threshold := thresholds[i][j]; threshold > 0 {
+					// check all signatures are signed by distinct co-signers
+					signerSigs = sigs[idx : idx+int(threshold)]
+					signKeys := make([]crypto.PubKey, len(signerSigs))
+					for k, sig := range signerSigs {
+						signKeys[k] = sig.PubKey
+					}
+					if err := am.CheckMultiSigPubKeys(ctx, types.AccountKey(msgSigner), signKeys); err != nil {
+						return ctx, err.Result(), true
+					}
+				} else {
+					// check public key is valid to sign this msg
+					_, err := am.CheckSigningPubKeyOwner(ctx, types.AccountKey(msgSigner), sigs[idx].PubKey, permission, consumeAmount)
+					if err != nil {
+						return ctx, err.Result(), true
+					}
 				}
 				// verify sequence number
 				seq, err := am.GetSequence(ctx, types.AccountKey(msgSigner))
 				if err != nil {
 					return ctx, err.Result(), true
 				}
-				if seq != sigs[idx].Sequence {
-					return ctx, ErrInvalidSequence(
-						fmt.Sprintf("Invalid sequence for signer %v. Got %d, expected %d",
-							types.AccountKey(msgSigner), sigs[idx].Sequence, seq)).Result(), true
+				for _, sig := range signerSigs {
+					if seq != sig.Sequence {
+						return ctx, ErrInvalidSequence(
+							fmt.Sprintf("Invalid sequence for signer %v. Got %d, expected %d",
+								types.AccountKey(msgSigner), sig.Sequence, seq)).Result(), true
+					}
 				}
 				if err := am.IncreaseSequenceByOne(ctx, types.AccountKey(msgSigner)); err != nil {
 					return ctx, err.Result(), true
@@ -101,14 +133,16 @@ func NewAnteHandler(am acc.AccountManager, gm global.GlobalManager) sdk.AnteHand
 				if err = am.CheckUserTPSCapacity(ctx, types.AccountKey(msgSigner), tpsCapacityRatio); err != nil {
 					return ctx, err.Result(), true
 				}
-				// construct sign bytes
-				signBytes := auth.StdSignBytes(ctx.ChainID(), 0, sequences[idx], fee, sdkMsgs, stdTx.GetMemo())
-				// verify signature
-				if !sigs[idx].PubKey.VerifyBytes(signBytes, sigs[idx].Signature) {
-					return ctx, ErrUnverifiedBytes(
-						fmt.Sprintf("signature verification failed, chain-id:%v", ctx.ChainID())).Result(), true
+				for _, sig := range signerSigs {
+					// construct sign bytes
+					signBytes := auth.StdSignBytes(ctx.ChainID(), 0, sequences[idx], fee, sdkMsgs, stdTx.GetMemo())
+					// verify signature
+					if !sig.PubKey.VerifyBytes(signBytes, sig.Signature) {
+						return ctx, ErrUnverifiedBytes(
+							fmt.Sprintf("signature verification failed, chain-id:%v", ctx.ChainID())).Result(), true
+					}
+					idx++
 				}
-				idx++
 			}
 		}
 
@@ -13,6 +13,7 @@ import (
 	acc "github.com/lino-network/lino/x/account"
 	accstore "github.com/lino-network/lino/x/account/model"
 	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/post"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -314,3 +315,76 @@ func TestTPSCapacity(t *testing.T) {
 	tx = newTestTx(ctx, []sdk.Msg{msg}, privs, seqs)
 	checkInvalidTx(t, anteHandler, ctx, tx, acc.ErrAccountTPSCapacityNotEnough(user1).Result())
 }
+
+// Test multi-signature account needs threshold signatures from distinct co-signers.
+func TestMultiSigTx(t *testing.T) {
+	am, _, ph, ctx, anteHandler := setupTest()
+	_, transaction1, app1, user1 := createTestAccount(ctx, am, ph, "user1")
+	_, transaction2, _, _ := createTestAccount(ctx, am, ph, "user2")
+
+	coSigner1 := secp256k1.GenPrivKey()
+	coSigner2 := secp256k1.GenPrivKey()
+	coSigner3 := secp256k1.GenPrivKey()
+	for _, coSigner := range []secp256k1.PrivKeySecp256k1{coSigner1, coSigner2, coSigner3} {
+		assert.Nil(t, am.AddCoSigner(ctx, user1, coSigner.PubKey()))
+	}
+	assert.Nil(t, am.ChangeThreshold(ctx, user1, 2))
+
+	var tx sdk.Tx
+	msg := newTestMsg(user1)
+	msg.Permission = types.TransactionPermission
+
+	// test single transaction key signature
+	privs, seqs := []crypto.PrivKey{transaction1}, []int64{0}
+	tx = newTestTx(ctx, []sdk.Msg{msg}, privs, seqs)
+	checkInvalidTx(t, anteHandler, ctx, tx, ErrWrongNumberOfSigners().Result())
+
+	// test same co-signer signs twice
+	privs, seqs = []crypto.PrivKey{coSigner1, coSigner1}, []int64{0, 0}
+	tx = newTestTx(ctx, []sdk.Msg{msg}, privs, seqs)
+	checkInvalidTx(t, anteHandler, ctx, tx, acc.ErrCheckMultiSigKey(user1).Result())
+
+	// test signature from key which is not co-signer
+	privs, seqs = []crypto.PrivKey{coSigner1, transaction2}, []int64{0, 0}
+	tx = newTestTx(ctx, []sdk.Msg{msg}, privs, seqs)
+	checkInvalidTx(t, anteHandler, ctx, tx, acc.ErrCheckMultiSigKey(user1).Result())
+
+	// test co-signer signs with wrong sequence
+	privs, seqs = []crypto.PrivKey{coSigner1, coSigner3}, []int64{0, 1}
+	tx = newTestTx(ctx, []sdk.Msg{msg}, privs, seqs)
+	checkInvalidTx(t, anteHandler, ctx, tx, ErrInvalidSequence(
+		fmt.Sprintf("Invalid sequence for signer %v. Got %d, expected %d", user1, 1, 0)).Result())
+
+	// test valid multi-signature
+	privs, seqs = []crypto.PrivKey{coSigner1, coSigner3}, []int64{0, 0}
+	tx = newTestTx(ctx, []sdk.Msg{msg}, privs, seqs)
+	checkValidTx(t, anteHandler, ctx, tx)
+	seq, err := am.GetSequence(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), seq)
+
+	// test app permission msg still needs single app key signature
+	msg.Permission = types.AppPermission
+	privs, seqs = []crypto.PrivKey{app1}, []int64{1}
+	tx = newTestTx(ctx, []sdk.Msg{msg}, privs, seqs)
+	checkValidTx(t, anteHandler, ctx, tx)
+
+	// test coin moving msg with pre-authorization permission needs multi-signature
+	donateMsg := post.NewDonateMsg("user1", types.LNO("1"), "author", "postID", "", "")
+	privs, seqs = []crypto.PrivKey{transaction1}, []int64{2}
+	tx = newTestTx(ctx, []sdk.Msg{donateMsg}, privs, seqs)
+	checkInvalidTx(t, anteHandler, ctx, tx, ErrWrongNumberOfSigners().Result())
+	_, err = am.CheckSigningPubKeyOwner(
+		ctx, user1, transaction1.PubKey(), types.PreAuthorizationPermission, types.NewCoinFromInt64(1))
+	assert.Equal(t, acc.ErrCheckMultiSigKey(user1), err)
+	privs, seqs = []crypto.PrivKey{coSigner1, coSigner2}, []int64{2, 2}
+	tx = newTestTx(ctx, []sdk.Msg{donateMsg}, privs, seqs)
+	checkValidTx(t, anteHandler, ctx, tx)
+
+	// test disable multi-signature
+	assert.Nil(t, am.ChangeThreshold(ctx, user1, 0))
+	msg.Permission = types.TransactionPermission
+	privs, seqs = []crypto.PrivKey{transaction1}, []int64{3}
+	tx = newTestTx(ctx, []sdk.Msg{msg}, privs, seqs)
+	checkValidTx(t, anteHandler, ctx, tx)
+}
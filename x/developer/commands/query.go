@@ -11,13 +11,15 @@ import (
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/developer/model"
 
+	dev "github.com/lino-network/lino/x/developer"
+
 	"github.com/cosmos/cosmos-sdk/wire"
 )
 
 // GetDeveloperCmd - returns target developer information
-func GetDeveloperCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetDeveloperCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -28,9 +30,9 @@ func GetDeveloperCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 }
 
 // GetDevelopersCmd - returns all developers relative information
-func GetDevelopersCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetDevelopersCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -41,8 +43,8 @@ func GetDevelopersCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 }
 
 type commander struct {
-	storeName string
-	cdc       *wire.Codec
+	queryRoute string
+	cdc        *wire.Codec
 }
 
 func (c commander) getDeveloperCmd(cmd *cobra.Command, args []string) error {
@@ -52,7 +54,7 @@ func (c commander) getDeveloperCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	accKey := types.AccountKey(args[0])
-	res, err := ctx.Query(model.GetDeveloperKey(accKey), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, dev.QueryDeveloper, string(accKey))
 	if err != nil {
 		return err
 	}
@@ -71,7 +73,7 @@ func (c commander) getDeveloperCmd(cmd *cobra.Command, args []string) error {
 
 func (c commander) getDevelopersCmd(cmd *cobra.Command, args []string) error {
 	ctx := client.NewCoreContextFromViper()
-	res, err := ctx.Query(model.GetDeveloperListKey(), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, dev.QueryDeveloperList)
 	if err != nil {
 		return err
 	}
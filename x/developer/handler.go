@@ -58,7 +58,13 @@ func handleDeveloperRegisterMsg(
 		ctx, msg.Username, deposit, msg.Website, msg.Description, msg.AppMetaData); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionDeveloperRegister),
+			types.TagSender, []byte(msg.Username),
+			types.TagAmount, []byte(msg.Deposit),
+		),
+	}
 }
 
 func handleDeveloperUpdateMsg(
@@ -75,7 +81,12 @@ func handleDeveloperUpdateMsg(
 		ctx, msg.Username, msg.Website, msg.Description, msg.AppMetaData); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionDeveloperUpdate),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
 }
 
 func handleDeveloperRevokeMsg(
@@ -103,7 +114,12 @@ func handleDeveloperRevokeMsg(
 		ctx, msg.Username, gm, am, param.DeveloperCoinReturnTimes, param.DeveloperCoinReturnIntervalSec, coin); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionDeveloperRevoke),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
 }
 
 func handleGrantPermissionMsg(
@@ -119,7 +135,13 @@ func handleGrantPermissionMsg(
 		ctx, msg.Username, msg.AuthorizedApp, msg.ValidityPeriodSec, msg.GrantLevel, types.NewCoinFromInt64(0)); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionGrantPermission),
+			types.TagSender, []byte(msg.Username),
+			types.TagReceiver, []byte(msg.AuthorizedApp),
+		),
+	}
 }
 
 func handleRevokePermissionMsg(
@@ -131,7 +153,12 @@ func handleRevokePermissionMsg(
 	if err := am.RevokePermission(ctx, msg.Username, msg.PubKey); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionRevokePermission),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
 }
 
 func handlePreAuthorizationMsg(
@@ -152,7 +179,14 @@ func handlePreAuthorizationMsg(
 		ctx, msg.Username, msg.AuthorizedApp, msg.ValidityPeriodSec, types.PreAuthorizationPermission, amount); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionPreAuthorization),
+			types.TagSender, []byte(msg.Username),
+			types.TagReceiver, []byte(msg.AuthorizedApp),
+			types.TagAmount, []byte(msg.Amount),
+		),
+	}
 }
 
 func returnCoinTo(
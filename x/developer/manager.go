@@ -209,3 +209,13 @@ func (dm DeveloperManager) WithdrawAll(
 	}
 	return developer.Deposit, nil
 }
+
+// Export - export developer KVStore for genesis
+func (dm DeveloperManager) Export(ctx sdk.Context) (*model.DeveloperTables, sdk.Error) {
+	return dm.storage.Export(ctx)
+}
+
+// Import - import developer KVStore from exported genesis
+func (dm DeveloperManager) Import(ctx sdk.Context, tables *model.DeveloperTables) sdk.Error {
+	return dm.storage.Import(ctx, tables)
+}
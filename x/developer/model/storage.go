@@ -101,6 +101,38 @@ func (ds DeveloperStorage) SetDeveloperList(ctx sdk.Context, lst *DeveloperList)
 	return nil
 }
 
+// Export - export all records in developer KVStore
+func (ds DeveloperStorage) Export(ctx sdk.Context) (*DeveloperTables, sdk.Error) {
+	tables := &DeveloperTables{}
+	store := ctx.KVStore(ds.key)
+	iter := sdk.KVStorePrefixIterator(store, developerSubstore)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var row Developer
+		if err := ds.cdc.UnmarshalJSON(iter.Value(), &row); err != nil {
+			return nil, ErrFailedToUnmarshalDeveloper(err)
+		}
+		tables.Developers = append(tables.Developers, row)
+	}
+
+	lst, err := ds.GetDeveloperList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables.List = *lst
+	return tables, nil
+}
+
+// Import - import all records exported by Export to developer KVStore
+func (ds DeveloperStorage) Import(ctx sdk.Context, tables *DeveloperTables) sdk.Error {
+	for _, row := range tables.Developers {
+		if err := ds.SetDeveloper(ctx, row.Username, &row); err != nil {
+			return err
+		}
+	}
+	return ds.SetDeveloperList(ctx, &tables.List)
+}
+
 // GetDeveloperKey - "developer substore" + "developer"
 func GetDeveloperKey(accKey types.AccountKey) []byte {
 	return append(developerSubstore, accKey...)
@@ -0,0 +1,7 @@
+package model
+
+// DeveloperTables - state of developer KVStore
+type DeveloperTables struct {
+	Developers []Developer   `json:"developers"`
+	List       DeveloperList `json:"list"`
+}
@@ -0,0 +1,59 @@
+package developer
+
+import (
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryDeveloper - query developer, path: developer/<username>
+	QueryDeveloper = "developer"
+	// QueryDeveloperList - query developer list, path: developerList
+	QueryDeveloperList = "developerList"
+)
+
+// NewQuerier - create a querier which serves custom queries under developer route
+func NewQuerier(dm DeveloperManager) sdk.Querier {
+	cdc := wire.NewCodec()
+	wire.RegisterCrypto(cdc)
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) == 0 {
+			return nil, types.ErrUnknownQueryPath("")
+		}
+		switch path[0] {
+		case QueryDeveloper:
+			return queryDeveloper(ctx, cdc, path[1:], dm)
+		case QueryDeveloperList:
+			return queryDeveloperList(ctx, cdc, path[1:], dm)
+		default:
+			return nil, types.ErrUnknownQueryPath(path[0])
+		}
+	}
+}
+
+func queryDeveloper(
+	ctx sdk.Context, cdc *wire.Codec, path []string, dm DeveloperManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	developer, err := dm.storage.GetDeveloper(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, developer)
+}
+
+func queryDeveloperList(
+	ctx sdk.Context, cdc *wire.Codec, path []string, dm DeveloperManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	lst, err := dm.storage.GetDeveloperList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, lst)
+}
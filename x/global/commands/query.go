@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/global/model"
+)
+
+// GetTimeEventQueueCmd returns a summary of a page of time events registered
+// in [start, end) with decoded event types and sizes
+func GetTimeEventQueueCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "time-event-queue <start unix time> <end unix time>",
+		Short: "Query time events queued in a time range",
+		RunE:  cmdr.getTimeEventQueueCmd,
+	}
+	cmd.Flags().Int64(client.FlagLimit, 20, "max number of time event lists in one page")
+	return cmd
+}
+
+// GetFailedEventsCmd returns failed time events in dead-letter store,
+// a single failed event is returned if id is given
+func GetFailedEventsCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "failed-events [id]",
+		Short: "Query failed time events waiting for retry or recovery proposal",
+		RunE:  cmdr.getFailedEventsCmd,
+	}
+}
+
+type commander struct {
+	queryRoute string
+	cdc        *wire.Codec
+}
+
+func (c commander) getTimeEventQueueCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 2 {
+		return errors.New("You must provide start and end unix time")
+	}
+	start, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid start unix time")
+	}
+	end, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid end unix time")
+	}
+	if start >= end {
+		return errors.New("start unix time must be earlier than end unix time")
+	}
+
+	res, err := ctx.QueryCustom(
+		c.queryRoute, global.QueryTimeEventQueue, args[0], args[1],
+		strconv.FormatInt(viper.GetInt64(client.FlagLimit), 10))
+	if err != nil {
+		return err
+	}
+	queue := new(model.TimeEventQueue)
+	if err := c.cdc.UnmarshalJSON(res, queue); err != nil {
+		return err
+	}
+
+	if err := client.PrintIndent(queue); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getFailedEventsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) > 1 {
+		return errors.New("You can only provide one failed event id")
+	}
+
+	if len(args) == 1 {
+		if _, err := strconv.ParseInt(args[0], 10, 64); err != nil {
+			return errors.Wrap(err, "invalid failed event id")
+		}
+		res, err := ctx.QueryCustom(c.queryRoute, global.QueryFailedEvent, args[0])
+		if err != nil {
+			return err
+		}
+		failedEvent := new(model.FailedEvent)
+		if err := c.cdc.UnmarshalJSON(res, failedEvent); err != nil {
+			return err
+		}
+		return client.PrintIndent(failedEvent)
+	}
+
+	res, err := ctx.QueryCustom(c.queryRoute, global.QueryFailedEvents)
+	if err != nil {
+		return err
+	}
+	failedEvents := []model.FailedEvent{}
+	if err := c.cdc.UnmarshalJSON(res, &failedEvents); err != nil {
+		return err
+	}
+	return client.PrintIndent(failedEvents)
+}
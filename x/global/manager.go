@@ -158,6 +158,16 @@ func (gm GlobalManager) GetConsumption(ctx sdk.Context) (types.Coin, sdk.Error)
 	return globalMeta.CumulativeConsumption, nil
 }
 
+// GetContentRewardEventTime - get unix time when the content reward event registered
+// in current block will be executed
+func (gm GlobalManager) GetContentRewardEventTime(ctx sdk.Context) (int64, sdk.Error) {
+	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return ctx.BlockHeader().Time.Unix() + consumptionMeta.ConsumptionFreezingPeriodSec, nil
+}
+
 // AddFrictionAndRegisterContentRewardEvent - register reward calculation event at 7 days later
 func (gm GlobalManager) AddFrictionAndRegisterContentRewardEvent(
 	ctx sdk.Context, event types.Event, friction types.Coin, evaluate types.Coin) sdk.Error {
@@ -165,6 +175,10 @@ func (gm GlobalManager) AddFrictionAndRegisterContentRewardEvent(
 	if err != nil {
 		return err
 	}
+	executeAt, err := gm.GetContentRewardEventTime(ctx)
+	if err != nil {
+		return err
+	}
 	pastDay, err := gm.GetPastDay(ctx, ctx.BlockHeader().Time.Unix())
 	if err != nil {
 		return err
@@ -177,8 +191,7 @@ func (gm GlobalManager) AddFrictionAndRegisterContentRewardEvent(
 	consumptionMeta.ConsumptionWindow = consumptionMeta.ConsumptionWindow.Plus(evaluate)
 	linoStakeStat.TotalConsumptionFriction = linoStakeStat.TotalConsumptionFriction.Plus(friction)
 	linoStakeStat.UnclaimedFriction = linoStakeStat.UnclaimedFriction.Plus(friction)
-	if err := gm.registerEventAtTime(
-		ctx, ctx.BlockHeader().Time.Unix()+consumptionMeta.ConsumptionFreezingPeriodSec, event); err != nil {
+	if err := gm.registerEventAtTime(ctx, executeAt, event); err != nil {
 		return err
 	}
 	if err := gm.storage.SetConsumptionMeta(ctx, consumptionMeta); err != nil {
@@ -291,6 +304,112 @@ func (gm GlobalManager) RegisterCoinReturnEvent(
 	return nil
 }
 
+// RegisterScheduledTransferEvent - register scheduled transfer event at given time,
+// event due in the past is executed in next block
+func (gm GlobalManager) RegisterScheduledTransferEvent(
+	ctx sdk.Context, executeAt int64, event types.Event) sdk.Error {
+	if now := ctx.BlockHeader().Time.Unix(); executeAt < now {
+		executeAt = now
+	}
+	return gm.registerEventAtTime(ctx, executeAt, event)
+}
+
+// RegisterValidatorUnbondingEvent - register validator unbonding event at unbonding complete time
+func (gm GlobalManager) RegisterValidatorUnbondingEvent(
+	ctx sdk.Context, completeAt int64, event types.Event) sdk.Error {
+	return gm.registerEventAtTime(ctx, completeAt, event)
+}
+
+// AddFailedEvent - put event whose execution failed into dead-letter store,
+// the event will be retried in later blocks by FailedEventMaxRetries times
+func (gm GlobalManager) AddFailedEvent(
+	ctx sdk.Context, event types.Event, unixTime int64, execErr sdk.Error) sdk.Error {
+	proposalParam, err := gm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return err
+	}
+	nextID, err := gm.storage.GetNextFailedEventID(ctx)
+	if err != nil {
+		return err
+	}
+	failedEvent := &model.FailedEvent{
+		ID:               nextID.NextFailedEventID,
+		Event:            event,
+		UnixTime:         unixTime,
+		FailedAt:         ctx.BlockHeader().Time.Unix(),
+		Attempts:         1,
+		RemainingRetries: proposalParam.FailedEventMaxRetries,
+		LastTriedHeight:  ctx.BlockHeader().Height,
+		Error:            execErr.Error(),
+	}
+	if err := gm.storage.SetFailedEvent(ctx, failedEvent); err != nil {
+		return err
+	}
+	nextID.NextFailedEventID++
+	return gm.storage.SetNextFailedEventID(ctx, nextID)
+}
+
+// GetRetryableFailedEvents - get failed events which have retries left and are not tried in current block
+func (gm GlobalManager) GetRetryableFailedEvents(ctx sdk.Context) ([]model.FailedEvent, sdk.Error) {
+	failedEvents, err := gm.storage.GetFailedEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	retryable := []model.FailedEvent{}
+	for _, failedEvent := range failedEvents {
+		if failedEvent.RemainingRetries > 0 && failedEvent.LastTriedHeight < ctx.BlockHeader().Height {
+			retryable = append(retryable, failedEvent)
+		}
+	}
+	return retryable, nil
+}
+
+// UpdateFailedEventRetry - remove failed event if retry succeeded, otherwise record the error
+func (gm GlobalManager) UpdateFailedEventRetry(ctx sdk.Context, id int64, retryErr sdk.Error) sdk.Error {
+	failedEvent, err := gm.storage.GetFailedEvent(ctx, id)
+	if err != nil {
+		return err
+	}
+	if retryErr == nil {
+		gm.storage.RemoveFailedEvent(ctx, id)
+		return nil
+	}
+	failedEvent.Attempts++
+	failedEvent.RemainingRetries--
+	failedEvent.LastTriedHeight = ctx.BlockHeader().Height
+	failedEvent.Error = retryErr.Error()
+	return gm.storage.SetFailedEvent(ctx, failedEvent)
+}
+
+// DropFailedEvent - remove failed event from dead-letter store without executing it
+func (gm GlobalManager) DropFailedEvent(ctx sdk.Context, id int64) sdk.Error {
+	if !gm.storage.DoesFailedEventExist(ctx, id) {
+		return model.ErrFailedEventNotFound(id)
+	}
+	gm.storage.RemoveFailedEvent(ctx, id)
+	return nil
+}
+
+// ReplayFailedEvent - allow failed event to be executed once more
+func (gm GlobalManager) ReplayFailedEvent(ctx sdk.Context, id int64) sdk.Error {
+	failedEvent, err := gm.storage.GetFailedEvent(ctx, id)
+	if err != nil {
+		return err
+	}
+	failedEvent.RemainingRetries++
+	return gm.storage.SetFailedEvent(ctx, failedEvent)
+}
+
+// GetFailedEvent - get failed event in dead-letter store
+func (gm GlobalManager) GetFailedEvent(ctx sdk.Context, id int64) (*model.FailedEvent, sdk.Error) {
+	return gm.storage.GetFailedEvent(ctx, id)
+}
+
+// DoesFailedEventExist - check if failed event is in dead-letter store
+func (gm GlobalManager) DoesFailedEventExist(ctx sdk.Context, id int64) bool {
+	return gm.storage.DoesFailedEventExist(ctx, id)
+}
+
 // RegisterProposalDecideEvent - register proposal decide event
 func (gm GlobalManager) RegisterProposalDecideEvent(
 	ctx sdk.Context, decideSec int64, event types.Event) sdk.Error {
@@ -457,13 +576,17 @@ func (gm GlobalManager) AddConsumption(ctx sdk.Context, coin types.Coin) sdk.Err
 	return nil
 }
 
-// AddToDeveloperInflationPool - add coin to developer inflation pool
+// AddToDeveloperInflationPool - add coin to developer inflation pool, the coin leaves
+// circulation and is added to total lino coin again once distributed
 func (gm GlobalManager) AddToDeveloperInflationPool(ctx sdk.Context, coin types.Coin) sdk.Error {
 	inflationPool, err := gm.storage.GetInflationPool(ctx)
 	if err != nil {
 		return err
 	}
 	inflationPool.DeveloperInflationPool = inflationPool.DeveloperInflationPool.Plus(coin)
+	if err := gm.minusTotalLinoCoin(ctx, coin); err != nil {
+		return err
+	}
 
 	if err := gm.storage.SetInflationPool(ctx, inflationPool); err != nil {
 		return err
@@ -471,13 +594,17 @@ func (gm GlobalManager) AddToDeveloperInflationPool(ctx sdk.Context, coin types.
 	return nil
 }
 
-// AddToValidatorInflationPool - add validator inflation to pool
+// AddToValidatorInflationPool - add coin to validator inflation pool, the coin leaves
+// circulation and is added to total lino coin again once distributed
 func (gm GlobalManager) AddToValidatorInflationPool(ctx sdk.Context, coin types.Coin) sdk.Error {
 	pool, err := gm.storage.GetInflationPool(ctx)
 	if err != nil {
 		return err
 	}
 	pool.ValidatorInflationPool = pool.ValidatorInflationPool.Plus(coin)
+	if err := gm.minusTotalLinoCoin(ctx, coin); err != nil {
+		return err
+	}
 	if err := gm.storage.SetInflationPool(ctx, pool); err != nil {
 		return err
 	}
@@ -550,6 +677,19 @@ func (gm GlobalManager) addTotalLinoCoin(ctx sdk.Context, newCoin types.Coin) sd
 	return nil
 }
 
+func (gm GlobalManager) minusTotalLinoCoin(ctx sdk.Context, coin types.Coin) sdk.Error {
+	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+	if err != nil {
+		return err
+	}
+	globalMeta.TotalLinoCoin = globalMeta.TotalLinoCoin.Minus(coin)
+
+	if err := gm.storage.SetGlobalMeta(ctx, globalMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
 // UpdateTPS - update current tps based on current block information
 func (gm GlobalManager) UpdateTPS(ctx sdk.Context) sdk.Error {
 	tps, err := gm.storage.GetTPS(ctx)
@@ -666,3 +806,13 @@ func PostConsumptionTimesAdjustment(
 	return (1.0/(1.0+math.Exp(
 		(float64(numOfConsumptionOnAuthor)-float64(paras.NumOfConsumptionOnAuthorOffset)))) + 1.0) + 1.0
 }
+
+// Export - export global KVStore for genesis
+func (gm GlobalManager) Export(ctx sdk.Context) (*model.GlobalTables, sdk.Error) {
+	return gm.storage.Export(ctx)
+}
+
+// Import - import global KVStore from exported genesis
+func (gm GlobalManager) Import(ctx sdk.Context, tables *model.GlobalTables) sdk.Error {
+	return gm.storage.Import(ctx, tables)
+}
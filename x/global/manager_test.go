@@ -1102,6 +1102,9 @@ func TestAddToValidatorInflationPool(t *testing.T) {
 	}
 	err := gm.storage.SetInflationPool(ctx, inflationPool)
 	assert.Nil(t, err)
+	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+	assert.Nil(t, err)
+	totalLino := globalMeta.TotalLinoCoin
 
 	testCases := []struct {
 		testName string
@@ -1134,6 +1137,11 @@ func TestAddToValidatorInflationPool(t *testing.T) {
 				pool.ValidatorInflationPool, tc.expect)
 		}
 	}
+
+	// coin added to pool leaves circulation
+	globalMeta, err = gm.storage.GetGlobalMeta(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, totalLino.Minus(types.NewCoinFromInt64(101)), globalMeta.TotalLinoCoin)
 }
 
 func TestAddConsumption(t *testing.T) {
@@ -1757,3 +1765,79 @@ func TestRegisterParamChangeEvent(t *testing.T) {
 		assert.Equal(t, timeEventList.Events, tc.expectEventList)
 	}
 }
+
+func TestFailedEvent(t *testing.T) {
+	ctx, gm := setupTest(t)
+	proposalParam, _ := gm.paramHolder.GetProposalParam(ctx)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(100, 0), Height: 1})
+
+	err := gm.AddFailedEvent(ctx, testEvent{}, 90, model.ErrGlobalMetaNotFound())
+	assert.Nil(t, err)
+	err = gm.AddFailedEvent(ctx, testEvent{}, 95, model.ErrInflationPoolNotFound())
+	assert.Nil(t, err)
+	assert.True(t, gm.DoesFailedEventExist(ctx, 1))
+	assert.True(t, gm.DoesFailedEventExist(ctx, 2))
+	assert.False(t, gm.DoesFailedEventExist(ctx, 3))
+
+	failedEvent, err := gm.storage.GetFailedEvent(ctx, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, model.FailedEvent{
+		ID:               1,
+		Event:            testEvent{},
+		UnixTime:         90,
+		FailedAt:         100,
+		Attempts:         1,
+		RemainingRetries: proposalParam.FailedEventMaxRetries,
+		LastTriedHeight:  1,
+		Error:            model.ErrGlobalMetaNotFound().Error(),
+	}, *failedEvent)
+
+	// events failed in current block are not retried in the same block
+	retryable, err := gm.GetRetryableFailedEvents(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(retryable))
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(110, 0), Height: 2})
+	retryable, err = gm.GetRetryableFailedEvents(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(retryable))
+
+	// successful retry removes failed event, failed retry consumes one retry
+	err = gm.UpdateFailedEventRetry(ctx, 1, nil)
+	assert.Nil(t, err)
+	assert.False(t, gm.DoesFailedEventExist(ctx, 1))
+	for i := int64(0); i < proposalParam.FailedEventMaxRetries; i++ {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(110+i, 0), Height: 2 + i})
+		err = gm.UpdateFailedEventRetry(ctx, 2, model.ErrGlobalMetaNotFound())
+		assert.Nil(t, err)
+	}
+	failedEvent, err = gm.storage.GetFailedEvent(ctx, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1+proposalParam.FailedEventMaxRetries, failedEvent.Attempts)
+	assert.Equal(t, int64(0), failedEvent.RemainingRetries)
+	assert.Equal(t, model.ErrGlobalMetaNotFound().Error(), failedEvent.Error)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(200, 0), Height: 100})
+	retryable, err = gm.GetRetryableFailedEvents(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(retryable))
+
+	// replay gives failed event one more chance
+	err = gm.ReplayFailedEvent(ctx, 2)
+	assert.Nil(t, err)
+	retryable, err = gm.GetRetryableFailedEvents(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(retryable))
+	assert.Equal(t, int64(2), retryable[0].ID)
+
+	err = gm.DropFailedEvent(ctx, 2)
+	assert.Nil(t, err)
+	assert.False(t, gm.DoesFailedEventExist(ctx, 2))
+	assert.Equal(t, model.ErrFailedEventNotFound(2), gm.DropFailedEvent(ctx, 2))
+	assert.Equal(t, model.ErrFailedEventNotFound(2).Code(), gm.ReplayFailedEvent(ctx, 2).Code())
+
+	// failed event id keeps increasing after removal
+	err = gm.AddFailedEvent(ctx, testEvent{}, 200, model.ErrGlobalMetaNotFound())
+	assert.Nil(t, err)
+	assert.True(t, gm.DoesFailedEventExist(ctx, 3))
+}
@@ -101,3 +101,18 @@ func ErrFailedToUnmarshalTPS(err error) sdk.Error {
 func ErrFailedToUnmarshalTime(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalTime, fmt.Sprintf("failed to unmarshal time: %s", err.Error()))
 }
+
+// ErrFailedEventNotFound - error if failed event is not found in KVStore
+func ErrFailedEventNotFound(id int64) sdk.Error {
+	return types.NewError(types.CodeFailedEventNotFound, fmt.Sprintf("failed event %v is not found", id))
+}
+
+// ErrFailedToMarshalFailedEvent - error if marshal failed event failed
+func ErrFailedToMarshalFailedEvent(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalFailedEvent, fmt.Sprintf("failed to marshal failed event: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalFailedEvent - error if unmarshal failed event failed
+func ErrFailedToUnmarshalFailedEvent(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalFailedEvent, fmt.Sprintf("failed to unmarshal failed event: %s", err.Error()))
+}
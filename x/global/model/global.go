@@ -65,3 +65,59 @@ type InitParamList struct {
 	ConsumptionFrictionRate      sdk.Rat `json:"consumption_friction_rate"`
 	ConsumptionFreezingPeriodSec int64   `json:"consumption_freezing_period_second"`
 }
+
+// TimeEventQueue - summary of a page of time event lists registered in a time range
+// LastBlockTime is used to spot overdue events which should have been executed.
+// NextStart is the unix time to query next page from, zero if there is no more list
+type TimeEventQueue struct {
+	Start           int64                  `json:"start"`
+	End             int64                  `json:"end"`
+	NextStart       int64                  `json:"next_start"`
+	LastBlockTime   int64                  `json:"last_block_time"`
+	NumOfLists      int64                  `json:"num_of_lists"`
+	NumOfEvents     int64                  `json:"num_of_events"`
+	NumOfOverdue    int64                  `json:"num_of_overdue"`
+	TotalSize       int64                  `json:"total_size"`
+	EventTypeCounts []EventTypeCount       `json:"event_type_counts"`
+	Lists           []TimeEventListSummary `json:"lists"`
+}
+
+// EventTypeCount - number of queued events of a type
+type EventTypeCount struct {
+	Type  string `json:"type"`
+	Count int64  `json:"count"`
+}
+
+// TimeEventListSummary - time event list at unix time with decoded event types
+// and encoded size in bytes
+type TimeEventListSummary struct {
+	UnixTime int64            `json:"unix_time"`
+	Size     int64            `json:"size"`
+	Events   []TimeEventEntry `json:"events"`
+}
+
+// TimeEventEntry - queued event with its type name
+type TimeEventEntry struct {
+	Type  string      `json:"type"`
+	Event types.Event `json:"event"`
+}
+
+// FailedEvent - time event whose execution returned error, kept in dead-letter store
+// UnixTime is the time event was registered at
+// RemainingRetries is the times left to retry in later blocks
+// LastTriedHeight is the height of last execution
+type FailedEvent struct {
+	ID               int64       `json:"id"`
+	Event            types.Event `json:"event"`
+	UnixTime         int64       `json:"unix_time"`
+	FailedAt         int64       `json:"failed_at"`
+	Attempts         int64       `json:"attempts"`
+	RemainingRetries int64       `json:"remaining_retries"`
+	LastTriedHeight  int64       `json:"last_tried_height"`
+	Error            string      `json:"error"`
+}
+
+// NextFailedEventID - id of next failed event put into dead-letter store
+type NextFailedEventID struct {
+	NextFailedEventID int64 `json:"next_failed_event_id"`
+}
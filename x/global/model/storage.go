@@ -1,6 +1,8 @@
 package model
 
 import (
+	"encoding/binary"
+	"sort"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/wire"
@@ -18,6 +20,8 @@ var (
 	tpsSubStore             = []byte{0x04} // SubStore for tps
 	timeSubStore            = []byte{0x05} // SubStore for time
 	linoStakeStatSubStore   = []byte{0x06} // SubStore for lino power statistic
+	failedEventSubStore     = []byte{0x07} // SubStore for failed time events
+	failedEventIDSubStore   = []byte{0x08} // SubStore for next failed event id
 )
 
 // GlobalStorage - global storage
@@ -150,6 +154,39 @@ func (gs GlobalStorage) RemoveTimeEventList(ctx sdk.Context, unixTime int64) sdk
 	return nil
 }
 
+// GetTimeEventListsInRange - get at most limit time event lists registered in [start, end) ordered by time
+func (gs GlobalStorage) GetTimeEventListsInRange(
+	ctx sdk.Context, start, end, limit int64) ([]TimeEventListRow, sdk.Error) {
+	store := ctx.KVStore(gs.key)
+	// unix time is stored in decimal string, the order of key is not the order of time
+	iter := sdk.KVStorePrefixIterator(store, timeEventListSubStore)
+	defer iter.Close()
+	times := []int64{}
+	for ; iter.Valid(); iter.Next() {
+		unixTime, err := getTimeEventListUnixTime(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if unixTime < start || unixTime >= end {
+			continue
+		}
+		times = append(times, unixTime)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+	if int64(len(times)) > limit {
+		times = times[:limit]
+	}
+	rows := []TimeEventListRow{}
+	for _, unixTime := range times {
+		lst, err := gs.GetTimeEventList(ctx, unixTime)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, TimeEventListRow{UnixTime: unixTime, TimeEventList: *lst})
+	}
+	return rows, nil
+}
+
 // SetLinoStakeStat - set lino power statistic at given day
 func (gs GlobalStorage) SetLinoStakeStat(ctx sdk.Context, day int64, lps *LinoStakeStat) sdk.Error {
 	store := ctx.KVStore(gs.key)
@@ -300,6 +337,196 @@ func (gs GlobalStorage) SetGlobalTime(ctx sdk.Context, globalTime *GlobalTime) s
 	return nil
 }
 
+// DoesFailedEventExist - check if failed event exists in KVStore
+func (gs GlobalStorage) DoesFailedEventExist(ctx sdk.Context, id int64) bool {
+	store := ctx.KVStore(gs.key)
+	return store.Has(GetFailedEventKey(id))
+}
+
+// GetFailedEvent - get failed event from KVStore
+func (gs GlobalStorage) GetFailedEvent(ctx sdk.Context, id int64) (*FailedEvent, sdk.Error) {
+	store := ctx.KVStore(gs.key)
+	eventBytes := store.Get(GetFailedEventKey(id))
+	if eventBytes == nil {
+		return nil, ErrFailedEventNotFound(id)
+	}
+	failedEvent := new(FailedEvent)
+	if err := gs.cdc.UnmarshalJSON(eventBytes, failedEvent); err != nil {
+		return nil, ErrFailedToUnmarshalFailedEvent(err)
+	}
+	return failedEvent, nil
+}
+
+// SetFailedEvent - set failed event to KVStore
+func (gs GlobalStorage) SetFailedEvent(ctx sdk.Context, failedEvent *FailedEvent) sdk.Error {
+	store := ctx.KVStore(gs.key)
+	eventBytes, err := gs.cdc.MarshalJSON(*failedEvent)
+	if err != nil {
+		return ErrFailedToMarshalFailedEvent(err)
+	}
+	store.Set(GetFailedEventKey(failedEvent.ID), eventBytes)
+	return nil
+}
+
+// RemoveFailedEvent - remove failed event from KVStore
+func (gs GlobalStorage) RemoveFailedEvent(ctx sdk.Context, id int64) {
+	store := ctx.KVStore(gs.key)
+	store.Delete(GetFailedEventKey(id))
+}
+
+// GetFailedEvents - get all failed events ordered by id
+func (gs GlobalStorage) GetFailedEvents(ctx sdk.Context) ([]FailedEvent, sdk.Error) {
+	store := ctx.KVStore(gs.key)
+	iter := sdk.KVStorePrefixIterator(store, failedEventSubStore)
+	defer iter.Close()
+	failedEvents := []FailedEvent{}
+	for ; iter.Valid(); iter.Next() {
+		var failedEvent FailedEvent
+		if err := gs.cdc.UnmarshalJSON(iter.Value(), &failedEvent); err != nil {
+			return nil, ErrFailedToUnmarshalFailedEvent(err)
+		}
+		failedEvents = append(failedEvents, failedEvent)
+	}
+	return failedEvents, nil
+}
+
+// GetNextFailedEventID - get id of next failed event, starts from 1
+func (gs GlobalStorage) GetNextFailedEventID(ctx sdk.Context) (*NextFailedEventID, sdk.Error) {
+	store := ctx.KVStore(gs.key)
+	idBytes := store.Get(GetNextFailedEventIDKey())
+	if idBytes == nil {
+		return &NextFailedEventID{NextFailedEventID: 1}, nil
+	}
+	nextID := new(NextFailedEventID)
+	if err := gs.cdc.UnmarshalJSON(idBytes, nextID); err != nil {
+		return nil, ErrFailedToUnmarshalFailedEvent(err)
+	}
+	return nextID, nil
+}
+
+// SetNextFailedEventID - set id of next failed event
+func (gs GlobalStorage) SetNextFailedEventID(ctx sdk.Context, nextID *NextFailedEventID) sdk.Error {
+	store := ctx.KVStore(gs.key)
+	idBytes, err := gs.cdc.MarshalJSON(*nextID)
+	if err != nil {
+		return ErrFailedToMarshalFailedEvent(err)
+	}
+	store.Set(GetNextFailedEventIDKey(), idBytes)
+	return nil
+}
+
+// Export - export all records in global KVStore
+func (gs GlobalStorage) Export(ctx sdk.Context) (*GlobalTables, sdk.Error) {
+	tables := &GlobalTables{}
+	globalMeta, err := gs.GetGlobalMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables.GlobalMeta = *globalMeta
+	inflationPool, err := gs.GetInflationPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables.InflationPool = *inflationPool
+	consumptionMeta, err := gs.GetConsumptionMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables.ConsumptionMeta = *consumptionMeta
+	tps, err := gs.GetTPS(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables.TPS = *tps
+	globalTime, err := gs.GetGlobalTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables.GlobalTime = *globalTime
+
+	store := ctx.KVStore(gs.key)
+	eventIter := sdk.KVStorePrefixIterator(store, timeEventListSubStore)
+	defer eventIter.Close()
+	for ; eventIter.Valid(); eventIter.Next() {
+		unixTime, err := getTimeEventListUnixTime(eventIter.Key())
+		if err != nil {
+			return nil, err
+		}
+		row := TimeEventListRow{UnixTime: unixTime}
+		if err := gs.cdc.UnmarshalJSON(eventIter.Value(), &row.TimeEventList); err != nil {
+			return nil, ErrFailedToUnmarshalTimeEventList(err)
+		}
+		tables.TimeEventLists = append(tables.TimeEventLists, row)
+	}
+
+	statIter := sdk.KVStorePrefixIterator(store, linoStakeStatSubStore)
+	defer statIter.Close()
+	for ; statIter.Valid(); statIter.Next() {
+		day, parseErr := strconv.ParseInt(string(statIter.Key()[len(linoStakeStatSubStore):]), 10, 64)
+		if parseErr != nil {
+			return nil, ErrFailedToUnmarshalLinoStakeStatistic(parseErr)
+		}
+		row := LinoStakeStatRow{Day: day}
+		if err := gs.cdc.UnmarshalJSON(statIter.Value(), &row.LinoStakeStat); err != nil {
+			return nil, ErrFailedToUnmarshalLinoStakeStatistic(err)
+		}
+		tables.LinoStakeStats = append(tables.LinoStakeStats, row)
+	}
+
+	failedEvents, err := gs.GetFailedEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables.FailedEvents = failedEvents
+	nextFailedEventID, err := gs.GetNextFailedEventID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables.NextFailedEvent = *nextFailedEventID
+	return tables, nil
+}
+
+// Import - import all records exported by Export to global KVStore
+func (gs GlobalStorage) Import(ctx sdk.Context, tables *GlobalTables) sdk.Error {
+	if err := gs.SetGlobalMeta(ctx, &tables.GlobalMeta); err != nil {
+		return err
+	}
+	if err := gs.SetInflationPool(ctx, &tables.InflationPool); err != nil {
+		return err
+	}
+	if err := gs.SetConsumptionMeta(ctx, &tables.ConsumptionMeta); err != nil {
+		return err
+	}
+	if err := gs.SetTPS(ctx, &tables.TPS); err != nil {
+		return err
+	}
+	if err := gs.SetGlobalTime(ctx, &tables.GlobalTime); err != nil {
+		return err
+	}
+	for _, row := range tables.TimeEventLists {
+		if err := gs.SetTimeEventList(ctx, row.UnixTime, &row.TimeEventList); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.LinoStakeStats {
+		if err := gs.SetLinoStakeStat(ctx, row.Day, &row.LinoStakeStat); err != nil {
+			return err
+		}
+	}
+	for _, failedEvent := range tables.FailedEvents {
+		if err := gs.SetFailedEvent(ctx, &failedEvent); err != nil {
+			return err
+		}
+	}
+	// state exported before dead-letter store was introduced has no next failed event id
+	if tables.NextFailedEvent.NextFailedEventID > 0 {
+		if err := gs.SetNextFailedEventID(ctx, &tables.NextFailedEvent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetLinoStakeStatKey - get lino power statistic at day from KVStore
 func GetLinoStakeStatKey(day int64) []byte {
 	return append(linoStakeStatSubStore, strconv.FormatInt(day, 10)...)
@@ -310,6 +537,14 @@ func GetTimeEventListKey(unixTime int64) []byte {
 	return append(timeEventListSubStore, strconv.FormatInt(unixTime, 10)...)
 }
 
+func getTimeEventListUnixTime(key []byte) (int64, sdk.Error) {
+	unixTime, err := strconv.ParseInt(string(key[len(timeEventListSubStore):]), 10, 64)
+	if err != nil {
+		return 0, ErrFailedToUnmarshalTimeEventList(err)
+	}
+	return unixTime, nil
+}
+
 // GetGlobalMetaKey - "global meta substore"
 func GetGlobalMetaKey() []byte {
 	return globalMetaSubStore
@@ -334,3 +569,15 @@ func GetTPSKey() []byte {
 func GetTimeKey() []byte {
 	return timeSubStore
 }
+
+// GetFailedEventKey - "failed event substore" + "id"
+func GetFailedEventKey(id int64) []byte {
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, uint64(id))
+	return append(failedEventSubStore, idBytes...)
+}
+
+// GetNextFailedEventIDKey - "next failed event id substore"
+func GetNextFailedEventIDKey() []byte {
+	return failedEventIDSubStore
+}
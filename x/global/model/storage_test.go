@@ -76,3 +76,42 @@ func TestGlobalStorageGenesis(t *testing.T) {
 	}
 	checkGlobalStorage(t, ctx, gm, globalMeta, consumptionMeta, inflationPool)
 }
+
+func TestTimeEventListsInRange(t *testing.T) {
+	gm := NewGlobalStorage(TestGlobalKVStoreKey)
+	ctx := getContext()
+
+	// key layout is kept so that lists registered before upgrade are still reachable
+	assert.Equal(t, append(timeEventListSubStore, []byte("100")...), GetTimeEventListKey(100))
+
+	// decimal keys are not in time order
+	for _, unixTime := range []int64{1000, 99, 100, 20} {
+		err := gm.SetTimeEventList(ctx, unixTime, &types.TimeEventList{})
+		assert.Nil(t, err)
+	}
+	testCases := []struct {
+		testName    string
+		start       int64
+		end         int64
+		limit       int64
+		expectTimes []int64
+	}{
+		{"all lists in range", 0, 2000, 10, []int64{20, 99, 100, 1000}},
+		{"end is exclusive", 99, 1000, 10, []int64{99, 100}},
+		{"limit applies in time order", 0, 2000, 2, []int64{20, 99}},
+		{"empty range", 1001, 2000, 10, []int64{}},
+	}
+	for _, tc := range testCases {
+		rows, err := gm.GetTimeEventListsInRange(ctx, tc.start, tc.end, tc.limit)
+		if err != nil {
+			t.Errorf("%s: failed to get time event lists, got err %v", tc.testName, err)
+		}
+		times := []int64{}
+		for _, row := range rows {
+			times = append(times, row.UnixTime)
+		}
+		if !assert.Equal(t, tc.expectTimes, times) {
+			t.Errorf("%s: diff time event lists", tc.testName)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"github.com/lino-network/lino/types"
+)
+
+// TimeEventListRow - time event list at unix time
+type TimeEventListRow struct {
+	UnixTime      int64               `json:"unix_time"`
+	TimeEventList types.TimeEventList `json:"time_event_list"`
+}
+
+// LinoStakeStatRow - lino stake statistic at day
+type LinoStakeStatRow struct {
+	Day           int64         `json:"day"`
+	LinoStakeStat LinoStakeStat `json:"lino_stake_stat"`
+}
+
+// GlobalTables - state of global KVStore
+type GlobalTables struct {
+	GlobalMeta      GlobalMeta         `json:"global_meta"`
+	InflationPool   InflationPool      `json:"inflation_pool"`
+	ConsumptionMeta ConsumptionMeta    `json:"consumption_meta"`
+	TPS             TPS                `json:"tps"`
+	GlobalTime      GlobalTime         `json:"global_time"`
+	TimeEventLists  []TimeEventListRow `json:"time_event_lists"`
+	LinoStakeStats  []LinoStakeStatRow `json:"lino_stake_stats"`
+	FailedEvents    []FailedEvent      `json:"failed_events"`
+	NextFailedEvent NextFailedEventID  `json:"next_failed_event"`
+}
@@ -0,0 +1,274 @@
+package global
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global/model"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryTimeEventList - query time event list at given unix time, path: timeEventList/<unix time>
+	QueryTimeEventList = "timeEventList"
+	// QueryTimeEventQueue - query summary of a page of time event lists in [start, end), path: timeEventQueue/<start>/<end>/<limit>
+	QueryTimeEventQueue = "timeEventQueue"
+	// QueryFailedEvent - query failed event in dead-letter store, path: failedEvent/<id>
+	QueryFailedEvent = "failedEvent"
+	// QueryFailedEvents - query all failed events in dead-letter store, path: failedEvents
+	QueryFailedEvents = "failedEvents"
+	// QueryGlobalMeta - query global meta, path: globalMeta
+	QueryGlobalMeta = "globalMeta"
+	// QueryInflationPool - query inflation pool, path: inflationPool
+	QueryInflationPool = "inflationPool"
+	// QueryConsumptionMeta - query consumption meta, path: consumptionMeta
+	QueryConsumptionMeta = "consumptionMeta"
+	// QueryTPS - query tps, path: tps
+	QueryTPS = "tps"
+	// QueryGlobalTime - query global time, path: globalTime
+	QueryGlobalTime = "globalTime"
+	// QueryLinoStakeStat - query lino stake statistic at given day, path: linoStakeStat/<day>
+	QueryLinoStakeStat = "linoStakeStat"
+)
+
+// NewQuerier - create a querier which serves custom queries under global route,
+// global manager codec is used since time event list contains registered events
+func NewQuerier(gm GlobalManager) sdk.Querier {
+	cdc := gm.WireCodec()
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) == 0 {
+			return nil, types.ErrUnknownQueryPath("")
+		}
+		switch path[0] {
+		case QueryTimeEventList:
+			return queryTimeEventList(ctx, cdc, path[1:], gm)
+		case QueryTimeEventQueue:
+			return queryTimeEventQueue(ctx, cdc, path[1:], gm)
+		case QueryFailedEvent:
+			return queryFailedEvent(ctx, cdc, path[1:], gm)
+		case QueryFailedEvents:
+			return queryFailedEvents(ctx, cdc, path[1:], gm)
+		case QueryGlobalMeta:
+			return queryGlobalMeta(ctx, cdc, path[1:], gm)
+		case QueryInflationPool:
+			return queryInflationPool(ctx, cdc, path[1:], gm)
+		case QueryConsumptionMeta:
+			return queryConsumptionMeta(ctx, cdc, path[1:], gm)
+		case QueryTPS:
+			return queryTPS(ctx, cdc, path[1:], gm)
+		case QueryGlobalTime:
+			return queryGlobalTime(ctx, cdc, path[1:], gm)
+		case QueryLinoStakeStat:
+			return queryLinoStakeStat(ctx, cdc, path[1:], gm)
+		default:
+			return nil, types.ErrUnknownQueryPath(path[0])
+		}
+	}
+}
+
+func queryTimeEventList(
+	ctx sdk.Context, cdc *wire.Codec, path []string, gm GlobalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	unixTime, parseErr := strconv.ParseInt(path[0], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	lst, err := gm.storage.GetTimeEventList(ctx, unixTime)
+	if err != nil {
+		return nil, err
+	}
+	if lst == nil {
+		lst = &types.TimeEventList{Events: []types.Event{}}
+	}
+	return types.MarshalQueryResult(cdc, lst)
+}
+
+func queryTimeEventQueue(
+	ctx sdk.Context, cdc *wire.Codec, path []string, gm GlobalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 3); err != nil {
+		return nil, err
+	}
+	start, parseErr := strconv.ParseInt(path[0], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	end, parseErr := strconv.ParseInt(path[1], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	limit, parseErr := strconv.ParseInt(path[2], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	if start < 0 || start >= end {
+		return nil, types.ErrInvalidQueryParams(fmt.Sprintf("invalid time range [%v, %v)", start, end))
+	}
+	if limit <= 0 || limit > types.MaximumTimeEventQueuePageSize {
+		return nil, types.ErrInvalidQueryParams(fmt.Sprintf("invalid limit %v", limit))
+	}
+	lastBlockTime, err := gm.GetLastBlockTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// one more list is loaded to tell where next page starts
+	rows, err := gm.storage.GetTimeEventListsInRange(ctx, start, end, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	nextStart := int64(0)
+	if int64(len(rows)) > limit {
+		nextStart = rows[limit].UnixTime
+		rows = rows[:limit]
+	}
+	queue := model.TimeEventQueue{
+		Start:           start,
+		End:             end,
+		NextStart:       nextStart,
+		LastBlockTime:   lastBlockTime,
+		EventTypeCounts: []model.EventTypeCount{},
+		Lists:           []model.TimeEventListSummary{},
+	}
+	typeCounts := map[string]int64{}
+	for _, row := range rows {
+		listBytes, marshalErr := cdc.MarshalJSON(row.TimeEventList)
+		if marshalErr != nil {
+			return nil, types.ErrFailedToMarshalQueryResult(marshalErr)
+		}
+		summary := model.TimeEventListSummary{
+			UnixTime: row.UnixTime,
+			Size:     int64(len(listBytes)),
+			Events:   []model.TimeEventEntry{},
+		}
+		for _, event := range row.TimeEventList.Events {
+			eventType := fmt.Sprintf("%T", event)
+			typeCounts[eventType]++
+			summary.Events = append(summary.Events, model.TimeEventEntry{Type: eventType, Event: event})
+		}
+		// events before last block time should have been executed and removed
+		if row.UnixTime < lastBlockTime {
+			queue.NumOfOverdue += int64(len(summary.Events))
+		}
+		queue.NumOfLists++
+		queue.NumOfEvents += int64(len(summary.Events))
+		queue.TotalSize += summary.Size
+		queue.Lists = append(queue.Lists, summary)
+	}
+	for eventType, count := range typeCounts {
+		queue.EventTypeCounts = append(queue.EventTypeCounts, model.EventTypeCount{Type: eventType, Count: count})
+	}
+	sort.Slice(queue.EventTypeCounts, func(i, j int) bool {
+		return queue.EventTypeCounts[i].Type < queue.EventTypeCounts[j].Type
+	})
+	return types.MarshalQueryResult(cdc, queue)
+}
+
+func queryFailedEvent(
+	ctx sdk.Context, cdc *wire.Codec, path []string, gm GlobalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	id, parseErr := strconv.ParseInt(path[0], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	failedEvent, err := gm.storage.GetFailedEvent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, failedEvent)
+}
+
+func queryFailedEvents(
+	ctx sdk.Context, cdc *wire.Codec, path []string, gm GlobalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	failedEvents, err := gm.storage.GetFailedEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, failedEvents)
+}
+
+func queryGlobalMeta(
+	ctx sdk.Context, cdc *wire.Codec, path []string, gm GlobalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, globalMeta)
+}
+
+func queryInflationPool(
+	ctx sdk.Context, cdc *wire.Codec, path []string, gm GlobalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	pool, err := gm.storage.GetInflationPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, pool)
+}
+
+func queryConsumptionMeta(
+	ctx sdk.Context, cdc *wire.Codec, path []string, gm GlobalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, consumptionMeta)
+}
+
+func queryTPS(
+	ctx sdk.Context, cdc *wire.Codec, path []string, gm GlobalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	tps, err := gm.storage.GetTPS(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, tps)
+}
+
+func queryGlobalTime(
+	ctx sdk.Context, cdc *wire.Codec, path []string, gm GlobalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	globalTime, err := gm.storage.GetGlobalTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, globalTime)
+}
+
+func queryLinoStakeStat(
+	ctx sdk.Context, cdc *wire.Codec, path []string, gm GlobalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	day, parseErr := strconv.ParseInt(path[0], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	linoStakeStat, err := gm.storage.GetLinoStakeStat(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, linoStakeStat)
+}
@@ -0,0 +1,77 @@
+package global
+
+import (
+	"testing"
+
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global/model"
+	"github.com/stretchr/testify/assert"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestQueryTimeEventQueue(t *testing.T) {
+	ctx, gm := setupTest(t)
+	querier := NewQuerier(gm)
+	cdc := gm.WireCodec()
+
+	for _, unixTime := range []int64{99, 100, 100, 1000} {
+		err := gm.registerEventAtTime(ctx, unixTime, testEvent{})
+		assert.Nil(t, err)
+	}
+	err := gm.SetLastBlockTime(ctx, 100)
+	assert.Nil(t, err)
+
+	res, err := querier(ctx, []string{QueryTimeEventQueue, "0", "1000", "10"}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	queue := new(model.TimeEventQueue)
+	assert.Nil(t, cdc.UnmarshalJSON(res, queue))
+
+	listBytes, marshalErr := cdc.MarshalJSON(types.TimeEventList{Events: []types.Event{testEvent{}}})
+	assert.Nil(t, marshalErr)
+	assert.Equal(t, int64(0), queue.Start)
+	assert.Equal(t, int64(1000), queue.End)
+	assert.Equal(t, int64(0), queue.NextStart)
+	assert.Equal(t, int64(100), queue.LastBlockTime)
+	assert.Equal(t, int64(2), queue.NumOfLists)
+	assert.Equal(t, int64(3), queue.NumOfEvents)
+	assert.Equal(t, int64(1), queue.NumOfOverdue)
+	assert.Equal(t, []model.EventTypeCount{{Type: "global.testEvent", Count: 3}}, queue.EventTypeCounts)
+	assert.Equal(t, 2, len(queue.Lists))
+	assert.Equal(t, int64(99), queue.Lists[0].UnixTime)
+	assert.Equal(t, int64(len(listBytes)), queue.Lists[0].Size)
+	assert.Equal(t, int64(100), queue.Lists[1].UnixTime)
+	assert.Equal(t, 2, len(queue.Lists[1].Events))
+	assert.Equal(t, queue.Lists[0].Size+queue.Lists[1].Size, queue.TotalSize)
+
+	res, err = querier(ctx, []string{QueryTimeEventQueue, "1001", "2000", "10"}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	queue = new(model.TimeEventQueue)
+	assert.Nil(t, cdc.UnmarshalJSON(res, queue))
+	assert.Equal(t, int64(0), queue.NumOfEvents)
+	assert.Equal(t, 0, len(queue.Lists))
+
+	// lists are paged in time order
+	res, err = querier(ctx, []string{QueryTimeEventQueue, "0", "2000", "2"}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	queue = new(model.TimeEventQueue)
+	assert.Nil(t, cdc.UnmarshalJSON(res, queue))
+	assert.Equal(t, 2, len(queue.Lists))
+	assert.Equal(t, int64(1000), queue.NextStart)
+	res, err = querier(ctx, []string{QueryTimeEventQueue, "1000", "2000", "2"}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	queue = new(model.TimeEventQueue)
+	assert.Nil(t, cdc.UnmarshalJSON(res, queue))
+	assert.Equal(t, 1, len(queue.Lists))
+	assert.Equal(t, int64(1000), queue.Lists[0].UnixTime)
+	assert.Equal(t, int64(0), queue.NextStart)
+
+	_, err = querier(ctx, []string{QueryTimeEventQueue, "0", "1000"}, abci.RequestQuery{})
+	assert.NotNil(t, err)
+	_, err = querier(ctx, []string{QueryTimeEventQueue, "a", "1000", "10"}, abci.RequestQuery{})
+	assert.NotNil(t, err)
+	_, err = querier(ctx, []string{QueryTimeEventQueue, "1000", "0", "10"}, abci.RequestQuery{})
+	assert.NotNil(t, err)
+	_, err = querier(ctx, []string{QueryTimeEventQueue, "0", "1000", "0"}, abci.RequestQuery{})
+	assert.NotNil(t, err)
+}
@@ -22,6 +22,7 @@ func ProviderReportTxCmd(cdc *wire.Codec) *cobra.Command {
 	}
 	cmd.Flags().String(client.FlagProvider, "", "reporter of this transaction")
 	cmd.Flags().String(client.FlagUsage, "", "usage of the report")
+	cmd.Flags().StringSlice(client.FlagContentHashes, nil, "comma separated hashes of off-chain post contents served")
 	return cmd
 }
 
@@ -34,7 +35,8 @@ func sendProviderReportTx(cdc *wire.Codec) client.CommandTxCallback {
 		if err != nil {
 			return err
 		}
-		msg := infra.NewProviderReportMsg(username, usage)
+		msg := infra.NewProviderReportMsg(
+			username, usage, viper.GetStringSlice(client.FlagContentHashes))
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
@@ -9,15 +9,16 @@ import (
 
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/infra"
 	"github.com/lino-network/lino/x/infra/model"
 
 	"github.com/cosmos/cosmos-sdk/wire"
 )
 
 // GetInfraProviderCmd returns target voter information
-func GetInfraProviderCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetInfraProviderCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -28,9 +29,9 @@ func GetInfraProviderCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 }
 
 // GetInfraProvidersCmd returns all validators relative information
-func GetInfraProvidersCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetInfraProvidersCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -40,9 +41,22 @@ func GetInfraProvidersCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetServedContentsCmd returns off-chain post contents served by infra provider
+func GetServedContentsCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "served-contents <provider>",
+		Short: "Query off-chain post contents served by infra provider",
+		RunE:  cmdr.getServedContentsCmd,
+	}
+}
+
 type commander struct {
-	storeName string
-	cdc       *wire.Codec
+	queryRoute string
+	cdc        *wire.Codec
 }
 
 func (c commander) getInfraProviderCmd(cmd *cobra.Command, args []string) error {
@@ -54,7 +68,7 @@ func (c commander) getInfraProviderCmd(cmd *cobra.Command, args []string) error
 	// find the key to look up the account
 	accKey := types.AccountKey(args[0])
 
-	res, err := ctx.Query(model.GetInfraProviderKey(accKey), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, infra.QueryInfraProvider, string(accKey))
 	if err != nil {
 		return err
 	}
@@ -74,7 +88,7 @@ func (c commander) getInfraProviderCmd(cmd *cobra.Command, args []string) error
 
 func (c commander) getInfraProvidersCmd(cmd *cobra.Command, args []string) error {
 	ctx := client.NewCoreContextFromViper()
-	res, err := ctx.Query(model.GetInfraProviderListKey(), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, infra.QueryInfraProviderList)
 	if err != nil {
 		return err
 	}
@@ -92,3 +106,26 @@ func (c commander) getInfraProvidersCmd(cmd *cobra.Command, args []string) error
 
 	return nil
 }
+
+func (c commander) getServedContentsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a infra provider name")
+	}
+
+	res, err := ctx.QueryCustom(c.queryRoute, infra.QueryServedContents, args[0])
+	if err != nil {
+		return err
+	}
+	contents := []model.ServedContent{}
+	if err := c.cdc.UnmarshalJSON(res, &contents); err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(contents, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
@@ -21,3 +21,18 @@ func ErrInvalidUsername() sdk.Error {
 func ErrInvalidUsage() sdk.Error {
 	return types.NewError(types.CodeInvalidUsage, fmt.Sprintf("invalid Usage"))
 }
+
+// ErrInvalidContentHash - error if reported content hash is invalid or duplicated
+func ErrInvalidContentHash(hash string) sdk.Error {
+	return types.NewError(types.CodeInvalidContentHash, fmt.Sprintf("invalid content hash %v", hash))
+}
+
+// ErrTooManyServedContents - error if too many served contents in one report
+func ErrTooManyServedContents() sdk.Error {
+	return types.NewError(types.CodeTooManyServedContents, fmt.Sprintf("too many served contents"))
+}
+
+// ErrContentNotReferenced - error if reported content is not referenced by any post
+func ErrContentNotReferenced(hash string) sdk.Error {
+	return types.NewError(types.CodeContentNotReferenced, fmt.Sprintf("content %v is not referenced by any post", hash))
+}
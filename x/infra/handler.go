@@ -4,15 +4,18 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewHandler - Handle all "infra" type messages.
-func NewHandler(im InfraManager) sdk.Handler {
+func NewHandler(im InfraManager, pm post.PostManager) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
 		case ProviderReportMsg:
-			return handleProviderReportMsg(ctx, im, msg)
+			return handleProviderReportMsg(ctx, im, pm, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized infra msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -20,13 +23,28 @@ func NewHandler(im InfraManager) sdk.Handler {
 	}
 }
 
-func handleProviderReportMsg(ctx sdk.Context, im InfraManager, msg ProviderReportMsg) sdk.Result {
+func handleProviderReportMsg(
+	ctx sdk.Context, im InfraManager, pm post.PostManager, msg ProviderReportMsg) sdk.Result {
 	if !im.DoesInfraProviderExist(ctx, msg.Username) {
 		return ErrProviderNotFound().Result()
 	}
+	// only content referenced by existing post can be reported
+	for _, hash := range msg.ContentHashes {
+		if !pm.DoesContentHashExist(ctx, hash) {
+			return ErrContentNotReferenced(hash).Result()
+		}
+	}
 
 	if err := im.ReportUsage(ctx, msg.Username, msg.Usage); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	if err := im.ReportServedContents(ctx, msg.Username, msg.ContentHashes); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionProviderReport),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
 }
@@ -2,15 +2,19 @@ package infra
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/infra/model"
 	"github.com/stretchr/testify/assert"
+
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestReportBasic(t *testing.T) {
-	ctx, im := setupTest(t, 0)
-	handler := NewHandler(im)
+	ctx, im, pm := setupTest(t, 0)
+	handler := NewHandler(im, pm)
 	im.InitGenesis(ctx)
 
 	user1 := types.AccountKey("user1")
@@ -18,15 +22,58 @@ func TestReportBasic(t *testing.T) {
 	im.RegisterInfraProvider(ctx, user1)
 
 	// infra provider does not exist
-	msg1 := NewProviderReportMsg("qwdqwdqw", usage)
+	msg1 := NewProviderReportMsg("qwdqwdqw", usage, nil)
 	res := handler(ctx, msg1)
 	assert.Equal(t, ErrProviderNotFound().Result(), res)
 
-	msg2 := NewProviderReportMsg("user1", usage)
+	msg2 := NewProviderReportMsg("user1", usage, nil)
 	res2 := handler(ctx, msg2)
-	assert.Equal(t, sdk.Result{}, res2)
+	assert.Equal(t, sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionProviderReport),
+			types.TagSender, []byte("user1"),
+		),
+	}, res2)
 
 	provider, _ := im.storage.GetInfraProvider(ctx, user1)
 	assert.Equal(t, usage, provider.Usage)
 
 }
+
+func TestReportServedContent(t *testing.T) {
+	ctx, im, pm := setupTest(t, 0)
+	handler := NewHandler(im, pm)
+	im.InitGenesis(ctx)
+
+	user1 := types.AccountKey("user1")
+	im.RegisterInfraProvider(ctx, user1)
+
+	// content is not referenced by any post
+	msg := NewProviderReportMsg("user1", 100, []string{testContentHash})
+	res := handler(ctx, msg)
+	assert.Equal(t, ErrContentNotReferenced(testContentHash).Result(), res)
+
+	err := pm.CreatePost(
+		ctx, types.AccountKey("author"), "postID", "", "", "", "", "", "title", sdk.ZeroRat(), nil, nil,
+		&types.ContentReference{
+			Hash:     testContentHash,
+			Size:     1 << 20,
+			MIMEType: "text/markdown",
+			URI:      "ipfs://QmTestContent",
+		})
+	assert.Nil(t, err)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(100, 0)})
+	res = handler(ctx, msg)
+	assert.True(t, res.IsOK())
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(200, 0)})
+	res = handler(ctx, msg)
+	assert.True(t, res.IsOK())
+
+	contents, err := im.GetServedContents(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.ServedContent{
+		{ContentHash: testContentHash, Times: 2, LastReportedAt: 200}}, contents)
+	provider, _ := im.storage.GetInfraProvider(ctx, user1)
+	assert.Equal(t, int64(200), provider.Usage)
+}
@@ -97,6 +97,32 @@ func (im *InfraManager) ReportUsage(ctx sdk.Context, username types.AccountKey,
 	return nil
 }
 
+// ReportServedContents - infra provider report off-chain post contents it served
+func (im *InfraManager) ReportServedContents(
+	ctx sdk.Context, username types.AccountKey, contentHashes []string) sdk.Error {
+	for _, hash := range contentHashes {
+		content, err := im.storage.GetServedContent(ctx, username, hash)
+		if err != nil {
+			return err
+		}
+		if content == nil {
+			content = &model.ServedContent{ContentHash: hash}
+		}
+		content.Times++
+		content.LastReportedAt = ctx.BlockHeader().Time.Unix()
+		if err := im.storage.SetServedContent(ctx, username, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetServedContents - get all off-chain post contents served by infra provider
+func (im *InfraManager) GetServedContents(
+	ctx sdk.Context, username types.AccountKey) ([]model.ServedContent, sdk.Error) {
+	return im.storage.GetServedContents(ctx, username)
+}
+
 // GetUsageWeight - get the usage percentage of given infra provider
 func (im *InfraManager) GetUsageWeight(ctx sdk.Context, username types.AccountKey) (sdk.Rat, sdk.Error) {
 	lst, err := im.storage.GetInfraProviderList(ctx)
@@ -148,3 +174,13 @@ func (im *InfraManager) ClearUsage(ctx sdk.Context) sdk.Error {
 }
 
 // ClearUsage - clear all infra provider report usage
+
+// Export - export infra provider KVStore for genesis
+func (im InfraManager) Export(ctx sdk.Context) (*model.InfraProviderTables, sdk.Error) {
+	return im.storage.Export(ctx)
+}
+
+// Import - import infra provider KVStore from exported genesis
+func (im InfraManager) Import(ctx sdk.Context, tables *model.InfraProviderTables) sdk.Error {
+	return im.storage.Import(ctx, tables)
+}
@@ -36,3 +36,13 @@ func ErrFailedToUnmarshalInfraProvider(err error) sdk.Error {
 func ErrFailedToUnmarshalInfraProviderList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalInfraProviderList, fmt.Sprintf("failed to unmarshal infra provider list: %s", err.Error()))
 }
+
+// ErrFailedToMarshalServedContent - error if marshal served content failed
+func ErrFailedToMarshalServedContent(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalServedContent, fmt.Sprintf("failed to marshal served content: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalServedContent - error if unmarshal served content failed
+func ErrFailedToUnmarshalServedContent(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalServedContent, fmt.Sprintf("failed to unmarshal served content: %s", err.Error()))
+}
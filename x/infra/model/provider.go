@@ -14,3 +14,11 @@ type InfraProvider struct {
 type InfraProviderList struct {
 	AllInfraProviders []types.AccountKey `json:"all_infra_providers"`
 }
+
+// ServedContent - off-chain post content reported as served by infra provider.
+// Times is number of reports include the content
+type ServedContent struct {
+	ContentHash    string `json:"content_hash"`
+	Times          int64  `json:"times"`
+	LastReportedAt int64  `json:"last_reported_at"`
+}
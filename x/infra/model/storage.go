@@ -9,6 +9,7 @@ import (
 var (
 	infraProviderSubstore     = []byte{0x00}
 	infraProviderListSubstore = []byte{0x01}
+	servedContentSubstore     = []byte{0x02}
 )
 
 // InfraProviderStorage - infra provider storage
@@ -93,6 +94,98 @@ func (is InfraProviderStorage) SetInfraProviderList(ctx sdk.Context, lst *InfraP
 	return nil
 }
 
+// GetServedContent - get content served by infra provider from KVStore, nil if not reported
+func (is InfraProviderStorage) GetServedContent(
+	ctx sdk.Context, accKey types.AccountKey, contentHash string) (*ServedContent, sdk.Error) {
+	store := ctx.KVStore(is.key)
+	contentByte := store.Get(getServedContentKey(accKey, contentHash))
+	if contentByte == nil {
+		return nil, nil
+	}
+	content := new(ServedContent)
+	if err := is.cdc.UnmarshalJSON(contentByte, content); err != nil {
+		return nil, ErrFailedToUnmarshalServedContent(err)
+	}
+	return content, nil
+}
+
+// SetServedContent - set content served by infra provider to KVStore
+func (is InfraProviderStorage) SetServedContent(
+	ctx sdk.Context, accKey types.AccountKey, content *ServedContent) sdk.Error {
+	store := ctx.KVStore(is.key)
+	contentByte, err := is.cdc.MarshalJSON(*content)
+	if err != nil {
+		return ErrFailedToMarshalServedContent(err)
+	}
+	store.Set(getServedContentKey(accKey, content.ContentHash), contentByte)
+	return nil
+}
+
+// GetServedContents - get all contents served by infra provider from KVStore
+func (is InfraProviderStorage) GetServedContents(
+	ctx sdk.Context, accKey types.AccountKey) ([]ServedContent, sdk.Error) {
+	store := ctx.KVStore(is.key)
+	iter := sdk.KVStorePrefixIterator(store, getServedContentPrefix(accKey))
+	defer iter.Close()
+	contents := []ServedContent{}
+	for ; iter.Valid(); iter.Next() {
+		content := new(ServedContent)
+		if err := is.cdc.UnmarshalJSON(iter.Value(), content); err != nil {
+			return nil, ErrFailedToUnmarshalServedContent(err)
+		}
+		contents = append(contents, *content)
+	}
+	return contents, nil
+}
+
+// Export - export all records in infra provider KVStore
+func (is InfraProviderStorage) Export(ctx sdk.Context) (*InfraProviderTables, sdk.Error) {
+	tables := &InfraProviderTables{}
+	store := ctx.KVStore(is.key)
+	iter := sdk.KVStorePrefixIterator(store, infraProviderSubstore)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var row InfraProvider
+		if err := is.cdc.UnmarshalJSON(iter.Value(), &row); err != nil {
+			return nil, ErrFailedToUnmarshalInfraProvider(err)
+		}
+		tables.InfraProviders = append(tables.InfraProviders, row)
+	}
+
+	for _, provider := range tables.InfraProviders {
+		contents, err := is.GetServedContents(ctx, provider.Username)
+		if err != nil {
+			return nil, err
+		}
+		for _, content := range contents {
+			tables.ServedContents = append(
+				tables.ServedContents, ServedContentRow{Username: provider.Username, ServedContent: content})
+		}
+	}
+
+	lst, err := is.GetInfraProviderList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables.List = *lst
+	return tables, nil
+}
+
+// Import - import all records exported by Export to infra provider KVStore
+func (is InfraProviderStorage) Import(ctx sdk.Context, tables *InfraProviderTables) sdk.Error {
+	for _, row := range tables.InfraProviders {
+		if err := is.SetInfraProvider(ctx, row.Username, &row); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.ServedContents {
+		if err := is.SetServedContent(ctx, row.Username, &row.ServedContent); err != nil {
+			return err
+		}
+	}
+	return is.SetInfraProviderList(ctx, &tables.List)
+}
+
 // GetInfraProviderKey - get infra provider key in infra provider substore
 func GetInfraProviderKey(accKey types.AccountKey) []byte {
 	return append(infraProviderSubstore, accKey...)
@@ -102,3 +195,13 @@ func GetInfraProviderKey(accKey types.AccountKey) []byte {
 func GetInfraProviderListKey() []byte {
 	return infraProviderListSubstore
 }
+
+// getServedContentPrefix - "served content substore" + "username" + "separator"
+func getServedContentPrefix(accKey types.AccountKey) []byte {
+	return append(append(servedContentSubstore, accKey...), types.KeySeparator...)
+}
+
+// getServedContentKey - "served content substore" + "username" + "separator" + "content hash"
+func getServedContentKey(accKey types.AccountKey, contentHash string) []byte {
+	return append(getServedContentPrefix(accKey), contentHash...)
+}
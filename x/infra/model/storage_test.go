@@ -50,6 +50,34 @@ func TestInfraProviderList(t *testing.T) {
 
 }
 
+func TestServedContent(t *testing.T) {
+	user := types.AccountKey("user1")
+	content1 := ServedContent{ContentHash: "hash1", Times: 1, LastReportedAt: 100}
+	content2 := ServedContent{ContentHash: "hash2", Times: 2, LastReportedAt: 200}
+
+	runTest(t, func(env TestEnv) {
+		resultPtr, err := env.is.GetServedContent(env.ctx, user, content1.ContentHash)
+		assert.Nil(t, err)
+		assert.Nil(t, resultPtr)
+
+		err = env.is.SetServedContent(env.ctx, user, &content1)
+		assert.Nil(t, err)
+		err = env.is.SetServedContent(env.ctx, user, &content2)
+		assert.Nil(t, err)
+
+		resultPtr, err = env.is.GetServedContent(env.ctx, user, content1.ContentHash)
+		assert.Nil(t, err)
+		assert.Equal(t, content1, *resultPtr, "served content should be equal")
+
+		contents, err := env.is.GetServedContents(env.ctx, user)
+		assert.Nil(t, err)
+		assert.Equal(t, []ServedContent{content1, content2}, contents)
+		contents, err = env.is.GetServedContents(env.ctx, types.AccountKey("user"))
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(contents))
+	})
+}
+
 //
 // Test Environment setup
 //
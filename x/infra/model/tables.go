@@ -0,0 +1,18 @@
+package model
+
+import (
+	"github.com/lino-network/lino/types"
+)
+
+// InfraProviderTables - state of infra provider KVStore
+type InfraProviderTables struct {
+	InfraProviders []InfraProvider    `json:"infra_providers"`
+	List           InfraProviderList  `json:"list"`
+	ServedContents []ServedContentRow `json:"served_contents"`
+}
+
+// ServedContentRow - pk: username, content hash
+type ServedContentRow struct {
+	Username      types.AccountKey `json:"username"`
+	ServedContent ServedContent    `json:"served_content"`
+}
@@ -10,19 +10,22 @@ import (
 
 var _ types.Msg = ProviderReportMsg{}
 
-// ProviderReportMsg - infra provider report infra usage to blockchain
+// ProviderReportMsg - infra provider report infra usage to blockchain.
+// ContentHashes are hashes of off-chain post contents served by the provider
 type ProviderReportMsg struct {
-	Username types.AccountKey `json:"username"`
-	Usage    int64            `json:"usage"`
+	Username      types.AccountKey `json:"username"`
+	Usage         int64            `json:"usage"`
+	ContentHashes []string         `json:"content_hashes"`
 }
 
 //----------------------------------------
 // ReportMsg Msg Implementations
 // NewProviderReportMsg - new ProviderReportMsg
-func NewProviderReportMsg(provider string, usage int64) ProviderReportMsg {
+func NewProviderReportMsg(provider string, usage int64, contentHashes []string) ProviderReportMsg {
 	return ProviderReportMsg{
-		Username: types.AccountKey(provider),
-		Usage:    usage,
+		Username:      types.AccountKey(provider),
+		Usage:         usage,
+		ContentHashes: contentHashes,
 	}
 }
 
@@ -40,11 +43,22 @@ func (msg ProviderReportMsg) ValidateBasic() sdk.Error {
 		return ErrInvalidUsage()
 	}
 
+	if len(msg.ContentHashes) > types.MaximumNumOfServedContents {
+		return ErrTooManyServedContents()
+	}
+	seen := map[string]bool{}
+	for _, hash := range msg.ContentHashes {
+		if !types.IsValidContentHash(hash) || seen[hash] {
+			return ErrInvalidContentHash(hash)
+		}
+		seen[hash] = true
+	}
 	return nil
 }
 
 func (msg ProviderReportMsg) String() string {
-	return fmt.Sprintf("ProviderReportMsg{Username:%v, Usage:%v}", msg.Username, msg.Usage)
+	return fmt.Sprintf("ProviderReportMsg{Username:%v, Usage:%v, ContentHashes:%v}",
+		msg.Username, msg.Usage, msg.ContentHashes)
 }
 
 // GetPermission - implements types.Msg
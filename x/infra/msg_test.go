@@ -17,19 +17,41 @@ func TestProviderReportMsg(t *testing.T) {
 	}{
 		{
 			testName:          "normal case",
-			providerReportMsg: NewProviderReportMsg("user1", 100),
+			providerReportMsg: NewProviderReportMsg("user1", 100, nil),
 			expectError:       nil,
 		},
 		{
 			testName:          "invalid username",
-			providerReportMsg: NewProviderReportMsg("", 100),
+			providerReportMsg: NewProviderReportMsg("", 100, nil),
 			expectError:       ErrInvalidUsername(),
 		},
 		{
 			testName:          "invalid usage",
-			providerReportMsg: NewProviderReportMsg("user1", -100),
+			providerReportMsg: NewProviderReportMsg("user1", -100, nil),
 			expectError:       ErrInvalidUsage(),
 		},
+		{
+			testName:          "report served content",
+			providerReportMsg: NewProviderReportMsg("user1", 100, []string{testContentHash}),
+			expectError:       nil,
+		},
+		{
+			testName:          "invalid content hash",
+			providerReportMsg: NewProviderReportMsg("user1", 100, []string{"hash"}),
+			expectError:       ErrInvalidContentHash("hash"),
+		},
+		{
+			testName: "duplicate content hash",
+			providerReportMsg: NewProviderReportMsg(
+				"user1", 100, []string{testContentHash, testContentHash}),
+			expectError: ErrInvalidContentHash(testContentHash),
+		},
+		{
+			testName: "too many served contents",
+			providerReportMsg: NewProviderReportMsg(
+				"user1", 100, make([]string, types.MaximumNumOfServedContents+1)),
+			expectError: ErrTooManyServedContents(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -46,7 +68,7 @@ func TestMsgPermission(t *testing.T) {
 		expectPermission types.Permission
 	}{
 		"provider report msg": {
-			msg:              NewProviderReportMsg("test", 1),
+			msg:              NewProviderReportMsg("test", 1, nil),
 			expectPermission: types.TransactionPermission,
 		},
 	}
@@ -65,7 +87,7 @@ func TestGetSignBytes(t *testing.T) {
 		msg types.Msg
 	}{
 		"provider report msg": {
-			msg: NewProviderReportMsg("test", 1),
+			msg: NewProviderReportMsg("test", 1, nil),
 		},
 	}
 
@@ -80,7 +102,7 @@ func TestGetSigners(t *testing.T) {
 		expectSigners []types.AccountKey
 	}{
 		"provider report msg": {
-			msg:           NewProviderReportMsg("test", 1),
+			msg:           NewProviderReportMsg("test", 1, nil),
 			expectSigners: []types.AccountKey{"test"},
 		},
 	}
@@ -0,0 +1,75 @@
+package infra
+
+import (
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryInfraProvider - query infra provider, path: provider/<username>
+	QueryInfraProvider = "provider"
+	// QueryInfraProviderList - query infra provider list, path: providerList
+	QueryInfraProviderList = "providerList"
+	// QueryServedContents - query off-chain post contents served by infra provider, path: servedContents/<username>
+	QueryServedContents = "servedContents"
+)
+
+// NewQuerier - create a querier which serves custom queries under infra route
+func NewQuerier(im InfraManager) sdk.Querier {
+	cdc := wire.NewCodec()
+	wire.RegisterCrypto(cdc)
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) == 0 {
+			return nil, types.ErrUnknownQueryPath("")
+		}
+		switch path[0] {
+		case QueryInfraProvider:
+			return queryInfraProvider(ctx, cdc, path[1:], im)
+		case QueryInfraProviderList:
+			return queryInfraProviderList(ctx, cdc, path[1:], im)
+		case QueryServedContents:
+			return queryServedContents(ctx, cdc, path[1:], im)
+		default:
+			return nil, types.ErrUnknownQueryPath(path[0])
+		}
+	}
+}
+
+func queryInfraProvider(
+	ctx sdk.Context, cdc *wire.Codec, path []string, im InfraManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	provider, err := im.storage.GetInfraProvider(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, provider)
+}
+
+func queryInfraProviderList(
+	ctx sdk.Context, cdc *wire.Codec, path []string, im InfraManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	lst, err := im.storage.GetInfraProviderList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, lst)
+}
+
+func queryServedContents(
+	ctx sdk.Context, cdc *wire.Codec, path []string, im InfraManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	contents, err := im.GetServedContents(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, contents)
+}
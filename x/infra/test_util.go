@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/param"
+	"github.com/lino-network/lino/x/post"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
@@ -14,14 +15,19 @@ import (
 var (
 	testInfraKVStoreKey = sdk.NewKVStoreKey("infra")
 	testParamKVStoreKey = sdk.NewKVStoreKey("param")
+	testPostKVStoreKey  = sdk.NewKVStoreKey("post")
+
+	// sha256 of "content"
+	testContentHash = "ed7002b439e9ac845f22357d822bac1444730fbdb6016d3ec9432297b9ec9f73"
 )
 
-func setupTest(t *testing.T, height int64) (sdk.Context, InfraManager) {
+func setupTest(t *testing.T, height int64) (sdk.Context, InfraManager, post.PostManager) {
 	ctx := getContext(height)
 	ph := param.NewParamHolder(testParamKVStoreKey)
 	ph.InitParam(ctx)
 	im := NewInfraManager(testInfraKVStoreKey, ph)
-	return ctx, im
+	pm := post.NewPostManager(testPostKVStoreKey, ph)
+	return ctx, im, pm
 }
 
 func getContext(height int64) sdk.Context {
@@ -29,6 +35,7 @@ func getContext(height int64) sdk.Context {
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(testInfraKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(testParamKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(testPostKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
 
 	return sdk.NewContext(ms, abci.Header{Height: height}, false, log.NewNopLogger())
@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	post "github.com/lino-network/lino/x/post"
+)
+
+// AcceptCoAuthorTxCmd will create a accept co-author tx and sign it with the given key
+func AcceptCoAuthorTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-co-author",
+		Short: "accept co-authorship and revenue share of a post",
+		RunE:  sendAcceptCoAuthorTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "co-author who accepts the post")
+	cmd.Flags().String(client.FlagAuthor, "", "author of the target post")
+	cmd.Flags().String(client.FlagPostID, "", "post id of the target post")
+	return cmd
+}
+
+// send accept co-author transaction to the blockchain
+func sendAcceptCoAuthorTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := post.NewAcceptCoAuthorMsg(
+			viper.GetString(client.FlagUser), viper.GetString(client.FlagAuthor),
+			viper.GetString(client.FlagPostID))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/client"
@@ -29,6 +30,18 @@ func PostTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagSourceAuthor, "", "source post author name")
 	cmd.Flags().String(client.FlagSourcePostID, "", "source post id")
 	cmd.Flags().String(client.FlagRedistributionSplitRate, "0", "redistribution split rate")
+	cmd.Flags().StringSlice(client.FlagTags, nil, "comma separated tags of the post")
+	cmd.Flags().String(client.FlagContentHash, "", "hex encoded sha256 of content stored off chain")
+	cmd.Flags().Int64(client.FlagContentSize, 0, "size in bytes of content stored off chain")
+	cmd.Flags().String(client.FlagContentMIMEType, "", "MIME type of content stored off chain")
+	cmd.Flags().String(client.FlagContentURI, "", "storage URI of content stored off chain")
+	cmd.Flags().String(client.FlagPrice, "", "price in LNO to unlock the post, empty for free post")
+	cmd.Flags().String(client.FlagEncryptedContentHash, "", "hex encoded sha256 of encrypted paid content")
+	cmd.Flags().Int64(client.FlagEncryptedContentSize, 0, "size in bytes of encrypted paid content")
+	cmd.Flags().String(client.FlagEncryptedContentMIME, "", "MIME type of encrypted paid content")
+	cmd.Flags().String(client.FlagEncryptedContentURI, "", "storage URI of encrypted paid content")
+	cmd.Flags().StringSlice(client.FlagCoAuthors, nil,
+		"comma separated username:share of co-authors including the author, e.g. alice:0.6,bob:0.4")
 	return cmd
 }
 
@@ -47,6 +60,34 @@ func sendPostTx(cdc *wire.Codec) client.CommandTxCallback {
 			SourceAuthor:            types.AccountKey(viper.GetString(client.FlagSourceAuthor)),
 			SourcePostID:            viper.GetString(client.FlagSourcePostID),
 			RedistributionSplitRate: viper.GetString(client.FlagRedistributionSplitRate),
+			Tags:                    viper.GetStringSlice(client.FlagTags),
+		}
+		if hash := viper.GetString(client.FlagContentHash); hash != "" {
+			msg.ContentReference = &types.ContentReference{
+				Hash:     hash,
+				Size:     viper.GetInt64(client.FlagContentSize),
+				MIMEType: viper.GetString(client.FlagContentMIMEType),
+				URI:      viper.GetString(client.FlagContentURI),
+			}
+		}
+		msg.Price = types.LNO(viper.GetString(client.FlagPrice))
+		if hash := viper.GetString(client.FlagEncryptedContentHash); hash != "" {
+			msg.EncryptedContentReference = &types.ContentReference{
+				Hash:     hash,
+				Size:     viper.GetInt64(client.FlagEncryptedContentSize),
+				MIMEType: viper.GetString(client.FlagEncryptedContentMIME),
+				URI:      viper.GetString(client.FlagEncryptedContentURI),
+			}
+		}
+		for _, coAuthor := range viper.GetStringSlice(client.FlagCoAuthors) {
+			fields := strings.Split(coAuthor, ":")
+			if len(fields) != 2 {
+				return fmt.Errorf("invalid co-author %v, should be username:share", coAuthor)
+			}
+			msg.CoAuthors = append(msg.CoAuthors, post.CoAuthorShare{
+				Username: types.AccountKey(fields[0]),
+				Share:    fields[1],
+			})
 		}
 
 		// build and sign the transaction, then broadcast to Tendermint
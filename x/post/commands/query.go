@@ -1,20 +1,24 @@
 package commands
 
 import (
+	"strconv"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post"
 	"github.com/lino-network/lino/x/post/model"
 )
 
 // GetPostCmd returns a query post that will display the
 // info and meta of the post at a given author and postID
-func GetPostCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetPostCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -24,9 +28,111 @@ func GetPostCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetDonationEstimateCmd returns a dry run of donation which displays expected
+// friction, direct deposit, evaluate result and inflation reward
+func GetDonationEstimateCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "donation-estimate <username> <author> <postID> <amount>",
+		Short: "Estimate the result of a donation without sending it",
+		RunE:  cmdr.getDonationEstimateCmd,
+	}
+}
+
+// GetPendingRewardsCmd returns a query of pending content rewards of a post,
+// or of all posts created by the author if post id is omitted
+func GetPendingRewardsCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "pending-rewards <author> [postID]",
+		Short: "Query pending content rewards of an author or a post",
+		RunE:  cmdr.getPendingRewardsCmd,
+	}
+}
+
+// GetPostsByTagCmd returns a query of posts under a tag, newest first
+func GetPostsByTagCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "posts-by-tag <tag>",
+		Short: "Query posts under a tag ordered by created time",
+		RunE:  cmdr.getPostsByTagCmd,
+	}
+	cmd.Flags().Int64(client.FlagOffset, 0, "number of posts to skip")
+	cmd.Flags().Int64(client.FlagLimit, 20, "max number of posts in one page")
+	return cmd
+}
+
+// GetCommentTreeCmd returns a query of comments under a post with nested replies
+func GetCommentTreeCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "comments <author> <postID>",
+		Short: "Query comment tree of a post",
+		RunE:  cmdr.getCommentTreeCmd,
+	}
+	cmd.Flags().String(client.FlagSort, post.CommentSortNewest, "sort of comments, newest, oldest or most_donated")
+	cmd.Flags().Int64(client.FlagDepth, 2, "max depth of nested replies")
+	cmd.Flags().Int64(client.FlagLimit, 20, "max number of comments in each level")
+	cmd.Flags().String(client.FlagCursor, "", "next cursor returned by previous page")
+	return cmd
+}
+
+// GetPostVersionsCmd returns a query of previous versions of a post,
+// or of a single version if version is given
+func GetPostVersionsCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "post-versions <author> <postID> [version]",
+		Short: "Query edit history of a post",
+		RunE:  cmdr.getPostVersionsCmd,
+	}
+}
+
+// GetPostUnlockCmd returns a query of whether a user unlocked a paid post
+func GetPostUnlockCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "post-unlock <author> <postID> <username>",
+		Short: "Query unlock of a paid post by a user",
+		RunE:  cmdr.getPostUnlockCmd,
+	}
+}
+
+// GetPostCoAuthorsCmd returns a query of co-authors and revenue shares of a post
+func GetPostCoAuthorsCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "post-co-authors <author> <postID>",
+		Short: "Query co-authors and revenue shares of a post",
+		RunE:  cmdr.getPostCoAuthorsCmd,
+	}
+}
+
 type commander struct {
-	storeName string
-	cdc       *wire.Codec
+	queryRoute string
+	cdc        *wire.Codec
 }
 
 func (c commander) getPostCmd(cmd *cobra.Command, args []string) error {
@@ -40,7 +146,7 @@ func (c commander) getPostCmd(cmd *cobra.Command, args []string) error {
 	postID := args[1]
 	postKey := types.GetPermlink(types.AccountKey(author), postID)
 
-	res, err := ctx.Query(model.GetPostInfoKey(postKey), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, post.QueryPostInfo, string(postKey))
 	if err != nil {
 		return err
 	}
@@ -49,7 +155,7 @@ func (c commander) getPostCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	res, err = ctx.Query(model.GetPostMetaKey(postKey), c.storeName)
+	res, err = ctx.QueryCustom(c.queryRoute, post.QueryPostMeta, string(postKey))
 	if err != nil {
 		return err
 	}
@@ -67,9 +173,9 @@ func (c commander) getPostCmd(cmd *cobra.Command, args []string) error {
 
 // GetPostsCmd returns a query post that will display the
 // info and meta of the post at a given author and postID
-func GetPostsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetPostsCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -88,18 +194,13 @@ func (c commander) getPostsCmd(cmd *cobra.Command, args []string) error {
 	// find the key to look up the account
 	author := types.AccountKey(args[0])
 
-	resKVs, err := ctx.QuerySubspace(
-		c.cdc, append(model.GetPostInfoPrefix(author), types.PermlinkSeparator...), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, post.QueryPostsByAuthor, string(author))
 	if err != nil {
 		return err
 	}
 	var posts []model.PostInfo
-	for _, KV := range resKVs {
-		var info model.PostInfo
-		if err := c.cdc.UnmarshalJSON(KV.Value, &info); err != nil {
-			return err
-		}
-		posts = append(posts, info)
+	if err := c.cdc.UnmarshalJSON(res, &posts); err != nil {
+		return err
 	}
 
 	if err := client.PrintIndent(posts); err != nil {
@@ -107,3 +208,175 @@ func (c commander) getPostsCmd(cmd *cobra.Command, args []string) error {
 	}
 	return nil
 }
+
+func (c commander) getDonationEstimateCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 4 || len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 || len(args[3]) == 0 {
+		return errors.New("You must provide an valid username, author, post id and amount")
+	}
+
+	res, err := ctx.QueryCustom(c.queryRoute, post.QueryDonationEstimate, args...)
+	if err != nil {
+		return err
+	}
+	estimate := new(model.DonationEstimate)
+	if err := c.cdc.UnmarshalJSON(res, estimate); err != nil {
+		return err
+	}
+
+	if err := client.PrintIndent(estimate); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getPendingRewardsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) < 1 || len(args) > 2 || len(args[0]) == 0 {
+		return errors.New("You must provide an valid author")
+	}
+
+	var res []byte
+	var err error
+	if len(args) == 2 {
+		postKey := types.GetPermlink(types.AccountKey(args[0]), args[1])
+		res, err = ctx.QueryCustom(c.queryRoute, post.QueryPendingRewards, string(postKey))
+	} else {
+		res, err = ctx.QueryCustom(c.queryRoute, post.QueryPendingRewardsByAuthor, args[0])
+	}
+	if err != nil {
+		return err
+	}
+	pendingRewards := []model.PendingRewardStatus{}
+	if err := c.cdc.UnmarshalJSON(res, &pendingRewards); err != nil {
+		return err
+	}
+
+	if err := client.PrintIndent(pendingRewards); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getPostsByTagCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a tag")
+	}
+
+	res, err := ctx.QueryCustom(
+		c.queryRoute, post.QueryPostsByTag, args[0],
+		strconv.FormatInt(viper.GetInt64(client.FlagOffset), 10),
+		strconv.FormatInt(viper.GetInt64(client.FlagLimit), 10))
+	if err != nil {
+		return err
+	}
+	page := new(model.TaggedPostPage)
+	if err := c.cdc.UnmarshalJSON(res, page); err != nil {
+		return err
+	}
+
+	if err := client.PrintIndent(page); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getCommentTreeCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+		return errors.New("You must provide an valid author and post id")
+	}
+
+	params := []string{
+		string(types.GetPermlink(types.AccountKey(args[0]), args[1])),
+		viper.GetString(client.FlagSort),
+		strconv.FormatInt(viper.GetInt64(client.FlagDepth), 10),
+		strconv.FormatInt(viper.GetInt64(client.FlagLimit), 10),
+	}
+	if cursor := viper.GetString(client.FlagCursor); cursor != "" {
+		params = append(params, cursor)
+	}
+	res, err := ctx.QueryCustom(c.queryRoute, post.QueryCommentTree, params...)
+	if err != nil {
+		return err
+	}
+	tree := new(model.CommentTree)
+	if err := c.cdc.UnmarshalJSON(res, tree); err != nil {
+		return err
+	}
+
+	if err := client.PrintIndent(tree); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getPostVersionsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) < 2 || len(args) > 3 || len(args[0]) == 0 || len(args[1]) == 0 {
+		return errors.New("You must provide an valid author and post id")
+	}
+	postKey := types.GetPermlink(types.AccountKey(args[0]), args[1])
+
+	if len(args) == 3 {
+		if _, err := strconv.ParseInt(args[2], 10, 64); err != nil {
+			return errors.Wrap(err, "invalid version")
+		}
+		res, err := ctx.QueryCustom(c.queryRoute, post.QueryPostVersion, string(postKey), args[2])
+		if err != nil {
+			return err
+		}
+		postVersion := new(model.PostVersion)
+		if err := c.cdc.UnmarshalJSON(res, postVersion); err != nil {
+			return err
+		}
+		return client.PrintIndent(postVersion)
+	}
+
+	res, err := ctx.QueryCustom(c.queryRoute, post.QueryPostVersions, string(postKey))
+	if err != nil {
+		return err
+	}
+	postVersions := []model.PostVersion{}
+	if err := c.cdc.UnmarshalJSON(res, &postVersions); err != nil {
+		return err
+	}
+	return client.PrintIndent(postVersions)
+}
+
+func (c commander) getPostUnlockCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 3 || len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 {
+		return errors.New("You must provide an valid author, post id and username")
+	}
+	postKey := types.GetPermlink(types.AccountKey(args[0]), args[1])
+
+	res, err := ctx.QueryCustom(c.queryRoute, post.QueryPostUnlock, string(postKey), args[2])
+	if err != nil {
+		return err
+	}
+	unlock := new(model.PostUnlock)
+	if err := c.cdc.UnmarshalJSON(res, unlock); err != nil {
+		return err
+	}
+	return client.PrintIndent(unlock)
+}
+
+func (c commander) getPostCoAuthorsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+		return errors.New("You must provide an valid author and post id")
+	}
+	postKey := types.GetPermlink(types.AccountKey(args[0]), args[1])
+
+	res, err := ctx.QueryCustom(c.queryRoute, post.QueryPostCoAuthors, string(postKey))
+	if err != nil {
+		return err
+	}
+	coAuthors := new(model.PostCoAuthors)
+	if err := c.cdc.UnmarshalJSON(res, coAuthors); err != nil {
+		return err
+	}
+	return client.PrintIndent(coAuthors)
+}
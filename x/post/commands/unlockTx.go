@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	post "github.com/lino-network/lino/x/post"
+)
+
+// UnlockPostTxCmd will create a unlock post tx and sign it with the given key
+func UnlockPostTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-post",
+		Short: "pay the price to unlock a paid post",
+		RunE:  sendUnlockPostTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "user who unlocks the post")
+	cmd.Flags().String(client.FlagAuthor, "", "author of the target post")
+	cmd.Flags().String(client.FlagPostID, "", "post id of the target post")
+	cmd.Flags().String(client.FlagAmount, "", "price of the target post")
+	return cmd
+}
+
+// send unlock post transaction to the blockchain
+func sendUnlockPostTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := post.NewUnlockPostMsg(
+			viper.GetString(client.FlagUser), types.LNO(viper.GetString(client.FlagAmount)),
+			viper.GetString(client.FlagAuthor), viper.GetString(client.FlagPostID), "")
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -0,0 +1,180 @@
+package post
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// sort options of comment tree
+const (
+	CommentSortNewest      = "newest"
+	CommentSortOldest      = "oldest"
+	CommentSortMostDonated = "most_donated"
+)
+
+// CommentTreeFilter - filter used to build comment tree. Comments at every level are
+// sorted by Sort and at most Limit of them are returned. Replies deeper than MaxDepth, or
+// replies which would exceed MaximumCommentTreeNodes loaded comments, are only counted.
+// Cursor is the permlink of the last comment of previous page, it only applies to the
+// direct comments of the root
+type CommentTreeFilter struct {
+	Sort     string         `json:"sort"`
+	MaxDepth int64          `json:"max_depth"`
+	Limit    int64          `json:"limit"`
+	Cursor   types.Permlink `json:"cursor"`
+}
+
+// ValidateBasic - check comment tree filter is valid
+func (filter CommentTreeFilter) ValidateBasic() sdk.Error {
+	if filter.Sort != CommentSortNewest && filter.Sort != CommentSortOldest &&
+		filter.Sort != CommentSortMostDonated {
+		return ErrInvalidCommentTreeFilter(fmt.Sprintf("unknown sort %v", filter.Sort))
+	}
+	if filter.MaxDepth <= 0 || filter.MaxDepth > types.MaximumCommentTreeDepth {
+		return ErrInvalidCommentTreeFilter(fmt.Sprintf("invalid max depth %v", filter.MaxDepth))
+	}
+	if filter.Limit <= 0 || filter.Limit > types.MaximumCommentTreePageSize {
+		return ErrInvalidCommentTreeFilter(fmt.Sprintf("invalid limit %v", filter.Limit))
+	}
+	return nil
+}
+
+// GetCommentTree - get a page of comments under the root post with nested replies
+func (pm PostManager) GetCommentTree(
+	ctx sdk.Context, permlink types.Permlink, filter CommentTreeFilter) (*model.CommentTree, sdk.Error) {
+	if err := filter.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	// all direct comments of the root are loaded to sort them
+	budget := int64(types.MaximumCommentTreeNodes)
+	numOfComments := pm.postStorage.GetPostCommentCount(ctx, permlink)
+	if numOfComments > budget {
+		return nil, ErrTooManyCommentsInTree(permlink, numOfComments)
+	}
+	budget -= numOfComments
+	comments, err := pm.getSortedComments(ctx, permlink, filter.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	start := 0
+	if filter.Cursor != "" {
+		start = -1
+		for i, comment := range comments {
+			if types.GetPermlink(comment.Author, comment.PostID) == filter.Cursor {
+				start = i + 1
+				break
+			}
+		}
+		if start < 0 {
+			return nil, ErrCommentCursorNotFound(filter.Cursor)
+		}
+	}
+	end := start + int(filter.Limit)
+	if end > len(comments) {
+		end = len(comments)
+	}
+
+	tree := &model.CommentTree{
+		Author:   postInfo.Author,
+		PostID:   postInfo.PostID,
+		Comments: comments[start:end],
+	}
+	for i := range tree.Comments {
+		if err := pm.addReplies(ctx, &tree.Comments[i], filter, 1, &budget); err != nil {
+			return nil, err
+		}
+	}
+	if end < len(comments) {
+		tree.NextCursor = types.GetPermlink(comments[end-1].Author, comments[end-1].PostID)
+	}
+	return tree, nil
+}
+
+// addReplies - add first page of replies to comment at given depth, budget is the number
+// of comments that can still be loaded. Replies are counted before loading, they are only
+// counted if comment is at max depth or they don't fit in the budget
+func (pm PostManager) addReplies(
+	ctx sdk.Context, node *model.CommentNode, filter CommentTreeFilter, depth int64, budget *int64) sdk.Error {
+	permlink := types.GetPermlink(node.Author, node.PostID)
+	node.NumOfReplies = pm.postStorage.GetPostCommentCount(ctx, permlink)
+	if depth >= filter.MaxDepth || node.NumOfReplies > *budget {
+		node.HasMoreReplies = node.NumOfReplies > 0
+		return nil
+	}
+	*budget -= node.NumOfReplies
+	replies, err := pm.getSortedComments(ctx, permlink, filter.Sort)
+	if err != nil {
+		return err
+	}
+	if int64(len(replies)) > filter.Limit {
+		replies = replies[:filter.Limit]
+		node.HasMoreReplies = true
+	}
+	node.Replies = replies
+	for i := range node.Replies {
+		if err := pm.addReplies(ctx, &node.Replies[i], filter, depth+1, budget); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getSortedComments - get direct comments of a post with their post meta,
+// ties are broken by permlink so the order is deterministic
+func (pm PostManager) getSortedComments(
+	ctx sdk.Context, permlink types.Permlink, sortBy string) ([]model.CommentNode, sdk.Error) {
+	comments, err := pm.postStorage.GetPostComments(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]model.CommentNode, 0, len(comments))
+	for _, comment := range comments {
+		postMeta, err := pm.postStorage.GetPostMeta(
+			ctx, types.GetPermlink(comment.Author, comment.PostID))
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, model.CommentNode{
+			Author:           comment.Author,
+			PostID:           comment.PostID,
+			CreatedAt:        comment.CreatedAt,
+			IsDeleted:        postMeta.IsDeleted,
+			TotalDonateCount: postMeta.TotalDonateCount,
+			TotalReward:      postMeta.TotalReward,
+			Replies:          []model.CommentNode{},
+		})
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		a, b := nodes[i], nodes[j]
+		switch sortBy {
+		case CommentSortMostDonated:
+			if !a.TotalReward.IsEqual(b.TotalReward) {
+				return a.TotalReward.IsGT(b.TotalReward)
+			}
+			if a.CreatedAt != b.CreatedAt {
+				return a.CreatedAt > b.CreatedAt
+			}
+		case CommentSortOldest:
+			if a.CreatedAt != b.CreatedAt {
+				return a.CreatedAt < b.CreatedAt
+			}
+		default:
+			if a.CreatedAt != b.CreatedAt {
+				return a.CreatedAt > b.CreatedAt
+			}
+		}
+		return types.GetPermlink(a.Author, a.PostID) < types.GetPermlink(b.Author, b.PostID)
+	})
+	return nodes, nil
+}
@@ -0,0 +1,199 @@
+package post
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestGetCommentTree(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	user1, rootID := createTestPost(t, ctx, "user1", "root", am, pm, "0")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	root := types.GetPermlink(user1, rootID)
+
+	createComment := func(postID string, parent types.Permlink, parentAuthor types.AccountKey,
+		parentPostID string, createdAt int64) model.CommentNode {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(createdAt, 0)})
+		err := pm.AddComment(ctx, parent, user2, postID)
+		assert.Nil(t, err)
+		err = pm.CreatePost(
+			ctx, user2, postID, "", "", parentAuthor, parentPostID,
+			"content", "title", sdk.ZeroRat(), nil, nil, nil)
+		assert.Nil(t, err)
+		return model.CommentNode{
+			Author:      user2,
+			PostID:      postID,
+			CreatedAt:   createdAt,
+			TotalReward: types.NewCoinFromInt64(0),
+			Replies:     []model.CommentNode{},
+		}
+	}
+	c1 := createComment("c1", root, user1, rootID, 100)
+	c2 := createComment("c2", root, user1, rootID, 200)
+	c3 := createComment("c3", root, user1, rootID, 300)
+	r1 := createComment("r1", types.GetPermlink(user2, "c1"), user2, "c1", 400)
+	r2 := createComment("r2", types.GetPermlink(user2, "c1"), user2, "c1", 500)
+	rr1 := createComment("rr1", types.GetPermlink(user2, "r1"), user2, "r1", 600)
+
+	err := pm.AddDonation(
+		ctx, types.GetPermlink(user2, "c2"), user1, types.NewCoinFromInt64(10), types.DirectDeposit)
+	assert.Nil(t, err)
+	donatedC2 := c2
+	donatedC2.TotalDonateCount = 1
+	donatedC2.TotalReward = types.NewCoinFromInt64(10)
+
+	// c1 with replies at depth 2, rr1 is deeper than max depth
+	r1AtDepth2 := r1
+	r1AtDepth2.NumOfReplies = 1
+	r1AtDepth2.HasMoreReplies = true
+	c1WithReplies := c1
+	c1WithReplies.NumOfReplies = 2
+	c1WithReplies.Replies = []model.CommentNode{r2, r1AtDepth2}
+	// c1 with one page of replies at depth 3
+	r1WithReplies := r1
+	r1WithReplies.NumOfReplies = 1
+	r1WithReplies.Replies = []model.CommentNode{rr1}
+	c1WithOneReply := c1
+	c1WithOneReply.NumOfReplies = 2
+	c1WithOneReply.HasMoreReplies = true
+	c1WithOneReply.Replies = []model.CommentNode{r1WithReplies}
+	// c1 at depth 1
+	c1AtDepth1 := c1
+	c1AtDepth1.NumOfReplies = 2
+	c1AtDepth1.HasMoreReplies = true
+
+	testCases := []struct {
+		testName   string
+		permlink   types.Permlink
+		filter     CommentTreeFilter
+		expectErr  sdk.Error
+		expectTree *model.CommentTree
+	}{
+		{
+			testName: "newest first with nested replies",
+			permlink: root,
+			filter:   CommentTreeFilter{Sort: CommentSortNewest, MaxDepth: 2, Limit: 10},
+			expectTree: &model.CommentTree{
+				Author:   user1,
+				PostID:   rootID,
+				Comments: []model.CommentNode{c3, donatedC2, c1WithReplies},
+			},
+		},
+		{
+			testName: "first page of oldest first",
+			permlink: root,
+			filter:   CommentTreeFilter{Sort: CommentSortOldest, MaxDepth: 3, Limit: 1},
+			expectTree: &model.CommentTree{
+				Author:     user1,
+				PostID:     rootID,
+				Comments:   []model.CommentNode{c1WithOneReply},
+				NextCursor: types.GetPermlink(user2, "c1"),
+			},
+		},
+		{
+			testName: "second page of oldest first",
+			permlink: root,
+			filter: CommentTreeFilter{
+				Sort: CommentSortOldest, MaxDepth: 3, Limit: 1, Cursor: types.GetPermlink(user2, "c1")},
+			expectTree: &model.CommentTree{
+				Author:     user1,
+				PostID:     rootID,
+				Comments:   []model.CommentNode{donatedC2},
+				NextCursor: types.GetPermlink(user2, "c2"),
+			},
+		},
+		{
+			testName: "last page of oldest first",
+			permlink: root,
+			filter: CommentTreeFilter{
+				Sort: CommentSortOldest, MaxDepth: 3, Limit: 1, Cursor: types.GetPermlink(user2, "c2")},
+			expectTree: &model.CommentTree{
+				Author:   user1,
+				PostID:   rootID,
+				Comments: []model.CommentNode{c3},
+			},
+		},
+		{
+			testName: "most donated first",
+			permlink: root,
+			filter:   CommentTreeFilter{Sort: CommentSortMostDonated, MaxDepth: 1, Limit: 10},
+			expectTree: &model.CommentTree{
+				Author:   user1,
+				PostID:   rootID,
+				Comments: []model.CommentNode{donatedC2, c3, c1AtDepth1},
+			},
+		},
+		{
+			testName: "comment as root",
+			permlink: types.GetPermlink(user2, "r1"),
+			filter:   CommentTreeFilter{Sort: CommentSortNewest, MaxDepth: 1, Limit: 10},
+			expectTree: &model.CommentTree{
+				Author:   user2,
+				PostID:   "r1",
+				Comments: []model.CommentNode{rr1},
+			},
+		},
+		{
+			testName:  "cursor is not a comment of root",
+			permlink:  root,
+			filter:    CommentTreeFilter{Sort: CommentSortNewest, MaxDepth: 1, Limit: 10, Cursor: types.GetPermlink(user2, "r1")},
+			expectErr: ErrCommentCursorNotFound(types.GetPermlink(user2, "r1")),
+		},
+		{
+			testName:  "unknown sort",
+			permlink:  root,
+			filter:    CommentTreeFilter{Sort: "hottest", MaxDepth: 1, Limit: 10},
+			expectErr: ErrInvalidCommentTreeFilter("unknown sort hottest"),
+		},
+		{
+			testName:  "too deep",
+			permlink:  root,
+			filter:    CommentTreeFilter{Sort: CommentSortNewest, MaxDepth: types.MaximumCommentTreeDepth + 1, Limit: 10},
+			expectErr: ErrInvalidCommentTreeFilter("invalid max depth 6"),
+		},
+		{
+			testName:  "zero limit",
+			permlink:  root,
+			filter:    CommentTreeFilter{Sort: CommentSortNewest, MaxDepth: 1, Limit: 0},
+			expectErr: ErrInvalidCommentTreeFilter("invalid limit 0"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tree, err := pm.GetCommentTree(ctx, tc.permlink, tc.filter)
+		if !assert.Equal(t, tc.expectErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
+		}
+		if tc.expectErr != nil {
+			continue
+		}
+		if !assert.Equal(t, *tc.expectTree, *tree) {
+			t.Errorf("%s: diff tree, got %v, want %v", tc.testName, *tree, *tc.expectTree)
+		}
+	}
+
+	// replies are not expanded once node budget is used up, even within max depth
+	node := c1
+	budget := int64(2)
+	err = pm.addReplies(
+		ctx, &node, CommentTreeFilter{Sort: CommentSortNewest, MaxDepth: 3, Limit: 10}, 1, &budget)
+	assert.Nil(t, err)
+	assert.Equal(t, c1WithReplies, node)
+	assert.Equal(t, int64(0), budget)
+
+	// replies which don't fit in the budget are only counted
+	node = c1
+	budget = int64(1)
+	err = pm.addReplies(
+		ctx, &node, CommentTreeFilter{Sort: CommentSortNewest, MaxDepth: 3, Limit: 10}, 1, &budget)
+	assert.Nil(t, err)
+	assert.Equal(t, c1AtDepth1, node)
+	assert.Equal(t, int64(1), budget)
+}
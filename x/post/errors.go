@@ -128,6 +128,90 @@ func ErrTooManyURL() sdk.Error {
 	return types.NewError(types.CodeTooManyURL, fmt.Sprintf("too many url"))
 }
 
+// ErrTooManyTags - error when posting with too many tags
+func ErrTooManyTags() sdk.Error {
+	return types.NewError(types.CodeTooManyTags, fmt.Sprintf("too many tags"))
+}
+
+// ErrInvalidTag - error when tag is empty, too long, duplicated or contains key separator
+func ErrInvalidTag(tag string) sdk.Error {
+	return types.NewError(types.CodeInvalidTag, fmt.Sprintf("invalid tag %v", tag))
+}
+
+// ErrInvalidCommentTreeFilter - error when sort, depth or limit of comment tree query is invalid
+func ErrInvalidCommentTreeFilter(reason string) sdk.Error {
+	return types.NewError(types.CodeInvalidCommentTreeFilter, fmt.Sprintf("invalid comment tree filter: %v", reason))
+}
+
+// ErrCommentCursorNotFound - error when cursor of comment tree query is not a comment of the root
+func ErrCommentCursorNotFound(cursor types.Permlink) sdk.Error {
+	return types.NewError(types.CodeCommentCursorNotFound, fmt.Sprintf("comment cursor %v not found", cursor))
+}
+
+// ErrTooManyCommentsInTree - error when root of comment tree query has more direct comments
+// than a comment tree query can load
+func ErrTooManyCommentsInTree(permlink types.Permlink, numOfComments int64) sdk.Error {
+	return types.NewError(types.CodeTooManyCommentsInTree,
+		fmt.Sprintf("post %v has %v comments, more than %v can be loaded in comment tree",
+			permlink, numOfComments, types.MaximumCommentTreeNodes))
+}
+
+// ErrInvalidContentReference - error when off-chain content reference of post is invalid
+func ErrInvalidContentReference(reason string) sdk.Error {
+	return types.NewError(types.CodeInvalidContentReference, fmt.Sprintf("invalid content reference: %v", reason))
+}
+
+// ErrInvalidPaidPost - error when price or encrypted content reference of paid post is invalid
+func ErrInvalidPaidPost(reason string) sdk.Error {
+	return types.NewError(types.CodeInvalidPaidPost, fmt.Sprintf("invalid paid post: %v", reason))
+}
+
+// ErrPostNotPaid - error when unlock a post which is free or deleted
+func ErrPostNotPaid(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodePostNotPaid, fmt.Sprintf("post %v is not a paid post", permlink))
+}
+
+// ErrPostAlreadyUnlocked - error when user unlock a post twice
+func ErrPostAlreadyUnlocked(permlink types.Permlink, user types.AccountKey) sdk.Error {
+	return types.NewError(types.CodePostAlreadyUnlocked, fmt.Sprintf("post %v is already unlocked by %v", permlink, user))
+}
+
+// ErrUnlockPriceMismatch - error when unlock amount is different from price of the post
+func ErrUnlockPriceMismatch(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeUnlockPriceMismatch, fmt.Sprintf("unlock amount doesn't match price of post %v", permlink))
+}
+
+// ErrUnlockPostIsDeleted - error when unlock a deleted post
+func ErrUnlockPostIsDeleted(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeUnlockPostIsDeleted, fmt.Sprintf("unlock post %v failed, post is deleted", permlink))
+}
+
+// ErrCannotUnlockOwnPost - error when author or co-author unlock own post
+func ErrCannotUnlockOwnPost(user types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeCannotUnlockOwnPost, fmt.Sprintf("unlock failed, user %v unlock own post", user))
+}
+
+// ErrInvalidCoAuthors - error when co-authors or revenue shares of post are invalid
+func ErrInvalidCoAuthors(reason string) sdk.Error {
+	return types.NewError(types.CodeInvalidCoAuthors, fmt.Sprintf("invalid co-authors: %v", reason))
+}
+
+// ErrNotCoAuthor - error when user accepts co-authorship of a post not listing it
+func ErrNotCoAuthor(permlink types.Permlink, user types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeNotCoAuthor, fmt.Sprintf("user %v is not co-author of post %v", user, permlink))
+}
+
+// ErrCoAuthorAlreadyAccepted - error when co-author accepts a post twice
+func ErrCoAuthorAlreadyAccepted(permlink types.Permlink, user types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeCoAuthorAlreadyAccepted, fmt.Sprintf("user %v already accepted co-authorship of post %v", user, permlink))
+}
+
+// ErrInvalidPostsByTagPage - error when offset or limit of tag query is invalid
+func ErrInvalidPostsByTagPage(offset, limit int64) sdk.Error {
+	return types.NewError(
+		types.CodeInvalidPostsByTagPage, fmt.Sprintf("invalid offset %v or limit %v", offset, limit))
+}
+
 // ErrPostTitleExceedMaxLength - error when post title is too long
 func ErrPostTitleExceedMaxLength() sdk.Error {
 	return types.NewError(types.CodePostTitleExceedMaxLength, fmt.Sprintf("post title exceeds max length limitation"))
@@ -0,0 +1,84 @@
+package post
+
+import (
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/post/model"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
+	rep "github.com/lino-network/lino/x/reputation"
+)
+
+// EstimateDonation - dry run a donation in a cached context which is never written back.
+// Inflation reward is estimated as if reward events were executed at current consumption window
+func EstimateDonation(
+	ctx sdk.Context, msg DonateMsg, pm PostManager, am acc.AccountManager,
+	gm global.GlobalManager, dm dev.DeveloperManager, rm rep.ReputationManager) (*model.DonationEstimate, sdk.Error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	postEvent, sourceEvent, err := processDonation(cacheCtx, msg, pm, am, gm, dm, rm)
+	if err != nil {
+		return nil, err
+	}
+
+	estimate := &model.DonationEstimate{
+		Username:                msg.Username,
+		Amount:                  coin,
+		RedistributionSplitRate: sdk.OneRat(),
+	}
+	sourceAuthor, sourcePostID, err := pm.GetSourcePost(ctx, types.GetPermlink(msg.Author, msg.PostID))
+	if err != nil {
+		return nil, err
+	}
+	if sourceAuthor != types.AccountKey("") && sourcePostID != "" {
+		estimate.RedistributionSplitRate, err = pm.GetRedistributionSplitRate(
+			ctx, types.GetPermlink(sourceAuthor, sourcePostID))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// reward events are executed in registration order, source post first
+	if sourceEvent != nil {
+		if estimate.Source, err = estimateRewardEvent(cacheCtx, *sourceEvent, pm, gm, rm); err != nil {
+			return nil, err
+		}
+	}
+	if postEvent != nil {
+		if estimate.Post, err = estimateRewardEvent(cacheCtx, *postEvent, pm, gm, rm); err != nil {
+			return nil, err
+		}
+	}
+	return estimate, nil
+}
+
+func estimateRewardEvent(
+	ctx sdk.Context, event RewardEvent, pm PostManager, gm global.GlobalManager,
+	rm rep.ReputationManager) (*model.DonationPartEstimate, sdk.Error) {
+	penaltyScore, err := event.penaltyScore(ctx, pm, rm)
+	if err != nil {
+		return nil, err
+	}
+	reward, err := gm.GetRewardAndPopFromWindow(ctx, event.Evaluate, penaltyScore)
+	if err != nil {
+		return nil, err
+	}
+	return &model.DonationPartEstimate{
+		Author:          event.PostAuthor,
+		PostID:          event.PostID,
+		Amount:          event.Original,
+		Friction:        event.Friction,
+		DirectDeposit:   event.Original.Minus(event.Friction),
+		Evaluate:        event.Evaluate,
+		EstimatedReward: reward,
+	}, nil
+}
@@ -0,0 +1,108 @@
+package post
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestEstimateDonation(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	postParam, _ := ph.GetPostParam(ctx)
+	handler := NewHandler(pm, am, gm, dm, rm)
+	querier := NewQuerier(pm, am, gm, dm, rm)
+	cdc := wire.NewCodec()
+
+	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0.15")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	user3 := createTestAccount(t, ctx, am, "user3")
+	err := am.AddSavingCoin(
+		ctx, user3, types.NewCoinFromInt64(123*types.Decimals),
+		referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(postParam.PostIntervalSec, 0)})
+	err = pm.CreatePost(
+		ctx, user2, "repost", user1, postID, "", "",
+		string(make([]byte, 1000)), string(make([]byte, 50)),
+		sdk.ZeroRat(), []types.IDToURLMapping{}, nil, nil)
+	assert.Nil(t, err)
+
+	donateMsg := NewDonateMsg(string(user3), types.LNO("100"), string(user2), "repost", "", "")
+	estimate, err := EstimateDonation(ctx, donateMsg, pm, am, gm, dm, rm)
+	assert.Nil(t, err)
+
+	// dry run doesn't change any state
+	saving, _ := am.GetSavingFromBank(ctx, user3)
+	assert.Equal(t, initCoin.Plus(types.NewCoinFromInt64(123*types.Decimals)), saving)
+	assert.Nil(t, gm.GetTimeEventListAtTime(ctx, ctx.BlockHeader().Time.Unix()+3600*7*24))
+
+	res, err := querier(
+		ctx, []string{QueryDonationEstimate, string(user3), string(user2), "repost", "100"}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	queried := new(model.DonationEstimate)
+	assert.Nil(t, cdc.UnmarshalJSON(res, queried))
+	assert.Equal(t, estimate.Post.Evaluate, queried.Post.Evaluate)
+	assert.Equal(t, estimate.Source.EstimatedReward, queried.Source.EstimatedReward)
+
+	// estimate matches the actual donation
+	result := handler(ctx, donateMsg)
+	assert.Equal(t, donateResult(user3, user2, "100", "repost"), result)
+	eventList := gm.GetTimeEventListAtTime(ctx, ctx.BlockHeader().Time.Unix()+3600*7*24)
+	sourceEvent := eventList.Events[0].(RewardEvent)
+	postEvent := eventList.Events[1].(RewardEvent)
+
+	cacheCtx, _ := ctx.CacheContext()
+	expectedParts := []*model.DonationPartEstimate{}
+	for _, event := range []RewardEvent{sourceEvent, postEvent} {
+		penaltyScore, err := event.penaltyScore(cacheCtx, pm, rm)
+		assert.Nil(t, err)
+		reward, err := gm.GetRewardAndPopFromWindow(cacheCtx, event.Evaluate, penaltyScore)
+		assert.Nil(t, err)
+		expectedParts = append(expectedParts, &model.DonationPartEstimate{
+			Author:          event.PostAuthor,
+			PostID:          event.PostID,
+			Amount:          event.Original,
+			Friction:        event.Friction,
+			DirectDeposit:   event.Original.Minus(event.Friction),
+			Evaluate:        event.Evaluate,
+			EstimatedReward: reward,
+		})
+	}
+	assert.Equal(t, &model.DonationEstimate{
+		Username:                user3,
+		Amount:                  types.NewCoinFromInt64(100 * types.Decimals),
+		RedistributionSplitRate: sdk.NewRat(3, 20),
+		Post:                    expectedParts[1],
+		Source:                  expectedParts[0],
+	}, estimate)
+	assert.Equal(t, types.NewCoinFromInt64(15*types.Decimals), estimate.Post.Amount)
+	assert.Equal(t, types.NewCoinFromInt64(75000), estimate.Post.Friction)
+
+	testCases := []struct {
+		testName    string
+		msg         DonateMsg
+		expectedErr sdk.Error
+	}{
+		{
+			testName:    "donate to self",
+			msg:         NewDonateMsg(string(user2), types.LNO("1"), string(user2), "repost", "", ""),
+			expectedErr: ErrCannotDonateToSelf(user2),
+		},
+		{
+			testName:    "post doesn't exist",
+			msg:         NewDonateMsg(string(user3), types.LNO("1"), string(user2), "invalid", "", ""),
+			expectedErr: ErrPostNotFound(types.GetPermlink(user2, "invalid")),
+		},
+	}
+	for _, tc := range testCases {
+		_, err := EstimateDonation(ctx, tc.msg, pm, am, gm, dm, rm)
+		assert.Equal(t, tc.expectedErr, err, tc.testName)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/post/model"
 	rep "github.com/lino-network/lino/x/reputation"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,7 +23,7 @@ func init() {
 
 // RewardEvent - when donation occurred, a reward event will be register
 // at 7 days later. After 7 days reward event will be executed and send
-// inflation to author.
+// inflation to author and co-authors by their revenue shares.
 type RewardEvent struct {
 	PostAuthor types.AccountKey `json:"post_author"`
 	PostID     string           `json:"post_id"`
@@ -31,6 +32,9 @@ type RewardEvent struct {
 	Original   types.Coin       `json:"original"`
 	Friction   types.Coin       `json:"friction"`
 	FromApp    types.AccountKey `json:"from_app"`
+	// ScheduledAt and PendingRewardID identify the pending reward index entry of this event
+	ScheduledAt     int64 `json:"scheduled_at"`
+	PendingRewardID int64 `json:"pending_reward_id"`
 }
 
 // Execute - execute reward event after 7 days
@@ -40,18 +44,11 @@ func (event RewardEvent) Execute(
 	vm vote.VoteManager, rm rep.ReputationManager) sdk.Error {
 
 	permlink := types.GetPermlink(event.PostAuthor, event.PostID)
-	// check if post is deleted
-	rep, err := rm.GetSumRep(ctx, permlink)
+	pm.RemovePendingReward(ctx, permlink, event.ScheduledAt, event.PendingRewardID)
+	paneltyScore, err := event.penaltyScore(ctx, pm, rm)
 	if err != nil {
 		return err
 	}
-	paneltyScore, err := pm.GetPenaltyScore(ctx, rep)
-	if err != nil {
-		return err
-	}
-	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
-		paneltyScore = sdk.OneRat()
-	}
 	reward, err := gm.GetRewardAndPopFromWindow(ctx, event.Evaluate, paneltyScore)
 	if err != nil {
 		return err
@@ -71,9 +68,54 @@ func (event RewardEvent) Execute(
 		return err
 	}
 
-	if err := am.AddIncomeAndReward(
-		ctx, event.PostAuthor, event.Original, event.Friction, reward, event.Consumer, event.PostAuthor, event.PostID); err != nil {
+	// each co-author gets its share of the reward and a reward history entry
+	shares, err := pm.GetRevenueShares(ctx, permlink)
+	if err != nil {
 		return err
 	}
+	originals := splitByRevenueShares(event.Original, shares)
+	frictions := splitByRevenueShares(event.Friction, shares)
+	rewards := splitByRevenueShares(reward, shares)
+	for i, share := range shares {
+		if err := am.AddIncomeAndReward(
+			ctx, share.Username, originals[i], frictions[i], rewards[i],
+			event.Consumer, event.PostAuthor, event.PostID); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// penaltyScore - penalty score of the post based on its reputation, deleted post gets full penalty
+func (event RewardEvent) penaltyScore(
+	ctx sdk.Context, pm PostManager, rm rep.ReputationManager) (sdk.Rat, sdk.Error) {
+	permlink := types.GetPermlink(event.PostAuthor, event.PostID)
+	rep, err := rm.GetSumRep(ctx, permlink)
+	if err != nil {
+		return sdk.OneRat(), err
+	}
+	paneltyScore, err := pm.GetPenaltyScore(ctx, rep)
+	if err != nil {
+		return sdk.OneRat(), err
+	}
+	// check if post is deleted
+	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
+		paneltyScore = sdk.OneRat()
+	}
+	return paneltyScore, nil
+}
+
+// toPendingReward - pending reward index entry of the event
+func (event RewardEvent) toPendingReward() model.PendingReward {
+	return model.PendingReward{
+		ID:          event.PendingRewardID,
+		Author:      event.PostAuthor,
+		PostID:      event.PostID,
+		Consumer:    event.Consumer,
+		Evaluate:    event.Evaluate,
+		Original:    event.Original,
+		Friction:    event.Friction,
+		FromApp:     event.FromApp,
+		ScheduledAt: event.ScheduledAt,
+	}
+}
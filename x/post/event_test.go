@@ -2,7 +2,9 @@ package post
 
 import (
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/types"
 	"github.com/stretchr/testify/assert"
 
@@ -10,6 +12,7 @@ import (
 	accModel "github.com/lino-network/lino/x/account/model"
 	globalModel "github.com/lino-network/lino/x/global/model"
 	postModel "github.com/lino-network/lino/x/post/model"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestRewardEvent(t *testing.T) {
@@ -197,3 +200,172 @@ func TestRewardEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestRewardEventCoAuthors(t *testing.T) {
+	ctx, am, _, pm, gm, dm, vm, rm := setupTest(t, 1)
+	gs := globalModel.NewGlobalStorage(testGlobalKVStoreKey)
+	as := accModel.NewAccountStorage(testAccountKVStoreKey)
+
+	author, postID := createTestPost(t, ctx, "author", "postID", am, pm, "0")
+	coAuthor1 := createTestAccount(t, ctx, am, "coAuthor1")
+	coAuthor2 := createTestAccount(t, ctx, am, "coAuthor2")
+	consumer := createTestAccount(t, ctx, am, "consumer")
+	permlink := types.GetPermlink(author, postID)
+	err := pm.SetPostCoAuthors(
+		ctx, permlink, author, []types.AccountKey{author, coAuthor1, coAuthor2},
+		[]sdk.Rat{sdk.NewRat(1, 2), sdk.NewRat(3, 10), sdk.NewRat(1, 5)})
+	assert.Nil(t, err)
+	err = pm.AcceptCoAuthor(ctx, permlink, coAuthor1)
+	assert.Nil(t, err)
+
+	gs.SetConsumptionMeta(ctx, &globalModel.ConsumptionMeta{
+		ConsumptionRewardPool: types.NewCoinFromInt64(100),
+		ConsumptionWindow:     types.NewCoinFromInt64(100),
+	})
+	as.SetReward(ctx, author, &accModel.Reward{})
+	as.SetReward(ctx, coAuthor1, &accModel.Reward{})
+	vm.AddVoter(ctx, author, types.NewCoinFromInt64(0))
+	event := RewardEvent{
+		PostAuthor: author,
+		PostID:     postID,
+		Consumer:   consumer,
+		Evaluate:   types.NewCoinFromInt64(100),
+		Original:   types.NewCoinFromInt64(100),
+		Friction:   types.NewCoinFromInt64(20),
+	}
+	err = event.Execute(ctx, pm, am, gm, dm, vm, rm)
+	assert.Nil(t, err)
+
+	// co-author who hasn't accepted gets nothing, its share goes to author
+	testCases := []struct {
+		testName      string
+		username      types.AccountKey
+		expectReward  accModel.Reward
+		expectHistory *accModel.RewardHistory
+	}{
+		{
+			testName: "author gets its share and share of co-author not accepted",
+			username: author,
+			expectReward: accModel.Reward{
+				TotalIncome:     types.NewCoinFromInt64(70),
+				OriginalIncome:  types.NewCoinFromInt64(14),
+				FrictionIncome:  types.NewCoinFromInt64(14),
+				InflationIncome: types.NewCoinFromInt64(70),
+				UnclaimReward:   types.NewCoinFromInt64(70),
+			},
+			expectHistory: &accModel.RewardHistory{
+				Details: []accModel.RewardDetail{{
+					OriginalDonation: types.NewCoinFromInt64(70),
+					FrictionDonation: types.NewCoinFromInt64(14),
+					ActualReward:     types.NewCoinFromInt64(70),
+					Consumer:         consumer,
+					PostAuthor:       author,
+					PostID:           postID,
+					CreatedAt:        ctx.BlockHeader().Time.Unix(),
+				}},
+			},
+		},
+		{
+			testName: "accepted co-author gets its share",
+			username: coAuthor1,
+			expectReward: accModel.Reward{
+				TotalIncome:     types.NewCoinFromInt64(30),
+				OriginalIncome:  types.NewCoinFromInt64(6),
+				FrictionIncome:  types.NewCoinFromInt64(6),
+				InflationIncome: types.NewCoinFromInt64(30),
+				UnclaimReward:   types.NewCoinFromInt64(30),
+			},
+			expectHistory: &accModel.RewardHistory{
+				Details: []accModel.RewardDetail{{
+					OriginalDonation: types.NewCoinFromInt64(30),
+					FrictionDonation: types.NewCoinFromInt64(6),
+					ActualReward:     types.NewCoinFromInt64(30),
+					Consumer:         consumer,
+					PostAuthor:       author,
+					PostID:           postID,
+					CreatedAt:        ctx.BlockHeader().Time.Unix(),
+				}},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		reward, err := as.GetReward(ctx, tc.username)
+		if err != nil {
+			t.Errorf("%s: failed to get reward, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.expectReward, *reward) {
+			t.Errorf("%s: diff reward, got %v, want %v", tc.testName, *reward, tc.expectReward)
+		}
+		history, err := as.GetRewardHistory(ctx, tc.username, 0)
+		if err != nil {
+			t.Errorf("%s: failed to get reward history, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.expectHistory, history) {
+			t.Errorf("%s: diff reward history, got %v, want %v", tc.testName, history, tc.expectHistory)
+		}
+	}
+	history, err := as.GetRewardHistory(ctx, coAuthor2, 0)
+	assert.Nil(t, err)
+	assert.Nil(t, history)
+}
+
+func TestPendingRewardIndex(t *testing.T) {
+	ctx, am, _, pm, gm, dm, vm, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, rm)
+	querier := NewQuerier(pm, am, gm, dm, rm)
+	cdc := wire.NewCodec()
+
+	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	err := am.AddSavingCoin(
+		ctx, user2, types.NewCoinFromInt64(100*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+	permlink := types.GetPermlink(user1, postID)
+
+	for i := 0; i < 2; i++ {
+		result := handler(ctx, NewDonateMsg(string(user2), types.LNO("10"), string(user1), postID, "", ""))
+		assert.Equal(t, donateResult(user2, user1, "10", postID), result)
+	}
+	scheduledAt := ctx.BlockHeader().Time.Unix() + 3600*7*24
+	eventList := gm.GetTimeEventListAtTime(ctx, scheduledAt)
+	assert.Equal(t, 2, len(eventList.Events))
+
+	expectStatuses := []postModel.PendingRewardStatus{}
+	for _, event := range eventList.Events {
+		expectStatuses = append(expectStatuses, postModel.PendingRewardStatus{
+			Reward:       event.(RewardEvent).toPendingReward(),
+			PenaltyScore: sdk.ZeroRat(),
+		})
+	}
+	for _, path := range [][]string{
+		{QueryPendingRewards, string(permlink)},
+		{QueryPendingRewardsByAuthor, string(user1)},
+	} {
+		res, err := querier(ctx, path, abci.RequestQuery{})
+		assert.Nil(t, err)
+		statuses := []postModel.PendingRewardStatus{}
+		assert.Nil(t, cdc.UnmarshalJSON(res, &statuses))
+		assert.Equal(t, expectStatuses, statuses)
+	}
+
+	// identical donations get their own pending reward ids
+	assert.Equal(t, int64(1), expectStatuses[0].Reward.ID)
+	assert.Equal(t, int64(2), expectStatuses[1].Reward.ID)
+	assert.Equal(t, scheduledAt, expectStatuses[0].Reward.ScheduledAt)
+
+	// executed reward is removed from index by its exact key
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(scheduledAt, 0)})
+	err = eventList.Events[1].(RewardEvent).Execute(ctx, pm, am, gm, dm, vm, rm)
+	assert.Nil(t, err)
+	pendingRewards, err := pm.GetPendingRewardsOfPost(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, []postModel.PendingReward{expectStatuses[0].Reward}, pendingRewards)
+
+	// reward executed later than scheduled, e.g. retried, is still removed
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(scheduledAt+3600, 0)})
+	err = eventList.Events[0].(RewardEvent).Execute(ctx, pm, am, gm, dm, vm, rm)
+	assert.Nil(t, err)
+	pendingRewards, err = pm.GetPendingRewardsOfAuthor(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, []postModel.PendingReward{}, pendingRewards)
+}
@@ -23,6 +23,8 @@ func NewHandler(
 			return handleCreatePostMsg(ctx, msg, pm, am, gm)
 		case DonateMsg:
 			return handleDonateMsg(ctx, msg, pm, am, gm, dm, rm)
+		case UnlockPostMsg:
+			return handleUnlockPostMsg(ctx, msg, pm, am, gm, dm, rm)
 		case ReportOrUpvoteMsg:
 			return handleReportOrUpvoteMsg(ctx, msg, pm, am, gm, rm)
 		case ViewMsg:
@@ -31,6 +33,8 @@ func NewHandler(
 			return handleUpdatePostMsg(ctx, msg, pm, am)
 		case DeletePostMsg:
 			return handleDeletePostMsg(ctx, msg, pm, am)
+		case AcceptCoAuthorMsg:
+			return handleAcceptCoAuthorMsg(ctx, msg, pm, am)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized post msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -58,6 +62,13 @@ func handleCreatePostMsg(ctx sdk.Context, msg CreatePostMsg, pm PostManager, am
 	if lastPostAt+postParam.PostIntervalSec > ctx.BlockHeader().Time.Unix() {
 		return ErrPostTooOften(msg.Author).Result()
 	}
+	coAuthors := []types.AccountKey{}
+	for _, coAuthor := range msg.CoAuthors {
+		if !am.DoesAccountExist(ctx, coAuthor.Username) {
+			return ErrAccountNotFound(coAuthor.Username).Result()
+		}
+		coAuthors = append(coAuthors, coAuthor.Username)
+	}
 	if len(msg.ParentAuthor) > 0 || len(msg.ParentPostID) > 0 {
 		parentPostKey := types.GetPermlink(msg.ParentAuthor, msg.ParentPostID)
 		if !pm.DoesPostExist(ctx, parentPostKey) {
@@ -76,14 +87,62 @@ func handleCreatePostMsg(ctx sdk.Context, msg CreatePostMsg, pm PostManager, am
 	if err := pm.CreatePost(
 		ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 		msg.ParentAuthor, msg.ParentPostID, msg.Content, msg.Title,
-		splitRate, msg.Links); err != nil {
+		splitRate, msg.Links, msg.Tags, msg.ContentReference); err != nil {
 		return err.Result()
 	}
+	if len(msg.CoAuthors) > 0 {
+		shares, err := parseCoAuthorShares(msg.Author, msg.CoAuthors)
+		if err != nil {
+			return err.Result()
+		}
+		if err := pm.SetPostCoAuthors(ctx, permlink, msg.Author, coAuthors, shares); err != nil {
+			return err.Result()
+		}
+	}
+	if msg.EncryptedContentReference != nil {
+		price, err := types.LinoToCoin(msg.Price)
+		if err != nil {
+			return err.Result()
+		}
+		if err := pm.SetPostPaywall(ctx, permlink, price, *msg.EncryptedContentReference); err != nil {
+			return err.Result()
+		}
+	}
 
 	if err := am.UpdateLastPostAt(ctx, msg.Author); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionCreatePost),
+			types.TagSender, []byte(msg.Author),
+			types.TagAuthor, []byte(msg.Author),
+			types.TagPermlink, []byte(permlink),
+		),
+	}
+}
+
+// Handle AcceptCoAuthorMsg
+func handleAcceptCoAuthorMsg(
+	ctx sdk.Context, msg AcceptCoAuthorMsg, pm PostManager, am acc.AccountManager) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Username) {
+		return ErrAccountNotFound(msg.Username).Result()
+	}
+	permlink := types.GetPermlink(msg.Author, msg.PostID)
+	if !pm.DoesPostExist(ctx, permlink) {
+		return ErrPostNotFound(permlink).Result()
+	}
+	if err := pm.AcceptCoAuthor(ctx, permlink, msg.Username); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionAcceptCoAuthor),
+			types.TagSender, []byte(msg.Username),
+			types.TagAuthor, []byte(msg.Author),
+			types.TagPermlink, []byte(permlink),
+		),
+	}
 }
 
 // Handle ViewMsg
@@ -99,7 +158,14 @@ func handleViewMsg(ctx sdk.Context, msg ViewMsg, pm PostManager, am acc.AccountM
 		return err.Result()
 	}
 
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionView),
+			types.TagSender, []byte(msg.Username),
+			types.TagAuthor, []byte(msg.Author),
+			types.TagPermlink, []byte(permlink),
+		),
+	}
 }
 
 // Handle DonateMsg
@@ -107,6 +173,27 @@ func handleDonateMsg(
 	ctx sdk.Context, msg DonateMsg, pm PostManager, am acc.AccountManager,
 	gm global.GlobalManager, dm dev.DeveloperManager, rm rep.ReputationManager) sdk.Result {
 	permlink := types.GetPermlink(msg.Author, msg.PostID)
+	if _, _, err := processDonation(ctx, msg, pm, am, gm, dm, rm); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionDonate),
+			types.TagSender, []byte(msg.Username),
+			types.TagReceiver, []byte(msg.Author),
+			types.TagAmount, []byte(msg.Amount),
+			types.TagAuthor, []byte(msg.Author),
+			types.TagPermlink, []byte(permlink),
+		),
+	}
+}
+
+// Handle UnlockPostMsg, the price is transferred to author through the same friction
+// path as donation and the unlock is recorded so that app can verify access
+func handleUnlockPostMsg(
+	ctx sdk.Context, msg UnlockPostMsg, pm PostManager, am acc.AccountManager,
+	gm global.GlobalManager, dm dev.DeveloperManager, rm rep.ReputationManager) sdk.Result {
+	permlink := types.GetPermlink(msg.Author, msg.PostID)
 	coin, err := types.LinoToCoin(msg.Amount)
 	if err != nil {
 		return err.Result()
@@ -118,120 +205,216 @@ func handleDonateMsg(
 		return ErrPostNotFound(permlink).Result()
 	}
 	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
-		return ErrDonatePostIsDeleted(permlink).Result()
+		return ErrUnlockPostIsDeleted(permlink).Result()
+	}
+	isCoAuthor, err := pm.IsCoAuthor(ctx, permlink, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+	if isCoAuthor {
+		return ErrCannotUnlockOwnPost(msg.Username).Result()
+	}
+	paywall, err := pm.GetPostPaywall(ctx, permlink)
+	if err != nil {
+		return ErrPostNotPaid(permlink).Result()
+	}
+	if !coin.IsEqual(paywall.Price) {
+		return ErrUnlockPriceMismatch(permlink).Result()
+	}
+	if pm.IsPostUnlocked(ctx, permlink, msg.Username) {
+		return ErrPostAlreadyUnlocked(permlink, msg.Username).Result()
+	}
+	if msg.FromApp != "" {
+		if !dm.DoesDeveloperExist(ctx, msg.FromApp) {
+			return ErrDeveloperNotFound(msg.FromApp).Result()
+		}
+	}
+
+	coinDayBeforeUnlock, err := am.GetCoinDay(ctx, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+	if err := am.MinusSavingCoinWithFullCoinDay(
+		ctx, msg.Username, coin, msg.Author,
+		fmt.Sprintf("unlock post: %v", string(permlink)), types.DonationOut); err != nil {
+		return err.Result()
+	}
+	coinDayAfterUnlock, err := am.GetCoinDay(ctx, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+	if _, err := processDonationFriction(
+		ctx, msg.Username, coin, coinDayBeforeUnlock.Minus(coinDayAfterUnlock),
+		msg.Author, msg.PostID, msg.FromApp, am, pm, gm, rm); err != nil {
+		return err.Result()
+	}
+	if err := pm.AddPostUnlock(ctx, permlink, msg.Username, coin); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionUnlockPost),
+			types.TagSender, []byte(msg.Username),
+			types.TagReceiver, []byte(msg.Author),
+			types.TagAmount, []byte(msg.Amount),
+			types.TagAuthor, []byte(msg.Author),
+			types.TagPermlink, []byte(permlink),
+		),
+	}
+}
+
+// processDonation - donate to the post and its source post if any, returns reward events
+// registered for the post and the source post, which are nil if nothing is donated to them
+func processDonation(
+	ctx sdk.Context, msg DonateMsg, pm PostManager, am acc.AccountManager,
+	gm global.GlobalManager, dm dev.DeveloperManager,
+	rm rep.ReputationManager) (postEvent, sourceEvent *RewardEvent, err sdk.Error) {
+	permlink := types.GetPermlink(msg.Author, msg.PostID)
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !am.DoesAccountExist(ctx, msg.Username) {
+		return nil, nil, ErrAccountNotFound(msg.Username)
+	}
+	if !pm.DoesPostExist(ctx, permlink) {
+		return nil, nil, ErrPostNotFound(permlink)
+	}
+	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
+		return nil, nil, ErrDonatePostIsDeleted(permlink)
 	}
 
 	if msg.Username == msg.Author {
-		return ErrCannotDonateToSelf(msg.Username).Result()
+		return nil, nil, ErrCannotDonateToSelf(msg.Username)
 	}
 	if msg.FromApp != "" {
 		if !dm.DoesDeveloperExist(ctx, msg.FromApp) {
-			return ErrDeveloperNotFound(msg.FromApp).Result()
+			return nil, nil, ErrDeveloperNotFound(msg.FromApp)
 		}
 	}
 
 	coinDayBeforeDonate, err := am.GetCoinDay(ctx, msg.Username)
 	if err != nil {
-		return err.Result()
+		return nil, nil, err
 	}
 
 	if err := am.MinusSavingCoinWithFullCoinDay(
 		ctx, msg.Username, coin, msg.Author,
 		fmt.Sprintf("donate to post: %v, memo: %v", string(permlink), msg.Memo),
 		types.DonationOut); err != nil {
-		return err.Result()
+		return nil, nil, err
 	}
 
 	coinDayAfterDonate, err := am.GetCoinDay(ctx, msg.Username)
 	if err != nil {
-		return err.Result()
+		return nil, nil, err
 	}
 
 	totalCoinDayDonated := coinDayBeforeDonate.Minus(coinDayAfterDonate)
 	sourceAuthor, sourcePostID, err := pm.GetSourcePost(ctx, permlink)
 	if err != nil {
-		return err.Result()
+		return nil, nil, err
 	}
 	if sourceAuthor != types.AccountKey("") && sourcePostID != "" {
 		sourcePermlink := types.GetPermlink(sourceAuthor, sourcePostID)
 
 		redistributionSplitRate, err := pm.GetRedistributionSplitRate(ctx, sourcePermlink)
 		if err != nil {
-			return err.Result()
+			return nil, nil, err
 		}
 		sourceIncome := types.RatToCoin(coin.ToRat().Mul(sdk.OneRat().Sub(redistributionSplitRate)))
 		coin = coin.Minus(sourceIncome)
 		sourceCoinDayGained := types.RatToCoin(totalCoinDayDonated.ToRat().Mul(sdk.OneRat().Sub(redistributionSplitRate)))
 		totalCoinDayDonated = totalCoinDayDonated.Minus(sourceCoinDayGained)
-		if err := processDonationFriction(
-			ctx, msg.Username, sourceIncome, sourceCoinDayGained, sourceAuthor, sourcePostID, msg.FromApp, am, pm, gm, rm); err != nil {
-			return ErrProcessSourceDonation(sourcePermlink).Result()
+		sourceEvent, err = processDonationFriction(
+			ctx, msg.Username, sourceIncome, sourceCoinDayGained, sourceAuthor, sourcePostID, msg.FromApp, am, pm, gm, rm)
+		if err != nil {
+			return nil, nil, ErrProcessSourceDonation(sourcePermlink)
 		}
 	}
-	if err := processDonationFriction(
-		ctx, msg.Username, coin, totalCoinDayDonated, msg.Author, msg.PostID, msg.FromApp, am, pm, gm, rm); err != nil {
-		return ErrProcessDonation(permlink).Result()
+	postEvent, err = processDonationFriction(
+		ctx, msg.Username, coin, totalCoinDayDonated, msg.Author, msg.PostID, msg.FromApp, am, pm, gm, rm)
+	if err != nil {
+		return nil, nil, ErrProcessDonation(permlink)
 	}
-	return sdk.Result{}
+	return postEvent, sourceEvent, nil
 }
 
+// processDonationFriction - charge friction, register reward event and deposit the rest to
+// post author and co-authors by their revenue shares. Returns the reward event, nil if coin is zero
 func processDonationFriction(
 	ctx sdk.Context, consumer types.AccountKey, coin types.Coin, coinDayDonated types.Coin,
 	postAuthor types.AccountKey, postID string, fromApp types.AccountKey, am acc.AccountManager,
-	pm PostManager, gm global.GlobalManager, rm rep.ReputationManager) sdk.Error {
+	pm PostManager, gm global.GlobalManager, rm rep.ReputationManager) (*RewardEvent, sdk.Error) {
 	postKey := types.GetPermlink(postAuthor, postID)
 	if coin.IsZero() {
-		return nil
+		return nil, nil
 	}
 	if !am.DoesAccountExist(ctx, postAuthor) {
-		return ErrAccountNotFound(postAuthor)
+		return nil, ErrAccountNotFound(postAuthor)
 	}
 	consumptionFrictionRate, err := gm.GetConsumptionFrictionRate(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	frictionCoin := types.RatToCoin(coin.ToRat().Mul(consumptionFrictionRate))
 	// evaluate this consumption can get the result, the result is used to get inflation from pool
 	dp, err := rm.DonateAt(ctx, consumer, postKey, coinDayDonated)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	evaluateResult, err := evaluateConsumption(ctx, consumer, dp, postAuthor, postID, am, pm, gm)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	scheduledAt, err := gm.GetContentRewardEventTime(ctx)
+	if err != nil {
+		return nil, err
 	}
 	rewardEvent := RewardEvent{
-		PostAuthor: postAuthor,
-		PostID:     postID,
-		Consumer:   consumer,
-		Evaluate:   evaluateResult,
-		Original:   coin,
-		Friction:   frictionCoin,
-		FromApp:    fromApp,
+		PostAuthor:  postAuthor,
+		PostID:      postID,
+		Consumer:    consumer,
+		Evaluate:    evaluateResult,
+		Original:    coin,
+		Friction:    frictionCoin,
+		FromApp:     fromApp,
+		ScheduledAt: scheduledAt,
+	}
+	pendingRewardID, err := pm.AddPendingReward(ctx, rewardEvent.toPendingReward())
+	if err != nil {
+		return nil, err
 	}
+	rewardEvent.PendingRewardID = pendingRewardID
 	if err := gm.AddFrictionAndRegisterContentRewardEvent(
 		ctx, rewardEvent, frictionCoin, evaluateResult); err != nil {
-		return err
+		return nil, err
 	}
 
 	directDeposit := coin.Minus(frictionCoin)
 	if err := pm.AddDonation(ctx, postKey, consumer, directDeposit, types.DirectDeposit); err != nil {
-		return err
+		return nil, err
 	}
-	if err := am.AddSavingCoin(
-		ctx, postAuthor, directDeposit, consumer, string(postKey), types.DonationIn); err != nil {
-		return err
+	shares, err := pm.GetRevenueShares(ctx, postKey)
+	if err != nil {
+		return nil, err
 	}
-	if err := am.AddDirectDeposit(ctx, postAuthor, directDeposit); err != nil {
-		return err
+	for i, deposit := range splitByRevenueShares(directDeposit, shares) {
+		if err := am.AddSavingCoin(
+			ctx, shares[i].Username, deposit, consumer, string(postKey), types.DonationIn); err != nil {
+			return nil, err
+		}
+		if err := am.AddDirectDeposit(ctx, shares[i].Username, deposit); err != nil {
+			return nil, err
+		}
 	}
 	if err := gm.AddConsumption(ctx, coin); err != nil {
-		return err
+		return nil, err
 	}
 	if err := am.UpdateDonationRelationship(ctx, postAuthor, consumer); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return &rewardEvent, nil
 }
 
 func evaluateConsumption(
@@ -286,7 +469,14 @@ func handleReportOrUpvoteMsg(
 	if err := am.UpdateLastReportOrUpvoteAt(ctx, msg.Username); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionReportOrUpvote),
+			types.TagSender, []byte(msg.Username),
+			types.TagAuthor, []byte(msg.Author),
+			types.TagPermlink, []byte(permlink),
+		),
+	}
 }
 
 func handleUpdatePostMsg(
@@ -303,10 +493,17 @@ func handleUpdatePostMsg(
 	}
 
 	if err := pm.UpdatePost(
-		ctx, msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links); err != nil {
+		ctx, msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links, msg.Tags); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionUpdatePost),
+			types.TagSender, []byte(msg.Author),
+			types.TagAuthor, []byte(msg.Author),
+			types.TagPermlink, []byte(permlink),
+		),
+	}
 }
 
 func handleDeletePostMsg(
@@ -322,5 +519,12 @@ func handleDeletePostMsg(
 	if err := pm.DeletePost(ctx, permlink); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionDeletePost),
+			types.TagSender, []byte(msg.Author),
+			types.TagAuthor, []byte(msg.Author),
+			types.TagPermlink, []byte(permlink),
+		),
+	}
 }
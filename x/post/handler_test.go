@@ -36,7 +36,7 @@ func TestHandlerCreatePost(t *testing.T) {
 		RedistributionSplitRate: "0",
 	}
 	result := handler(ctx, msg)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, postResult(types.ActionCreatePost, user, user, "TestPostID"))
 	assert.True(t, pm.DoesPostExist(ctx, types.GetPermlink(msg.Author, msg.PostID)))
 
 	// test invlaid author
@@ -70,23 +70,23 @@ func TestHandlerUpdatePost(t *testing.T) {
 		wantResult sdk.Result
 	}{
 		"normal update": {
-			msg:        NewUpdatePostMsg(string(user), postID, "update title", "update content", []types.IDToURLMapping(nil)),
-			wantResult: sdk.Result{},
+			msg:        NewUpdatePostMsg(string(user), postID, "update title", "update content", []types.IDToURLMapping(nil), nil),
+			wantResult: postResult(types.ActionUpdatePost, user, user, postID),
 		},
 		"update author doesn't exist": {
-			msg:        NewUpdatePostMsg("invalid", postID, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg("invalid", postID, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrAccountNotFound("invalid").Result(),
 		},
 		"update post doesn't exist - invalid post ID": {
-			msg:        NewUpdatePostMsg(string(user), "invalid", "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user), "invalid", "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrPostNotFound(types.GetPermlink(user, "invalid")).Result(),
 		},
 		"update post doesn't exist - invalid author": {
-			msg:        NewUpdatePostMsg(string(user2), postID, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user2), postID, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrPostNotFound(types.GetPermlink(user2, postID)).Result(),
 		},
 		"update deleted post": {
-			msg:        NewUpdatePostMsg(string(user1), postID1, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user1), postID1, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrUpdatePostIsDeleted(types.GetPermlink(user1, postID1)).Result(),
 		},
 	}
@@ -141,7 +141,7 @@ func TestHandlerDeletePost(t *testing.T) {
 				Author: user,
 				PostID: postID,
 			},
-			wantResult: sdk.Result{},
+			wantResult: postResult(types.ActionDeletePost, user, user, postID),
 		},
 		"author doesn't exist": {
 			msg: DeletePostMsg{
@@ -200,7 +200,7 @@ func TestHandlerCreateComment(t *testing.T) {
 		RedistributionSplitRate: "0",
 	}
 	result := handler(ctx, msg)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, postResult(types.ActionCreatePost, user, user, "comment"))
 
 	// after handler check KVStore
 	postInfo := model.PostInfo{
@@ -297,7 +297,7 @@ func TestHandlerRepost(t *testing.T) {
 	}
 	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: baseTime1})
 	result := handler(ctx, msg)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, postResult(types.ActionCreatePost, user, user, "repost"))
 
 	// after handler check KVStore
 	postInfo := model.PostInfo{
@@ -331,7 +331,7 @@ func TestHandlerRepost(t *testing.T) {
 	msg.SourcePostID = "repost"
 	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: baseTime2})
 	result = handler(ctx, msg)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, postResult(types.ActionCreatePost, user, user, "repost-repost"))
 
 	// after handler check KVStore
 	// check 2 depth repost
@@ -402,7 +402,7 @@ func TestHandlerPostDonate(t *testing.T) {
 			amount:     types.LNO("100"),
 			toAuthor:   author,
 			toPostID:   postID,
-			expectErr:  sdk.Result{},
+			expectErr:  donateResult(userWithSufficientSaving, author, "100", postID),
 			expectPostMeta: model.PostMeta{
 				CreatedAt:               ctx.BlockHeader().Time.Unix(),
 				LastUpdatedAt:           ctx.BlockHeader().Time.Unix(),
@@ -418,13 +418,15 @@ func TestHandlerPostDonate(t *testing.T) {
 			expectAuthorSaving: accParam.RegisterFee.Plus(
 				types.NewCoinFromInt64(95 * types.Decimals)),
 			expectRegisteredEvent: RewardEvent{
-				PostAuthor: author,
-				PostID:     postID,
-				Consumer:   userWithSufficientSaving,
-				Evaluate:   types.NewCoinFromInt64(59380),
-				Original:   types.NewCoinFromInt64(100 * types.Decimals),
-				Friction:   types.NewCoinFromInt64(5 * types.Decimals),
-				FromApp:    "",
+				PostAuthor:      author,
+				PostID:          postID,
+				Consumer:        userWithSufficientSaving,
+				Evaluate:        types.NewCoinFromInt64(59380),
+				Original:        types.NewCoinFromInt64(100 * types.Decimals),
+				Friction:        types.NewCoinFromInt64(5 * types.Decimals),
+				FromApp:         "",
+				ScheduledAt:     ctx.BlockHeader().Time.Unix() + 3600*7*24,
+				PendingRewardID: 1,
 			},
 			expectDonateTimesFromUserToAuthor: 1,
 			expectCumulativeConsumption:       types.NewCoinFromInt64(100 * types.Decimals),
@@ -458,7 +460,7 @@ func TestHandlerPostDonate(t *testing.T) {
 			amount:     types.LNO("50"),
 			toAuthor:   author,
 			toPostID:   postID,
-			expectErr:  sdk.Result{},
+			expectErr:  donateResult(secondUserWithSufficientSaving, author, "50", postID),
 			expectPostMeta: model.PostMeta{
 				CreatedAt:               ctx.BlockHeader().Time.Unix(),
 				LastUpdatedAt:           ctx.BlockHeader().Time.Unix(),
@@ -474,13 +476,15 @@ func TestHandlerPostDonate(t *testing.T) {
 				types.NewCoinFromInt64(50 * types.Decimals)),
 			expectAuthorSaving: accParam.RegisterFee.Plus(types.NewCoinFromInt64(14250000)),
 			expectRegisteredEvent: RewardEvent{
-				PostAuthor: author,
-				PostID:     postID,
-				Consumer:   secondUserWithSufficientSaving,
-				Evaluate:   types.NewCoinFromInt64(59361),
-				Original:   types.NewCoinFromInt64(50 * types.Decimals),
-				Friction:   types.NewCoinFromInt64(250000),
-				FromApp:    "",
+				PostAuthor:      author,
+				PostID:          postID,
+				Consumer:        secondUserWithSufficientSaving,
+				Evaluate:        types.NewCoinFromInt64(59361),
+				Original:        types.NewCoinFromInt64(50 * types.Decimals),
+				Friction:        types.NewCoinFromInt64(250000),
+				FromApp:         "",
+				ScheduledAt:     ctx.BlockHeader().Time.Unix() + 3600*7*24,
+				PendingRewardID: 2,
 			},
 			expectDonateTimesFromUserToAuthor: 1,
 			expectCumulativeConsumption:       types.NewCoinFromInt64(150 * types.Decimals),
@@ -495,7 +499,7 @@ func TestHandlerPostDonate(t *testing.T) {
 			amount:     types.LNO("50"),
 			toAuthor:   author,
 			toPostID:   postID,
-			expectErr:  sdk.Result{},
+			expectErr:  donateResult(secondUserWithSufficientSaving, author, "50", postID),
 			expectPostMeta: model.PostMeta{
 				CreatedAt:               ctx.BlockHeader().Time.Unix(),
 				LastUpdatedAt:           ctx.BlockHeader().Time.Unix(),
@@ -510,13 +514,15 @@ func TestHandlerPostDonate(t *testing.T) {
 			expectDonatorSaving: accParam.RegisterFee,
 			expectAuthorSaving:  accParam.RegisterFee.Plus(types.NewCoinFromInt64(190 * types.Decimals)),
 			expectRegisteredEvent: RewardEvent{
-				PostAuthor: author,
-				PostID:     postID,
-				Consumer:   secondUserWithSufficientSaving,
-				Evaluate:   types.NewCoinFromInt64(0),
-				Original:   types.NewCoinFromInt64(50 * types.Decimals),
-				Friction:   types.NewCoinFromInt64(250000),
-				FromApp:    "",
+				PostAuthor:      author,
+				PostID:          postID,
+				Consumer:        secondUserWithSufficientSaving,
+				Evaluate:        types.NewCoinFromInt64(0),
+				Original:        types.NewCoinFromInt64(50 * types.Decimals),
+				Friction:        types.NewCoinFromInt64(250000),
+				FromApp:         "",
+				ScheduledAt:     ctx.BlockHeader().Time.Unix() + 3600*7*24,
+				PendingRewardID: 3,
 			},
 			expectDonateTimesFromUserToAuthor: 2,
 			expectCumulativeConsumption:       types.NewCoinFromInt64(200 * types.Decimals),
@@ -531,7 +537,7 @@ func TestHandlerPostDonate(t *testing.T) {
 			amount:     types.LNO("0.00001"),
 			toAuthor:   author,
 			toPostID:   postID,
-			expectErr:  sdk.Result{},
+			expectErr:  donateResult(micropaymentUser, author, "0.00001", postID),
 			expectPostMeta: model.PostMeta{
 				CreatedAt:               ctx.BlockHeader().Time.Unix(),
 				LastUpdatedAt:           ctx.BlockHeader().Time.Unix(),
@@ -546,13 +552,15 @@ func TestHandlerPostDonate(t *testing.T) {
 			expectDonatorSaving: types.NewCoinFromInt64(199999),
 			expectAuthorSaving:  accParam.RegisterFee.Plus(types.NewCoinFromInt64(19000001)),
 			expectRegisteredEvent: RewardEvent{
-				PostAuthor: author,
-				PostID:     postID,
-				Consumer:   micropaymentUser,
-				Evaluate:   types.NewCoinFromInt64(5),
-				Original:   types.NewCoinFromInt64(1),
-				Friction:   types.NewCoinFromInt64(0),
-				FromApp:    "",
+				PostAuthor:      author,
+				PostID:          postID,
+				Consumer:        micropaymentUser,
+				Evaluate:        types.NewCoinFromInt64(5),
+				Original:        types.NewCoinFromInt64(1),
+				Friction:        types.NewCoinFromInt64(0),
+				FromApp:         "",
+				ScheduledAt:     ctx.BlockHeader().Time.Unix() + 3600*7*24,
+				PendingRewardID: 4,
 			},
 			expectDonateTimesFromUserToAuthor: 1,
 			expectCumulativeConsumption:       types.NewCoinFromInt64(20000001),
@@ -734,12 +742,12 @@ func TestHandlerRePostDonate(t *testing.T) {
 	}
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(postParam.PostIntervalSec, 0)})
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, postResult(types.ActionCreatePost, user2, user2, "repost"), result)
 
 	donateMsg := NewDonateMsg(
 		string(user3), types.LNO("100"), string(user2), "repost", "", memo1)
 	result = handler(ctx, donateMsg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, donateResult(user3, user2, "100", "repost"), result)
 	eventList :=
 		gm.GetTimeEventListAtTime(ctx, ctx.BlockHeader().Time.Unix()+3600*7*24)
 
@@ -770,13 +778,15 @@ func TestHandlerRePostDonate(t *testing.T) {
 	}
 	checkPostKVStore(t, ctx, types.GetPermlink(user2, "repost"), postInfo, postMeta)
 	repostRewardEvent := RewardEvent{
-		PostAuthor: user2,
-		PostID:     "repost",
-		Consumer:   user3,
-		Evaluate:   types.NewCoinFromInt64(13017),
-		Original:   types.NewCoinFromInt64(15 * types.Decimals),
-		Friction:   types.NewCoinFromInt64(75000),
-		FromApp:    "",
+		PostAuthor:      user2,
+		PostID:          "repost",
+		Consumer:        user3,
+		Evaluate:        types.NewCoinFromInt64(13017),
+		Original:        types.NewCoinFromInt64(15 * types.Decimals),
+		Friction:        types.NewCoinFromInt64(75000),
+		FromApp:         "",
+		ScheduledAt:     ctx.BlockHeader().Time.Unix() + 3600*7*24,
+		PendingRewardID: 2,
 	}
 	assert.Equal(t, repostRewardEvent, eventList.Events[1])
 
@@ -845,7 +855,7 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			targetPostAuthor:     string(user1),
 			targetPostID:         postID,
 			lastReportOrUpvoteAt: baseTime - postParam.ReportOrUpvoteIntervalSec,
-			expectResult:         sdk.Result{},
+			expectResult:         postResult(types.ActionReportOrUpvote, user1, user1, postID),
 		},
 		{
 			testName:             "user2 report",
@@ -854,7 +864,7 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			targetPostAuthor:     string(user1),
 			targetPostID:         postID,
 			lastReportOrUpvoteAt: baseTime - postParam.ReportOrUpvoteIntervalSec,
-			expectResult:         sdk.Result{},
+			expectResult:         postResult(types.ActionReportOrUpvote, user2, user1, postID),
 		},
 		{
 			testName:             "user3 upvote",
@@ -863,7 +873,7 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			targetPostAuthor:     string(user1),
 			targetPostID:         postID,
 			lastReportOrUpvoteAt: baseTime - postParam.ReportOrUpvoteIntervalSec,
-			expectResult:         sdk.Result{},
+			expectResult:         postResult(types.ActionReportOrUpvote, user3, user1, postID),
 		},
 		{
 			testName:             "user1 wanna change report to upvote",
@@ -872,7 +882,7 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			targetPostAuthor:     string(user1),
 			targetPostID:         postID,
 			lastReportOrUpvoteAt: baseTime - postParam.ReportOrUpvoteIntervalSec,
-			expectResult:         sdk.Result{},
+			expectResult:         postResult(types.ActionReportOrUpvote, user1, user1, postID),
 		},
 		{
 			testName:             "user1 report too often",
@@ -998,9 +1008,10 @@ func TestHandlerView(t *testing.T) {
 		postKey := types.GetPermlink(tc.author, tc.postID)
 		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(tc.viewTime, 0)})
 		msg := NewViewMsg(string(tc.viewUser), string(tc.author), tc.postID)
+		wantResult := postResult(types.ActionView, tc.viewUser, tc.author, tc.postID)
 		result := handler(ctx, msg)
-		if !assert.Equal(t, result, sdk.Result{}) {
-			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, sdk.Result{})
+		if !assert.Equal(t, result, wantResult) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, wantResult)
 		}
 
 		postMeta := model.PostMeta{
@@ -1027,3 +1038,295 @@ func TestHandlerView(t *testing.T) {
 		}
 	}
 }
+
+func postResult(action string, sender, author types.AccountKey, postID string) sdk.Result {
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(action),
+			types.TagSender, []byte(sender),
+			types.TagAuthor, []byte(author),
+			types.TagPermlink, []byte(types.GetPermlink(author, postID)),
+		),
+	}
+}
+
+func TestHandlerUnlockPost(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, rm)
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+	accParam, err := ph.GetAccountParam(ctx)
+	assert.Nil(t, err)
+
+	author := createTestAccount(t, ctx, am, "author")
+	user := createTestAccount(t, ctx, am, "user")
+	err = am.AddSavingCoin(
+		ctx, user, types.NewCoinFromInt64(100*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+	freeAuthor, freePostID := createTestPost(t, ctx, "freeAuthor", "free", am, pm, "0")
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(postParam.PostIntervalSec, 0)})
+	encryptedContent := types.ContentReference{
+		Hash:     testContentHash,
+		Size:     1 << 20,
+		MIMEType: "application/octet-stream",
+		URI:      "ipfs://QmEncryptedContent",
+	}
+	msg := CreatePostMsg{
+		PostID:                    "paid",
+		Title:                     "title",
+		Content:                   "preview",
+		Author:                    author,
+		RedistributionSplitRate:   "0",
+		Price:                     "10",
+		EncryptedContentReference: &encryptedContent,
+	}
+	result := handler(ctx, msg)
+	assert.Equal(t, postResult(types.ActionCreatePost, author, author, "paid"), result)
+	permlink := types.GetPermlink(author, "paid")
+	price := types.NewCoinFromInt64(10 * types.Decimals)
+	paywall, err := pm.GetPostPaywall(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, model.PostPaywall{Price: price, EncryptedContentReference: encryptedContent}, *paywall)
+	assert.True(t, pm.DoesContentHashExist(ctx, encryptedContent.Hash))
+
+	testCases := []struct {
+		testName     string
+		msg          UnlockPostMsg
+		expectResult sdk.Result
+	}{
+		{
+			testName:     "unlock free post",
+			msg:          NewUnlockPostMsg("user", "10", string(freeAuthor), freePostID, ""),
+			expectResult: ErrPostNotPaid(types.GetPermlink(freeAuthor, freePostID)).Result(),
+		},
+		{
+			testName:     "unlock own post",
+			msg:          NewUnlockPostMsg("author", "10", "author", "paid", ""),
+			expectResult: ErrCannotUnlockOwnPost(author).Result(),
+		},
+		{
+			testName:     "amount doesn't match price",
+			msg:          NewUnlockPostMsg("user", "5", "author", "paid", ""),
+			expectResult: ErrUnlockPriceMismatch(permlink).Result(),
+		},
+		{
+			testName:     "unlock paid post",
+			msg:          NewUnlockPostMsg("user", "10", "author", "paid", ""),
+			expectResult: unlockResult(user, author, "10", "paid"),
+		},
+		{
+			testName:     "unlock paid post twice",
+			msg:          NewUnlockPostMsg("user", "10", "author", "paid", ""),
+			expectResult: ErrPostAlreadyUnlocked(permlink, user).Result(),
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
+
+	// price is transferred to author with friction charged
+	authorSaving, err := am.GetSavingFromBank(ctx, author)
+	assert.Nil(t, err)
+	assert.True(t, authorSaving.IsEqual(accParam.RegisterFee.Plus(types.NewCoinFromInt64(95*types.Decimals/10))))
+	userSaving, err := am.GetSavingFromBank(ctx, user)
+	assert.Nil(t, err)
+	assert.True(t, userSaving.IsEqual(accParam.RegisterFee.Plus(types.NewCoinFromInt64(90*types.Decimals))))
+
+	assert.True(t, pm.IsPostUnlocked(ctx, permlink, user))
+	unlock, err := pm.GetPostUnlock(ctx, permlink, user)
+	assert.Nil(t, err)
+	assert.Equal(t, model.PostUnlock{Username: user, Price: price, UnlockedAt: postParam.PostIntervalSec}, *unlock)
+
+	// deleted post can't be unlocked, previous unlock is kept
+	err = pm.DeletePost(ctx, permlink)
+	assert.Nil(t, err)
+	assert.False(t, pm.DoesContentHashExist(ctx, encryptedContent.Hash))
+	result = handler(ctx, NewUnlockPostMsg("user", "10", "author", "paid", ""))
+	assert.Equal(t, ErrUnlockPostIsDeleted(permlink).Result(), result)
+	assert.True(t, pm.IsPostUnlocked(ctx, permlink, user))
+}
+
+func TestHandlerUnlockCoAuthoredPost(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, rm)
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+
+	author := createTestAccount(t, ctx, am, "author")
+	coAuthor1 := createTestAccount(t, ctx, am, "coAuthor1")
+	coAuthor2 := createTestAccount(t, ctx, am, "coAuthor2")
+	for _, user := range []types.AccountKey{coAuthor1, coAuthor2} {
+		err = am.AddSavingCoin(
+			ctx, user, types.NewCoinFromInt64(100*types.Decimals), referrer, "", types.TransferIn)
+		assert.Nil(t, err)
+	}
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(postParam.PostIntervalSec, 0)})
+	encryptedContent := types.ContentReference{
+		Hash:     testContentHash,
+		Size:     1 << 20,
+		MIMEType: "application/octet-stream",
+		URI:      "ipfs://QmEncryptedContent",
+	}
+	msg := CreatePostMsg{
+		PostID:                    "paid",
+		Title:                     "title",
+		Content:                   "preview",
+		Author:                    author,
+		RedistributionSplitRate:   "0",
+		Price:                     "10",
+		EncryptedContentReference: &encryptedContent,
+		CoAuthors: []CoAuthorShare{
+			{Username: author, Share: "0.5"},
+			{Username: coAuthor1, Share: "0.3"},
+			{Username: coAuthor2, Share: "0.2"},
+		},
+	}
+	result := handler(ctx, msg)
+	assert.Equal(t, postResult(types.ActionCreatePost, author, author, "paid"), result)
+	result = handler(ctx, NewAcceptCoAuthorMsg("coAuthor1", "author", "paid"))
+	assert.Equal(t, acceptCoAuthorResult(coAuthor1, author, "paid"), result)
+
+	// co-authors can't unlock, whether they accepted or not
+	for _, user := range []types.AccountKey{coAuthor1, coAuthor2} {
+		result = handler(ctx, NewUnlockPostMsg(string(user), "10", "author", "paid", ""))
+		assert.Equal(t, ErrCannotUnlockOwnPost(user).Result(), result)
+		assert.False(t, pm.IsPostUnlocked(ctx, types.GetPermlink(author, "paid"), user))
+	}
+}
+
+func unlockResult(user, author types.AccountKey, amount types.LNO, postID string) sdk.Result {
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionUnlockPost),
+			types.TagSender, []byte(user),
+			types.TagReceiver, []byte(author),
+			types.TagAmount, []byte(amount),
+			types.TagAuthor, []byte(author),
+			types.TagPermlink, []byte(types.GetPermlink(author, postID)),
+		),
+	}
+}
+
+func TestHandlerCoAuthors(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, rm)
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+	accParam, err := ph.GetAccountParam(ctx)
+	assert.Nil(t, err)
+
+	author := createTestAccount(t, ctx, am, "author")
+	coAuthor1 := createTestAccount(t, ctx, am, "coAuthor1")
+	coAuthor2 := createTestAccount(t, ctx, am, "coAuthor2")
+	user := createTestAccount(t, ctx, am, "user")
+	err = am.AddSavingCoin(
+		ctx, user, types.NewCoinFromInt64(100*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(postParam.PostIntervalSec, 0)})
+	msg := CreatePostMsg{
+		PostID:                  "coAuthored",
+		Title:                   "title",
+		Content:                 "content",
+		Author:                  author,
+		RedistributionSplitRate: "0",
+		CoAuthors: []CoAuthorShare{
+			{Username: author, Share: "0.5"},
+			{Username: coAuthor1, Share: "0.3"},
+			{Username: "nonexist", Share: "0.2"},
+		},
+	}
+	result := handler(ctx, msg)
+	assert.Equal(t, ErrAccountNotFound("nonexist").Result(), result)
+
+	msg.CoAuthors[2].Username = coAuthor2
+	result = handler(ctx, msg)
+	assert.Equal(t, postResult(types.ActionCreatePost, author, author, "coAuthored"), result)
+	permlink := types.GetPermlink(author, "coAuthored")
+	coAuthors, err := pm.GetPostCoAuthors(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(coAuthors.CoAuthors))
+	assert.True(t, coAuthors.CoAuthors[0].Accepted)
+	assert.False(t, coAuthors.CoAuthors[1].Accepted)
+	assert.True(t, coAuthors.CoAuthors[1].Share.Equal(sdk.NewRat(3, 10)))
+
+	testCases := []struct {
+		testName     string
+		msg          AcceptCoAuthorMsg
+		expectResult sdk.Result
+	}{
+		{
+			testName:     "post doesn't exist",
+			msg:          NewAcceptCoAuthorMsg("coAuthor1", "author", "nonexist"),
+			expectResult: ErrPostNotFound(types.GetPermlink(author, "nonexist")).Result(),
+		},
+		{
+			testName:     "user is not co-author",
+			msg:          NewAcceptCoAuthorMsg("user", "author", "coAuthored"),
+			expectResult: ErrNotCoAuthor(permlink, user).Result(),
+		},
+		{
+			testName:     "author accepts own post",
+			msg:          NewAcceptCoAuthorMsg("author", "author", "coAuthored"),
+			expectResult: ErrCoAuthorAlreadyAccepted(permlink, author).Result(),
+		},
+		{
+			testName:     "co-author accepts post",
+			msg:          NewAcceptCoAuthorMsg("coAuthor1", "author", "coAuthored"),
+			expectResult: acceptCoAuthorResult(coAuthor1, author, "coAuthored"),
+		},
+		{
+			testName:     "co-author accepts post twice",
+			msg:          NewAcceptCoAuthorMsg("coAuthor1", "author", "coAuthored"),
+			expectResult: ErrCoAuthorAlreadyAccepted(permlink, coAuthor1).Result(),
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
+
+	// direct deposit is split by shares, share of co-author who hasn't accepted goes to author
+	result = handler(ctx, NewDonateMsg("user", "10", "author", "coAuthored", "", ""))
+	assert.Equal(t, donateResult(user, author, "10", "coAuthored"), result)
+	authorSaving, err := am.GetSavingFromBank(ctx, author)
+	assert.Nil(t, err)
+	assert.True(t, authorSaving.IsEqual(accParam.RegisterFee.Plus(types.NewCoinFromInt64(665*types.Decimals/100))))
+	coAuthor1Saving, err := am.GetSavingFromBank(ctx, coAuthor1)
+	assert.Nil(t, err)
+	assert.True(t, coAuthor1Saving.IsEqual(accParam.RegisterFee.Plus(types.NewCoinFromInt64(285*types.Decimals/100))))
+	coAuthor2Saving, err := am.GetSavingFromBank(ctx, coAuthor2)
+	assert.Nil(t, err)
+	assert.True(t, coAuthor2Saving.IsEqual(accParam.RegisterFee))
+}
+
+func acceptCoAuthorResult(user, author types.AccountKey, postID string) sdk.Result {
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionAcceptCoAuthor),
+			types.TagSender, []byte(user),
+			types.TagAuthor, []byte(author),
+			types.TagPermlink, []byte(types.GetPermlink(author, postID)),
+		),
+	}
+}
+
+func donateResult(donator, author types.AccountKey, amount types.LNO, postID string) sdk.Result {
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionDonate),
+			types.TagSender, []byte(donator),
+			types.TagReceiver, []byte(author),
+			types.TagAmount, []byte(amount),
+			types.TagAuthor, []byte(author),
+			types.TagPermlink, []byte(types.GetPermlink(author, postID)),
+		),
+	}
+}
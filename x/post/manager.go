@@ -1,6 +1,9 @@
 package post
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/post/model"
@@ -83,17 +86,19 @@ func (pm PostManager) CreatePost(
 	sourceAuthor types.AccountKey, sourcePostID string,
 	parentAuthor types.AccountKey, parentPostID string,
 	content string, title string, redistributionSplitRate sdk.Rat,
-	links []types.IDToURLMapping) sdk.Error {
+	links []types.IDToURLMapping, tags []string, contentReference *types.ContentReference) sdk.Error {
 	postInfo := &model.PostInfo{
-		PostID:       postID,
-		Title:        title,
-		Content:      content,
-		Author:       author,
-		ParentAuthor: parentAuthor,
-		ParentPostID: parentPostID,
-		SourceAuthor: sourceAuthor,
-		SourcePostID: sourcePostID,
-		Links:        links,
+		PostID:           postID,
+		Title:            title,
+		Content:          content,
+		Author:           author,
+		ParentAuthor:     parentAuthor,
+		ParentPostID:     parentPostID,
+		SourceAuthor:     sourceAuthor,
+		SourcePostID:     sourcePostID,
+		Links:            links,
+		Tags:             tags,
+		ContentReference: contentReference,
 	}
 	permlink := types.GetPermlink(postInfo.Author, postInfo.PostID)
 	if pm.DoesPostExist(ctx, permlink) {
@@ -116,26 +121,57 @@ func (pm PostManager) CreatePost(
 	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
 		return err
 	}
+	for _, tag := range tags {
+		if err := pm.addTaggedPost(ctx, tag, postInfo, postMeta); err != nil {
+			return err
+		}
+	}
+	if contentReference != nil {
+		pm.postStorage.SetContentReferencePost(ctx, contentReference.Hash, permlink)
+	}
 	return nil
 }
 
-// UpdatePost - update post title, content and links. Can't update a deleted post
+// UpdatePost - update post title, content, links and tags. Can't update a deleted post
 func (pm PostManager) UpdatePost(
 	ctx sdk.Context, author types.AccountKey, postID, title, content string,
-	links []types.IDToURLMapping) sdk.Error {
+	links []types.IDToURLMapping, tags []string) sdk.Error {
 	permlink := types.GetPermlink(author, postID)
 	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
 	if err != nil {
 		return err
 	}
+	// content of post stored off chain can't be replaced by inline content
+	if postInfo.ContentReference != nil && len(content) > 0 {
+		return ErrInvalidContentReference("inline content must be empty")
+	}
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
 	if err != nil {
 		return err
 	}
 
+	if err := pm.archivePostVersion(ctx, permlink, postInfo, postMeta); err != nil {
+		return err
+	}
+
+	// tag index keeps the created time of the post, only changed tags are updated
+	for _, tag := range postInfo.Tags {
+		if !containsTag(tags, tag) {
+			pm.postStorage.RemoveTaggedPost(ctx, tag, postMeta.CreatedAt, permlink)
+		}
+	}
+	for _, tag := range tags {
+		if !containsTag(postInfo.Tags, tag) {
+			if err := pm.addTaggedPost(ctx, tag, postInfo, postMeta); err != nil {
+				return err
+			}
+		}
+	}
+
 	postInfo.Title = title
 	postInfo.Content = content
 	postInfo.Links = links
+	postInfo.Tags = tags
 	// postMeta.RedistributionSplitRate = redistributionSplitRate
 	postMeta.LastUpdatedAt = ctx.BlockHeader().Time.Unix()
 
@@ -236,9 +272,30 @@ func (pm PostManager) DeletePost(ctx sdk.Context, permlink types.Permlink) sdk.E
 	if err != nil {
 		return err
 	}
+	for _, tag := range postInfo.Tags {
+		pm.postStorage.RemoveTaggedPost(ctx, tag, postMeta.CreatedAt, permlink)
+	}
+	if postInfo.ContentReference != nil {
+		pm.postStorage.RemoveContentReferencePost(ctx, postInfo.ContentReference.Hash, permlink)
+	}
+	// deleted post can't be unlocked anymore, previous unlocks are kept
+	if paywall, err := pm.postStorage.GetPostPaywall(ctx, permlink); err == nil {
+		pm.postStorage.RemoveContentReferencePost(ctx, paywall.EncryptedContentReference.Hash, permlink)
+		pm.postStorage.RemovePostPaywall(ctx, permlink)
+	}
+	// previous versions are removed as well, otherwise deleted content is still queryable
+	versions, err := pm.postStorage.GetPostVersions(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		pm.postStorage.RemovePostVersion(ctx, permlink, version.Version)
+	}
 	postInfo.Title = ""
 	postInfo.Content = ""
 	postInfo.Links = nil
+	postInfo.Tags = nil
+	postInfo.ContentReference = nil
 
 	if err := pm.postStorage.SetPostInfo(ctx, postInfo); err != nil {
 		return err
@@ -246,6 +303,231 @@ func (pm PostManager) DeletePost(ctx sdk.Context, permlink types.Permlink) sdk.E
 	return nil
 }
 
+// archivePostVersion - archive current post info before it is updated, the oldest
+// versions are removed if more than MaxNumOfPostVersions versions are retained
+func (pm PostManager) archivePostVersion(
+	ctx sdk.Context, permlink types.Permlink, postInfo *model.PostInfo, postMeta *model.PostMeta) sdk.Error {
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return err
+	}
+	versions, err := pm.postStorage.GetPostVersions(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if postParam.MaxNumOfPostVersions > 0 {
+		nextVersion := int64(1)
+		if len(versions) > 0 {
+			nextVersion = versions[len(versions)-1].Version + 1
+		}
+		contentHash := sha256.Sum256([]byte(postInfo.Content))
+		version := model.PostVersion{
+			Version:     nextVersion,
+			Info:        *postInfo,
+			ContentHash: hex.EncodeToString(contentHash[:]),
+			UpdatedAt:   postMeta.LastUpdatedAt,
+			ArchivedAt:  ctx.BlockHeader().Time.Unix(),
+		}
+		if err := pm.postStorage.SetPostVersion(ctx, permlink, &version); err != nil {
+			return err
+		}
+		versions = append(versions, version)
+	}
+	for int64(len(versions)) > postParam.MaxNumOfPostVersions {
+		pm.postStorage.RemovePostVersion(ctx, permlink, versions[0].Version)
+		versions = versions[1:]
+	}
+	return nil
+}
+
+// GetPostVersions - get all retained previous versions of a post ordered by version
+func (pm PostManager) GetPostVersions(
+	ctx sdk.Context, permlink types.Permlink) ([]model.PostVersion, sdk.Error) {
+	return pm.postStorage.GetPostVersions(ctx, permlink)
+}
+
+// GetPostVersion - get a previous version of a post
+func (pm PostManager) GetPostVersion(
+	ctx sdk.Context, permlink types.Permlink, version int64) (*model.PostVersion, sdk.Error) {
+	return pm.postStorage.GetPostVersion(ctx, permlink, version)
+}
+
+// GetPostsByTag - get a page of posts under tag, newest first
+func (pm PostManager) GetPostsByTag(
+	ctx sdk.Context, tag string, offset, limit int64) (*model.TaggedPostPage, sdk.Error) {
+	if offset < 0 || limit <= 0 || limit > types.MaximumPostsByTagPageSize {
+		return nil, ErrInvalidPostsByTagPage(offset, limit)
+	}
+	return pm.postStorage.GetTaggedPosts(ctx, tag, offset, limit)
+}
+
+// SetPostPaywall - make post paid, users have to pay the price to access its encrypted content
+func (pm PostManager) SetPostPaywall(
+	ctx sdk.Context, permlink types.Permlink, price types.Coin,
+	encryptedContentReference types.ContentReference) sdk.Error {
+	paywall := &model.PostPaywall{
+		Price:                     price,
+		EncryptedContentReference: encryptedContentReference,
+	}
+	if err := pm.postStorage.SetPostPaywall(ctx, permlink, paywall); err != nil {
+		return err
+	}
+	pm.postStorage.SetContentReferencePost(ctx, encryptedContentReference.Hash, permlink)
+	return nil
+}
+
+// GetPostPaywall - get price and encrypted content reference of paid post
+func (pm PostManager) GetPostPaywall(
+	ctx sdk.Context, permlink types.Permlink) (*model.PostPaywall, sdk.Error) {
+	return pm.postStorage.GetPostPaywall(ctx, permlink)
+}
+
+// IsPostUnlocked - check if user paid to unlock the post
+func (pm PostManager) IsPostUnlocked(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey) bool {
+	return pm.postStorage.DoesPostUnlockExist(ctx, permlink, user)
+}
+
+// GetPostUnlock - get unlock record of user to paid post
+func (pm PostManager) GetPostUnlock(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey) (*model.PostUnlock, sdk.Error) {
+	return pm.postStorage.GetPostUnlock(ctx, permlink, user)
+}
+
+// AddPostUnlock - record user paid the price to unlock the post
+func (pm PostManager) AddPostUnlock(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey, price types.Coin) sdk.Error {
+	unlock := &model.PostUnlock{
+		Username:   user,
+		Price:      price,
+		UnlockedAt: ctx.BlockHeader().Time.Unix(),
+	}
+	return pm.postStorage.SetPostUnlock(ctx, permlink, unlock)
+}
+
+// SetPostCoAuthors - set co-authors and their revenue shares of post,
+// the author accepts its own share when the post is created
+func (pm PostManager) SetPostCoAuthors(
+	ctx sdk.Context, permlink types.Permlink, author types.AccountKey,
+	usernames []types.AccountKey, shares []sdk.Rat) sdk.Error {
+	coAuthors := &model.PostCoAuthors{}
+	for i, username := range usernames {
+		coAuthors.CoAuthors = append(coAuthors.CoAuthors, model.CoAuthor{
+			Username: username,
+			Share:    shares[i],
+			Accepted: username == author,
+		})
+	}
+	return pm.postStorage.SetPostCoAuthors(ctx, permlink, coAuthors)
+}
+
+// GetPostCoAuthors - get co-authors and their revenue shares of post
+func (pm PostManager) GetPostCoAuthors(
+	ctx sdk.Context, permlink types.Permlink) (*model.PostCoAuthors, sdk.Error) {
+	return pm.postStorage.GetPostCoAuthors(ctx, permlink)
+}
+
+// AcceptCoAuthor - co-author accepts its share of post revenue
+func (pm PostManager) AcceptCoAuthor(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey) sdk.Error {
+	coAuthors, err := pm.postStorage.GetPostCoAuthors(ctx, permlink)
+	if err != nil {
+		return ErrNotCoAuthor(permlink, user)
+	}
+	for i, coAuthor := range coAuthors.CoAuthors {
+		if coAuthor.Username != user {
+			continue
+		}
+		if coAuthor.Accepted {
+			return ErrCoAuthorAlreadyAccepted(permlink, user)
+		}
+		coAuthors.CoAuthors[i].Accepted = true
+		return pm.postStorage.SetPostCoAuthors(ctx, permlink, coAuthors)
+	}
+	return ErrNotCoAuthor(permlink, user)
+}
+
+// IsCoAuthor - check if user is the author or a co-author of post,
+// co-author who hasn't accepted yet is included
+func (pm PostManager) IsCoAuthor(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey) (bool, sdk.Error) {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return false, err
+	}
+	if postInfo.Author == user {
+		return true, nil
+	}
+	if !pm.postStorage.DoesPostCoAuthorsExist(ctx, permlink) {
+		return false, nil
+	}
+	coAuthors, err := pm.postStorage.GetPostCoAuthors(ctx, permlink)
+	if err != nil {
+		return false, err
+	}
+	for _, coAuthor := range coAuthors.CoAuthors {
+		if coAuthor.Username == user {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetRevenueShares - get users receiving revenue of post and their shares. The author
+// comes first and receives shares of co-authors who haven't accepted yet
+func (pm PostManager) GetRevenueShares(
+	ctx sdk.Context, permlink types.Permlink) ([]model.RevenueShare, sdk.Error) {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	shares := []model.RevenueShare{{Username: postInfo.Author, Share: sdk.OneRat()}}
+	if !pm.postStorage.DoesPostCoAuthorsExist(ctx, permlink) {
+		return shares, nil
+	}
+	coAuthors, err := pm.postStorage.GetPostCoAuthors(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	for _, coAuthor := range coAuthors.CoAuthors {
+		if coAuthor.Username == postInfo.Author || !coAuthor.Accepted {
+			continue
+		}
+		shares[0].Share = shares[0].Share.Sub(coAuthor.Share)
+		shares = append(shares, model.RevenueShare{Username: coAuthor.Username, Share: coAuthor.Share})
+	}
+	return shares, nil
+}
+
+// GetPostsByContentHash - get all posts referencing off-chain content with given hash
+func (pm PostManager) GetPostsByContentHash(ctx sdk.Context, hash string) []types.Permlink {
+	return pm.postStorage.GetContentReferencePosts(ctx, hash)
+}
+
+// DoesContentHashExist - check if any post references off-chain content with given hash
+func (pm PostManager) DoesContentHashExist(ctx sdk.Context, hash string) bool {
+	return len(pm.postStorage.GetContentReferencePosts(ctx, hash)) > 0
+}
+
+func (pm PostManager) addTaggedPost(
+	ctx sdk.Context, tag string, postInfo *model.PostInfo, postMeta *model.PostMeta) sdk.Error {
+	taggedPost := &model.TaggedPost{
+		Author:    postInfo.Author,
+		PostID:    postInfo.PostID,
+		CreatedAt: postMeta.CreatedAt,
+	}
+	return pm.postStorage.SetTaggedPost(ctx, tag, taggedPost)
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
 // IsDeleted - check if a post is deleted or not
 func (pm PostManager) IsDeleted(ctx sdk.Context, permlink types.Permlink) (bool, sdk.Error) {
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
@@ -291,3 +573,67 @@ func (pm PostManager) GetPenaltyScore(ctx sdk.Context, reputation types.Coin) (s
 	}
 	return penaltyScore, nil
 }
+
+// AddPendingReward - add reward registered by donation to pending reward index,
+// returns the id assigned to the pending reward
+func (pm PostManager) AddPendingReward(ctx sdk.Context, reward model.PendingReward) (int64, sdk.Error) {
+	nextID, err := pm.postStorage.GetNextPendingRewardID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	reward.ID = nextID.NextPendingRewardID
+	nextID.NextPendingRewardID++
+	if err := pm.postStorage.SetNextPendingRewardID(ctx, nextID); err != nil {
+		return 0, err
+	}
+	if err := pm.postStorage.SetPendingReward(
+		ctx, types.GetPermlink(reward.Author, reward.PostID), &reward); err != nil {
+		return 0, err
+	}
+	return reward.ID, nil
+}
+
+// RemovePendingReward - remove pending reward of the post by its scheduled time and id
+func (pm PostManager) RemovePendingReward(
+	ctx sdk.Context, permlink types.Permlink, scheduledAt, id int64) {
+	pm.postStorage.RemovePendingReward(ctx, permlink, scheduledAt, id)
+}
+
+// GetPendingRewardsOfPost - get all pending rewards of a post ordered by scheduled time
+func (pm PostManager) GetPendingRewardsOfPost(
+	ctx sdk.Context, permlink types.Permlink) ([]model.PendingReward, sdk.Error) {
+	return pm.postStorage.GetPendingRewardsOfPost(ctx, permlink)
+}
+
+// GetPendingRewardsOfAuthor - get all pending rewards of posts created by author
+func (pm PostManager) GetPendingRewardsOfAuthor(
+	ctx sdk.Context, author types.AccountKey) ([]model.PendingReward, sdk.Error) {
+	return pm.postStorage.GetPendingRewardsOfAuthor(ctx, author)
+}
+
+// Export - export post KVStore for genesis
+func (pm PostManager) Export(ctx sdk.Context) (*model.PostTables, sdk.Error) {
+	return pm.postStorage.Export(ctx)
+}
+
+// Import - import post KVStore from exported genesis
+func (pm PostManager) Import(ctx sdk.Context, tables *model.PostTables) sdk.Error {
+	return pm.postStorage.Import(ctx, tables)
+}
+
+// splitByRevenueShares - split coin by revenue shares, the first share
+// receives the remainder so that the parts always sum to the coin
+func splitByRevenueShares(coin types.Coin, shares []model.RevenueShare) []types.Coin {
+	parts := make([]types.Coin, len(shares))
+	remain := coin
+	for i := 1; i < len(shares); i++ {
+		parts[i] = types.RatToCoin(coin.ToRat().Mul(shares[i].Share))
+		// rounding up several small parts can't take more than what is left
+		if parts[i].IsGT(remain) {
+			parts[i] = remain
+		}
+		remain = remain.Minus(parts[i])
+	}
+	parts[0] = remain
+	return parts
+}
@@ -1,10 +1,13 @@
 package post
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"math/big"
 	"testing"
 	"time"
 
+	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/post/model"
 	"github.com/stretchr/testify/assert"
@@ -99,7 +102,7 @@ func TestCreatePost(t *testing.T) {
 		err := pm.CreatePost(
 			ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 			msg.ParentAuthor, msg.ParentPostID, msg.Content,
-			msg.Title, sdk.ZeroRat(), msg.Links)
+			msg.Title, sdk.ZeroRat(), msg.Links, msg.Tags, msg.ContentReference)
 		if !assert.Equal(t, err, tc.expectResult) {
 			t.Errorf("%s: diff result, got %v, want %v", tc.testName, err, tc.expectResult)
 		}
@@ -149,7 +152,7 @@ func TestUpdatePost(t *testing.T) {
 			testName: "normal update",
 			msg: NewUpdatePostMsg(
 				string(user), postID, "update to this title", "update to this content",
-				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}),
+				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}, nil),
 			expectErr:  nil,
 			updateTime: baseTime + 10,
 		},
@@ -157,7 +160,7 @@ func TestUpdatePost(t *testing.T) {
 			testName: "update with invalid post id",
 			msg: NewUpdatePostMsg(
 				"invalid", postID, "update to this title", "update to this content",
-				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}),
+				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}, nil),
 			expectErr:  model.ErrPostNotFound(model.GetPostInfoKey(types.GetPermlink("invalid", postID))),
 			updateTime: baseTime + 100,
 		},
@@ -165,7 +168,7 @@ func TestUpdatePost(t *testing.T) {
 			testName: "update with invalid author",
 			msg: NewUpdatePostMsg(
 				string(user), "invalid", "update to this title", "update to this content",
-				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}),
+				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}, nil),
 			expectErr:  model.ErrPostNotFound(model.GetPostInfoKey(types.GetPermlink(user, "invalid"))),
 			updateTime: baseTime + 1000,
 		},
@@ -175,7 +178,7 @@ func TestUpdatePost(t *testing.T) {
 		ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(tc.updateTime, 0)})
 
 		err := pm.UpdatePost(
-			ctx, tc.msg.Author, tc.msg.PostID, tc.msg.Title, tc.msg.Content, tc.msg.Links)
+			ctx, tc.msg.Author, tc.msg.PostID, tc.msg.Title, tc.msg.Content, tc.msg.Links, tc.msg.Tags)
 		if !assert.Equal(t, err, tc.expectErr) {
 			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
 		}
@@ -279,7 +282,7 @@ func TestGetSourcePost(t *testing.T) {
 		err := pm.CreatePost(
 			ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 			msg.ParentAuthor, msg.ParentPostID, msg.Content,
-			msg.Title, sdk.ZeroRat(), msg.Links)
+			msg.Title, sdk.ZeroRat(), msg.Links, msg.Tags, msg.ContentReference)
 		if err != nil {
 			t.Errorf("%s: failed to create post, got err %v", tc.testName, err)
 		}
@@ -567,3 +570,191 @@ func TestDeletePost(t *testing.T) {
 	assert.Nil(t, err)
 	checkIsDelete(t, ctx, pm, types.GetPermlink(user, postID))
 }
+
+func TestPostTagIndex(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	user1 := createTestAccount(t, ctx, am, "user1")
+	user2 := createTestAccount(t, ctx, am, "user2")
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(100, 0)})
+	err := pm.CreatePost(
+		ctx, user1, "post1", "", "", "", "", "content", "title",
+		sdk.ZeroRat(), nil, []string{"music", "rock"}, nil)
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(200, 0)})
+	err = pm.CreatePost(
+		ctx, user2, "post2", "", "", "", "", "content", "title",
+		sdk.ZeroRat(), nil, []string{"music"}, nil)
+	assert.Nil(t, err)
+
+	post1 := model.TaggedPost{Author: user1, PostID: "post1", CreatedAt: 100}
+	post2 := model.TaggedPost{Author: user2, PostID: "post2", CreatedAt: 200}
+	checkTag := func(testName, tag string, expectPosts []model.TaggedPost) {
+		page, err := pm.GetPostsByTag(ctx, tag, 0, types.MaximumPostsByTagPageSize)
+		assert.Nil(t, err)
+		if !assert.Equal(t, expectPosts, page.Posts) {
+			t.Errorf("%s: diff posts under tag %s, got %v, want %v", testName, tag, page.Posts, expectPosts)
+		}
+	}
+	checkTag("create post", "music", []model.TaggedPost{post2, post1})
+	checkTag("create post", "rock", []model.TaggedPost{post1})
+
+	// updated post keeps its position under unchanged tag
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(300, 0)})
+	err = pm.UpdatePost(ctx, user1, "post1", "title", "content", nil, []string{"music", "jazz"})
+	assert.Nil(t, err)
+	checkTag("update post", "music", []model.TaggedPost{post2, post1})
+	checkTag("update post", "rock", []model.TaggedPost{})
+	checkTag("update post", "jazz", []model.TaggedPost{post1})
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, types.GetPermlink(user1, "post1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"music", "jazz"}, postInfo.Tags)
+
+	err = pm.DeletePost(ctx, types.GetPermlink(user1, "post1"))
+	assert.Nil(t, err)
+	checkTag("delete post", "music", []model.TaggedPost{post2})
+	checkTag("delete post", "jazz", []model.TaggedPost{})
+
+	_, err = pm.GetPostsByTag(ctx, "music", -1, 10)
+	assert.Equal(t, ErrInvalidPostsByTagPage(-1, 10), err)
+	_, err = pm.GetPostsByTag(ctx, "music", 0, types.MaximumPostsByTagPageSize+1)
+	assert.Equal(t, ErrInvalidPostsByTagPage(0, types.MaximumPostsByTagPageSize+1), err)
+}
+
+func TestPostVersions(t *testing.T) {
+	ctx, am, ph, pm, _, _, _, _ := setupTest(t, 1)
+	user := createTestAccount(t, ctx, am, "user")
+	permlink := types.GetPermlink(user, "postID")
+
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+	postParam.MaxNumOfPostVersions = 2
+	err = param.ChangeParamEvent{Param: *postParam}.Execute(ctx, ph)
+	assert.Nil(t, err)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(100, 0)})
+	err = pm.CreatePost(
+		ctx, user, "postID", "", "", "", "", "content v1", "title v1",
+		sdk.ZeroRat(), nil, []string{"tag"}, nil)
+	assert.Nil(t, err)
+	versions, err := pm.GetPostVersions(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(versions))
+
+	infoV1, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	assert.Nil(t, err)
+	hashV1 := sha256.Sum256([]byte("content v1"))
+	expectV1 := model.PostVersion{
+		Version:     1,
+		Info:        *infoV1,
+		ContentHash: hex.EncodeToString(hashV1[:]),
+		UpdatedAt:   100,
+		ArchivedAt:  200,
+	}
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(200, 0)})
+	err = pm.UpdatePost(ctx, user, "postID", "title v2", "content v2", nil, nil)
+	assert.Nil(t, err)
+	versions, err = pm.GetPostVersions(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.PostVersion{expectV1}, versions)
+
+	infoV2, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	assert.Nil(t, err)
+	hashV2 := sha256.Sum256([]byte("content v2"))
+	expectV2 := model.PostVersion{
+		Version:     2,
+		Info:        *infoV2,
+		ContentHash: hex.EncodeToString(hashV2[:]),
+		UpdatedAt:   200,
+		ArchivedAt:  300,
+	}
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(300, 0)})
+	err = pm.UpdatePost(ctx, user, "postID", "title v3", "content v3", nil, nil)
+	assert.Nil(t, err)
+
+	// oldest version is removed once the limit is exceeded
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(400, 0)})
+	err = pm.UpdatePost(ctx, user, "postID", "title v4", "content v4", nil, nil)
+	assert.Nil(t, err)
+	versions, err = pm.GetPostVersions(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(versions))
+	assert.Equal(t, expectV2, versions[0])
+	assert.Equal(t, int64(3), versions[1].Version)
+	assert.Equal(t, "content v3", versions[1].Info.Content)
+
+	version, err := pm.GetPostVersion(ctx, permlink, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, expectV2, *version)
+	_, err = pm.GetPostVersion(ctx, permlink, 1)
+	assert.Equal(t, types.CodePostVersionNotFound, err.Code())
+
+	// lowering the limit prunes versions on next update
+	postParam.MaxNumOfPostVersions = 0
+	err = param.ChangeParamEvent{Param: *postParam}.Execute(ctx, ph)
+	assert.Nil(t, err)
+	err = pm.UpdatePost(ctx, user, "postID", "title v5", "content v5", nil, nil)
+	assert.Nil(t, err)
+	versions, err = pm.GetPostVersions(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(versions))
+
+	// deleted post doesn't keep previous versions
+	postParam.MaxNumOfPostVersions = 2
+	err = param.ChangeParamEvent{Param: *postParam}.Execute(ctx, ph)
+	assert.Nil(t, err)
+	err = pm.UpdatePost(ctx, user, "postID", "title v6", "content v6", nil, nil)
+	assert.Nil(t, err)
+	err = pm.DeletePost(ctx, permlink)
+	assert.Nil(t, err)
+	versions, err = pm.GetPostVersions(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(versions))
+}
+
+func TestPostContentReference(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	user1 := createTestAccount(t, ctx, am, "user1")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	ref := &types.ContentReference{
+		Hash:     testContentHash,
+		Size:     1 << 20,
+		MIMEType: "text/markdown",
+		URI:      "ipfs://QmTestContent",
+	}
+	permlink1 := types.GetPermlink(user1, "post1")
+	permlink2 := types.GetPermlink(user2, "post2")
+
+	assert.False(t, pm.DoesContentHashExist(ctx, testContentHash))
+	err := pm.CreatePost(
+		ctx, user1, "post1", "", "", "", "", "", "title", sdk.ZeroRat(), nil, nil, ref)
+	assert.Nil(t, err)
+	err = pm.CreatePost(
+		ctx, user2, "post2", "", "", "", "", "", "title", sdk.ZeroRat(), nil, nil, ref)
+	assert.Nil(t, err)
+	assert.True(t, pm.DoesContentHashExist(ctx, testContentHash))
+	assert.Equal(t, []types.Permlink{permlink1, permlink2}, pm.GetPostsByContentHash(ctx, testContentHash))
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink1)
+	assert.Nil(t, err)
+	assert.Equal(t, ref, postInfo.ContentReference)
+
+	err = pm.UpdatePost(ctx, user1, "post1", "new title", "content", nil, nil)
+	assert.Equal(t, ErrInvalidContentReference("inline content must be empty"), err)
+	// content reference is kept when post is updated
+	err = pm.UpdatePost(ctx, user1, "post1", "new title", "", nil, nil)
+	assert.Nil(t, err)
+	postInfo, err = pm.postStorage.GetPostInfo(ctx, permlink1)
+	assert.Nil(t, err)
+	assert.Equal(t, ref, postInfo.ContentReference)
+
+	err = pm.DeletePost(ctx, permlink1)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Permlink{permlink2}, pm.GetPostsByContentHash(ctx, testContentHash))
+	postInfo, err = pm.postStorage.GetPostInfo(ctx, permlink1)
+	assert.Nil(t, err)
+	assert.Nil(t, postInfo.ContentReference)
+
+	err = pm.DeletePost(ctx, permlink2)
+	assert.Nil(t, err)
+	assert.False(t, pm.DoesContentHashExist(ctx, testContentHash))
+}
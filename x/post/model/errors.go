@@ -13,6 +13,26 @@ func ErrPostNotFound(key []byte) sdk.Error {
 	return types.NewError(types.CodePostNotFound, fmt.Sprintf("post is not found for key: %s", key))
 }
 
+// ErrPostVersionNotFound - error if post version is not found in KVStore
+func ErrPostVersionNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodePostVersionNotFound, fmt.Sprintf("post version is not found for key: %s", key))
+}
+
+// ErrPostPaywallNotFound - error if post paywall is not found in KVStore
+func ErrPostPaywallNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodePostPaywallNotFound, fmt.Sprintf("post paywall is not found for key: %s", key))
+}
+
+// ErrPostUnlockNotFound - error if post unlock is not found in KVStore
+func ErrPostUnlockNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodePostUnlockNotFound, fmt.Sprintf("post unlock is not found for key: %s", key))
+}
+
+// ErrPostCoAuthorsNotFound - error if post co-authors is not found in KVStore
+func ErrPostCoAuthorsNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodePostCoAuthorsNotFound, fmt.Sprintf("post co-authors is not found for key: %s", key))
+}
+
 // ErrPostMetaNotFound - error if post meta is not found in KVStore
 func ErrPostMetaNotFound(key []byte) sdk.Error {
 	return types.NewError(types.CodePostMetaNotFound, fmt.Sprintf("post meta is not found for key: %s", key))
@@ -68,6 +88,36 @@ func ErrFailedToMarshalPostDonations(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalPostDonations, fmt.Sprintf("failed to marshal post donations: %s", err.Error()))
 }
 
+// ErrFailedToMarshalPendingReward - error if marshal pending reward failed
+func ErrFailedToMarshalPendingReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPendingReward, fmt.Sprintf("failed to marshal pending reward: %s", err.Error()))
+}
+
+// ErrFailedToMarshalPostVersion - error if marshal post version failed
+func ErrFailedToMarshalPostVersion(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPostVersion, fmt.Sprintf("failed to marshal post version: %s", err.Error()))
+}
+
+// ErrFailedToMarshalPostPaywall - error if marshal post paywall failed
+func ErrFailedToMarshalPostPaywall(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPostPaywall, fmt.Sprintf("failed to marshal post paywall: %s", err.Error()))
+}
+
+// ErrFailedToMarshalPostUnlock - error if marshal post unlock failed
+func ErrFailedToMarshalPostUnlock(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPostUnlock, fmt.Sprintf("failed to marshal post unlock: %s", err.Error()))
+}
+
+// ErrFailedToMarshalPostCoAuthors - error if marshal post co-authors failed
+func ErrFailedToMarshalPostCoAuthors(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPostCoAuthors, fmt.Sprintf("failed to marshal post co-authors: %s", err.Error()))
+}
+
+// ErrFailedToMarshalTaggedPost - error if marshal tagged post failed
+func ErrFailedToMarshalTaggedPost(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalTaggedPost, fmt.Sprintf("failed to marshal tagged post: %s", err.Error()))
+}
+
 // ErrFailedToUnmarshalPostInfo - error if unmarshal post info failed
 func ErrFailedToUnmarshalPostInfo(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalPostInfo, fmt.Sprintf("failed to unmarshal post info: %s", err.Error()))
@@ -97,3 +147,33 @@ func ErrFailedToUnmarshalPostView(err error) sdk.Error {
 func ErrFailedToUnmarshalPostDonations(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalPostDonations, fmt.Sprintf("failed to unmarshal post donations: %s", err.Error()))
 }
+
+// ErrFailedToUnmarshalPendingReward - error if unmarshal pending reward failed
+func ErrFailedToUnmarshalPendingReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPendingReward, fmt.Sprintf("failed to unmarshal pending reward: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalPostVersion - error if unmarshal post version failed
+func ErrFailedToUnmarshalPostVersion(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPostVersion, fmt.Sprintf("failed to unmarshal post version: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalPostPaywall - error if unmarshal post paywall failed
+func ErrFailedToUnmarshalPostPaywall(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPostPaywall, fmt.Sprintf("failed to unmarshal post paywall: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalPostUnlock - error if unmarshal post unlock failed
+func ErrFailedToUnmarshalPostUnlock(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPostUnlock, fmt.Sprintf("failed to unmarshal post unlock: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalPostCoAuthors - error if unmarshal post co-authors failed
+func ErrFailedToUnmarshalPostCoAuthors(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPostCoAuthors, fmt.Sprintf("failed to unmarshal post co-authors: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalTaggedPost - error if unmarshal tagged post failed
+func ErrFailedToUnmarshalTaggedPost(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalTaggedPost, fmt.Sprintf("failed to unmarshal tagged post: %s", err.Error()))
+}
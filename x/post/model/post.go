@@ -23,6 +23,52 @@ type PostInfo struct {
 	SourceAuthor types.AccountKey       `json:"source_author"`
 	SourcePostID string                 `json:"source_postID"`
 	Links        []types.IDToURLMapping `json:"links"`
+	Tags         []string               `json:"tags"`
+	// ContentReference - set when content is stored off chain, Content is empty in that case
+	ContentReference *types.ContentReference `json:"content_reference"`
+}
+
+// PostVersion - previous post info archived when the post is updated. UpdatedAt is
+// the time this version was published, ArchivedAt is the time it was replaced.
+// ContentHash is hex encoded sha256 of the content
+type PostVersion struct {
+	Version     int64    `json:"version"`
+	Info        PostInfo `json:"info"`
+	ContentHash string   `json:"content_hash"`
+	UpdatedAt   int64    `json:"updated_at"`
+	ArchivedAt  int64    `json:"archived_at"`
+}
+
+// PostPaywall - price and encrypted off-chain content of a paid post
+type PostPaywall struct {
+	Price                     types.Coin             `json:"price"`
+	EncryptedContentReference types.ContentReference `json:"encrypted_content_reference"`
+}
+
+// PostUnlock - a user paid the price to access a paid post
+type PostUnlock struct {
+	Username   types.AccountKey `json:"username"`
+	Price      types.Coin       `json:"price"`
+	UnlockedAt int64            `json:"unlocked_at"`
+}
+
+// CoAuthor - co-author of a post and its share of post revenue. Share of co-author
+// who hasn't accepted yet goes to the author
+type CoAuthor struct {
+	Username types.AccountKey `json:"username"`
+	Share    sdk.Rat          `json:"share"`
+	Accepted bool             `json:"accepted"`
+}
+
+// PostCoAuthors - all co-authors of a post, including the author
+type PostCoAuthors struct {
+	CoAuthors []CoAuthor `json:"co_authors"`
+}
+
+// RevenueShare - share of post revenue received by a user
+type RevenueShare struct {
+	Username types.AccountKey `json:"username"`
+	Share    sdk.Rat          `json:"share"`
 }
 
 // PostMeta - stores tiny and frequently updated fields.
@@ -68,3 +114,86 @@ type Donations struct {
 	Times    int64            `json:"times"`
 	Amount   types.Coin       `json:"amount"`
 }
+
+// DonationEstimate - dry run result of a donation. RedistributionSplitRate is the rate of donation
+// kept by the post if it is a repost, the rest goes to the source post
+type DonationEstimate struct {
+	Username                types.AccountKey      `json:"username"`
+	Amount                  types.Coin            `json:"amount"`
+	RedistributionSplitRate sdk.Rat               `json:"redistribution_split_rate"`
+	Post                    *DonationPartEstimate `json:"post,omitempty"`
+	Source                  *DonationPartEstimate `json:"source,omitempty"`
+}
+
+// DonationPartEstimate - expected friction, direct deposit, evaluate result and inflation
+// reward of the part of a donation received by a post
+type DonationPartEstimate struct {
+	Author          types.AccountKey `json:"author"`
+	PostID          string           `json:"post_id"`
+	Amount          types.Coin       `json:"amount"`
+	Friction        types.Coin       `json:"friction"`
+	DirectDeposit   types.Coin       `json:"direct_deposit"`
+	Evaluate        types.Coin       `json:"evaluate"`
+	EstimatedReward types.Coin       `json:"estimated_reward"`
+}
+
+// PendingReward - content reward registered by a donation which is waiting for execution,
+// it is identified by ID together with ScheduledAt
+type PendingReward struct {
+	ID          int64            `json:"id"`
+	Author      types.AccountKey `json:"author"`
+	PostID      string           `json:"post_id"`
+	Consumer    types.AccountKey `json:"consumer"`
+	Evaluate    types.Coin       `json:"evaluate"`
+	Original    types.Coin       `json:"original"`
+	Friction    types.Coin       `json:"friction"`
+	FromApp     types.AccountKey `json:"from_app"`
+	ScheduledAt int64            `json:"scheduled_at"`
+}
+
+// NextPendingRewardID - id of next pending reward
+type NextPendingRewardID struct {
+	NextPendingRewardID int64 `json:"next_pending_reward_id"`
+}
+
+// PendingRewardStatus - pending reward with current penalty score of the post
+type PendingRewardStatus struct {
+	Reward       PendingReward `json:"reward"`
+	PenaltyScore sdk.Rat       `json:"penalty_score"`
+}
+
+// TaggedPost - post indexed under a tag, ordered by created time of the post
+type TaggedPost struct {
+	Author    types.AccountKey `json:"author"`
+	PostID    string           `json:"post_id"`
+	CreatedAt int64            `json:"created_at"`
+}
+
+// TaggedPostPage - a page of posts under a tag, newest first
+type TaggedPostPage struct {
+	Posts   []TaggedPost `json:"posts"`
+	HasMore bool         `json:"has_more"`
+}
+
+// CommentNode - comment with its post meta and nested replies. NumOfReplies is the number
+// of direct replies, HasMoreReplies is set if some of them are not included in Replies
+type CommentNode struct {
+	Author           types.AccountKey `json:"author"`
+	PostID           string           `json:"post_id"`
+	CreatedAt        int64            `json:"created_at"`
+	IsDeleted        bool             `json:"is_deleted"`
+	TotalDonateCount int64            `json:"total_donate_count"`
+	TotalReward      types.Coin       `json:"total_reward"`
+	NumOfReplies     int64            `json:"num_of_replies"`
+	HasMoreReplies   bool             `json:"has_more_replies"`
+	Replies          []CommentNode    `json:"replies"`
+}
+
+// CommentTree - a page of comments under the root post. NextCursor is the permlink
+// of the last comment in this page, empty if there is no more comment
+type CommentTree struct {
+	Author     types.AccountKey `json:"author"`
+	PostID     string           `json:"post_id"`
+	Comments   []CommentNode    `json:"comments"`
+	NextCursor types.Permlink   `json:"next_cursor"`
+}
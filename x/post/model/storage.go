@@ -1,6 +1,8 @@
 package model
 
 import (
+	"encoding/binary"
+
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/types"
 
@@ -8,12 +10,20 @@ import (
 )
 
 var (
-	postInfoSubStore           = []byte{0x00} // SubStore for all post info
-	postMetaSubStore           = []byte{0x01} // SubStore for all post mata info
-	postReportOrUpvoteSubStore = []byte{0x02} // SubStore for all report or upvote to post
-	postCommentSubStore        = []byte{0x03} // SubStore for all comments
-	postViewsSubStore          = []byte{0x04} // SubStore for all views
-	postDonationsSubStore      = []byte{0x05} // SubStore for all donations
+	postInfoSubStore            = []byte{0x00} // SubStore for all post info
+	postMetaSubStore            = []byte{0x01} // SubStore for all post mata info
+	postReportOrUpvoteSubStore  = []byte{0x02} // SubStore for all report or upvote to post
+	postCommentSubStore         = []byte{0x03} // SubStore for all comments
+	postViewsSubStore           = []byte{0x04} // SubStore for all views
+	postDonationsSubStore       = []byte{0x05} // SubStore for all donations
+	postPendingRewardSubStore   = []byte{0x06} // SubStore for all pending content rewards
+	postTagSubStore             = []byte{0x07} // SubStore for tag to post index
+	postVersionSubStore         = []byte{0x08} // SubStore for previous versions of post info
+	postContentRefSubStore      = []byte{0x09} // SubStore for content hash to post index
+	postPaywallSubStore         = []byte{0x0a} // SubStore for price and encrypted content of paid post
+	postUnlockSubStore          = []byte{0x0b} // SubStore for users unlocked paid post
+	postCoAuthorsSubStore       = []byte{0x0c} // SubStore for co-authors and revenue shares of post
+	postPendingRewardIDSubStore = []byte{0x0d} // SubStore for next pending reward id
 )
 
 // PostStorage - post storage
@@ -68,6 +78,60 @@ func (ps PostStorage) SetPostInfo(ctx sdk.Context, postInfo *PostInfo) sdk.Error
 	return nil
 }
 
+// GetPostVersion - get previous version of post info from KVStore
+func (ps PostStorage) GetPostVersion(
+	ctx sdk.Context, permlink types.Permlink, version int64) (*PostVersion, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	versionBytes := store.Get(getPostVersionKey(permlink, version))
+	if versionBytes == nil {
+		return nil, ErrPostVersionNotFound(getPostVersionKey(permlink, version))
+	}
+	postVersion := new(PostVersion)
+	if err := ps.cdc.UnmarshalJSON(versionBytes, postVersion); err != nil {
+		return nil, ErrFailedToUnmarshalPostVersion(err)
+	}
+	return postVersion, nil
+}
+
+// SetPostVersion - set previous version of post info to KVStore
+func (ps PostStorage) SetPostVersion(
+	ctx sdk.Context, permlink types.Permlink, postVersion *PostVersion) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	versionBytes, err := ps.cdc.MarshalJSON(*postVersion)
+	if err != nil {
+		return ErrFailedToMarshalPostVersion(err)
+	}
+	store.Set(getPostVersionKey(permlink, postVersion.Version), versionBytes)
+	return nil
+}
+
+// RemovePostVersion - remove previous version of post info from KVStore
+func (ps PostStorage) RemovePostVersion(ctx sdk.Context, permlink types.Permlink, version int64) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getPostVersionKey(permlink, version))
+}
+
+// GetPostVersions - get all retained previous versions of a post ordered by version
+func (ps PostStorage) GetPostVersions(
+	ctx sdk.Context, permlink types.Permlink) ([]PostVersion, sdk.Error) {
+	return ps.getPostVersionsWithPrefix(ctx, getPostVersionPrefix(permlink))
+}
+
+func (ps PostStorage) getPostVersionsWithPrefix(ctx sdk.Context, prefix []byte) ([]PostVersion, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	postVersions := []PostVersion{}
+	for ; iter.Valid(); iter.Next() {
+		postVersion := new(PostVersion)
+		if err := ps.cdc.UnmarshalJSON(iter.Value(), postVersion); err != nil {
+			return nil, ErrFailedToUnmarshalPostVersion(err)
+		}
+		postVersions = append(postVersions, *postVersion)
+	}
+	return postVersions, nil
+}
+
 // GetPostMeta - get post meta from KVStore
 func (ps PostStorage) GetPostMeta(ctx sdk.Context, permlink types.Permlink) (*PostMeta, sdk.Error) {
 	store := ctx.KVStore(ps.key)
@@ -149,6 +213,34 @@ func (ps PostStorage) SetPostComment(
 	return nil
 }
 
+// GetPostComments - get all comments of a post from KVStore
+func (ps PostStorage) GetPostComments(ctx sdk.Context, permlink types.Permlink) ([]Comment, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iter := sdk.KVStorePrefixIterator(store, getPostCommentPrefix(permlink))
+	defer iter.Close()
+	comments := []Comment{}
+	for ; iter.Valid(); iter.Next() {
+		comment := new(Comment)
+		if err := ps.cdc.UnmarshalJSON(iter.Value(), comment); err != nil {
+			return nil, ErrFailedToUnmarshalPostComment(err)
+		}
+		comments = append(comments, *comment)
+	}
+	return comments, nil
+}
+
+// GetPostCommentCount - get number of comments of a post from KVStore without loading them
+func (ps PostStorage) GetPostCommentCount(ctx sdk.Context, permlink types.Permlink) int64 {
+	store := ctx.KVStore(ps.key)
+	iter := sdk.KVStorePrefixIterator(store, getPostCommentPrefix(permlink))
+	defer iter.Close()
+	count := int64(0)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
+
 // GetPostView - get post view from KVStore
 func (ps PostStorage) GetPostView(
 	ctx sdk.Context, permlink types.Permlink, viewUser types.AccountKey) (*View, sdk.Error) {
@@ -202,6 +294,482 @@ func (ps PostStorage) SetPostDonations(
 	return nil
 }
 
+// GetPendingReward - get pending reward of a post by scheduled time and id,
+// returns nil if the pending reward doesn't exist
+func (ps PostStorage) GetPendingReward(
+	ctx sdk.Context, permlink types.Permlink, scheduledAt, id int64) (*PendingReward, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	rewardBytes := store.Get(getPendingRewardKey(permlink, scheduledAt, id))
+	if rewardBytes == nil {
+		return nil, nil
+	}
+	reward := new(PendingReward)
+	if err := ps.cdc.UnmarshalJSON(rewardBytes, reward); err != nil {
+		return nil, ErrFailedToUnmarshalPendingReward(err)
+	}
+	return reward, nil
+}
+
+// SetPendingReward - set pending reward of a post under its scheduled time and id
+func (ps PostStorage) SetPendingReward(
+	ctx sdk.Context, permlink types.Permlink, reward *PendingReward) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	rewardBytes, err := ps.cdc.MarshalJSON(*reward)
+	if err != nil {
+		return ErrFailedToMarshalPendingReward(err)
+	}
+	store.Set(getPendingRewardKey(permlink, reward.ScheduledAt, reward.ID), rewardBytes)
+	return nil
+}
+
+// RemovePendingReward - remove pending reward of a post by scheduled time and id
+func (ps PostStorage) RemovePendingReward(
+	ctx sdk.Context, permlink types.Permlink, scheduledAt, id int64) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getPendingRewardKey(permlink, scheduledAt, id))
+}
+
+// GetNextPendingRewardID - get id of next pending reward, starts from 1
+func (ps PostStorage) GetNextPendingRewardID(ctx sdk.Context) (*NextPendingRewardID, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	idBytes := store.Get(getNextPendingRewardIDKey())
+	if idBytes == nil {
+		return &NextPendingRewardID{NextPendingRewardID: 1}, nil
+	}
+	nextID := new(NextPendingRewardID)
+	if err := ps.cdc.UnmarshalJSON(idBytes, nextID); err != nil {
+		return nil, ErrFailedToUnmarshalPendingReward(err)
+	}
+	return nextID, nil
+}
+
+// SetNextPendingRewardID - set id of next pending reward
+func (ps PostStorage) SetNextPendingRewardID(ctx sdk.Context, nextID *NextPendingRewardID) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	idBytes, err := ps.cdc.MarshalJSON(*nextID)
+	if err != nil {
+		return ErrFailedToMarshalPendingReward(err)
+	}
+	store.Set(getNextPendingRewardIDKey(), idBytes)
+	return nil
+}
+
+// GetPendingRewardsOfPost - get all pending rewards of a post ordered by scheduled time
+func (ps PostStorage) GetPendingRewardsOfPost(
+	ctx sdk.Context, permlink types.Permlink) ([]PendingReward, sdk.Error) {
+	return ps.getPendingRewardsWithPrefix(ctx, getPendingRewardPrefix(permlink))
+}
+
+// GetPendingRewardsOfAuthor - get all pending rewards of posts created by author,
+// ordered by post and scheduled time
+func (ps PostStorage) GetPendingRewardsOfAuthor(
+	ctx sdk.Context, author types.AccountKey) ([]PendingReward, sdk.Error) {
+	return ps.getPendingRewardsWithPrefix(
+		ctx, append(append(postPendingRewardSubStore, author...), types.PermlinkSeparator...))
+}
+
+func (ps PostStorage) getPendingRewardsWithPrefix(ctx sdk.Context, prefix []byte) ([]PendingReward, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	rewards := []PendingReward{}
+	for ; iter.Valid(); iter.Next() {
+		var reward PendingReward
+		if err := ps.cdc.UnmarshalJSON(iter.Value(), &reward); err != nil {
+			return nil, ErrFailedToUnmarshalPendingReward(err)
+		}
+		rewards = append(rewards, reward)
+	}
+	return rewards, nil
+}
+
+// SetTaggedPost - index post under tag
+func (ps PostStorage) SetTaggedPost(ctx sdk.Context, tag string, taggedPost *TaggedPost) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	taggedPostBytes, err := ps.cdc.MarshalJSON(*taggedPost)
+	if err != nil {
+		return ErrFailedToMarshalTaggedPost(err)
+	}
+	store.Set(getTaggedPostKey(
+		tag, taggedPost.CreatedAt, types.GetPermlink(taggedPost.Author, taggedPost.PostID)), taggedPostBytes)
+	return nil
+}
+
+// RemoveTaggedPost - remove post from tag index
+func (ps PostStorage) RemoveTaggedPost(
+	ctx sdk.Context, tag string, createdAt int64, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getTaggedPostKey(tag, createdAt, permlink))
+}
+
+// GetTaggedPosts - get a page of posts under tag, newest first. Offset posts are skipped
+// and at most limit posts are returned
+func (ps PostStorage) GetTaggedPosts(
+	ctx sdk.Context, tag string, offset, limit int64) (*TaggedPostPage, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	prefix := getTaggedPostPrefix(tag)
+	iter := store.ReverseIterator(prefix, sdk.PrefixEndBytes(prefix))
+	defer iter.Close()
+	page := &TaggedPostPage{Posts: []TaggedPost{}}
+	for skipped := int64(0); iter.Valid() && skipped < offset; iter.Next() {
+		skipped++
+	}
+	for ; iter.Valid(); iter.Next() {
+		if int64(len(page.Posts)) == limit {
+			page.HasMore = true
+			break
+		}
+		taggedPost := new(TaggedPost)
+		if err := ps.cdc.UnmarshalJSON(iter.Value(), taggedPost); err != nil {
+			return nil, ErrFailedToUnmarshalTaggedPost(err)
+		}
+		page.Posts = append(page.Posts, *taggedPost)
+	}
+	return page, nil
+}
+
+// GetPostPaywall - get paywall of paid post from KVStore
+func (ps PostStorage) GetPostPaywall(ctx sdk.Context, permlink types.Permlink) (*PostPaywall, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	paywallBytes := store.Get(getPostPaywallKey(permlink))
+	if paywallBytes == nil {
+		return nil, ErrPostPaywallNotFound(getPostPaywallKey(permlink))
+	}
+	paywall := new(PostPaywall)
+	if err := ps.cdc.UnmarshalJSON(paywallBytes, paywall); err != nil {
+		return nil, ErrFailedToUnmarshalPostPaywall(err)
+	}
+	return paywall, nil
+}
+
+// SetPostPaywall - set paywall of paid post to KVStore
+func (ps PostStorage) SetPostPaywall(ctx sdk.Context, permlink types.Permlink, paywall *PostPaywall) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	paywallBytes, err := ps.cdc.MarshalJSON(*paywall)
+	if err != nil {
+		return ErrFailedToMarshalPostPaywall(err)
+	}
+	store.Set(getPostPaywallKey(permlink), paywallBytes)
+	return nil
+}
+
+// RemovePostPaywall - remove paywall of paid post from KVStore
+func (ps PostStorage) RemovePostPaywall(ctx sdk.Context, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getPostPaywallKey(permlink))
+}
+
+// GetPostCoAuthors - get co-authors of post from KVStore
+func (ps PostStorage) GetPostCoAuthors(ctx sdk.Context, permlink types.Permlink) (*PostCoAuthors, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	coAuthorsBytes := store.Get(getPostCoAuthorsKey(permlink))
+	if coAuthorsBytes == nil {
+		return nil, ErrPostCoAuthorsNotFound(getPostCoAuthorsKey(permlink))
+	}
+	coAuthors := new(PostCoAuthors)
+	if err := ps.cdc.UnmarshalJSON(coAuthorsBytes, coAuthors); err != nil {
+		return nil, ErrFailedToUnmarshalPostCoAuthors(err)
+	}
+	return coAuthors, nil
+}
+
+// DoesPostCoAuthorsExist - check if post has co-authors
+func (ps PostStorage) DoesPostCoAuthorsExist(ctx sdk.Context, permlink types.Permlink) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(getPostCoAuthorsKey(permlink))
+}
+
+// SetPostCoAuthors - set co-authors of post to KVStore
+func (ps PostStorage) SetPostCoAuthors(
+	ctx sdk.Context, permlink types.Permlink, coAuthors *PostCoAuthors) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	coAuthorsBytes, err := ps.cdc.MarshalJSON(*coAuthors)
+	if err != nil {
+		return ErrFailedToMarshalPostCoAuthors(err)
+	}
+	store.Set(getPostCoAuthorsKey(permlink), coAuthorsBytes)
+	return nil
+}
+
+// DoesPostUnlockExist - check if user unlocked paid post
+func (ps PostStorage) DoesPostUnlockExist(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(getPostUnlockKey(permlink, user))
+}
+
+// GetPostUnlock - get unlock of paid post from KVStore
+func (ps PostStorage) GetPostUnlock(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey) (*PostUnlock, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	unlockBytes := store.Get(getPostUnlockKey(permlink, user))
+	if unlockBytes == nil {
+		return nil, ErrPostUnlockNotFound(getPostUnlockKey(permlink, user))
+	}
+	unlock := new(PostUnlock)
+	if err := ps.cdc.UnmarshalJSON(unlockBytes, unlock); err != nil {
+		return nil, ErrFailedToUnmarshalPostUnlock(err)
+	}
+	return unlock, nil
+}
+
+// SetPostUnlock - set unlock of paid post to KVStore
+func (ps PostStorage) SetPostUnlock(ctx sdk.Context, permlink types.Permlink, unlock *PostUnlock) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	unlockBytes, err := ps.cdc.MarshalJSON(*unlock)
+	if err != nil {
+		return ErrFailedToMarshalPostUnlock(err)
+	}
+	store.Set(getPostUnlockKey(permlink, unlock.Username), unlockBytes)
+	return nil
+}
+
+// SetContentReferencePost - index post under hash of its off-chain content
+func (ps PostStorage) SetContentReferencePost(ctx sdk.Context, hash string, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Set(getContentReferencePostKey(hash, permlink), []byte(permlink))
+}
+
+// RemoveContentReferencePost - remove post from content hash index
+func (ps PostStorage) RemoveContentReferencePost(ctx sdk.Context, hash string, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getContentReferencePostKey(hash, permlink))
+}
+
+// GetContentReferencePosts - get all posts referencing off-chain content with given hash
+func (ps PostStorage) GetContentReferencePosts(ctx sdk.Context, hash string) []types.Permlink {
+	store := ctx.KVStore(ps.key)
+	iter := sdk.KVStorePrefixIterator(store, getContentReferencePostPrefix(hash))
+	defer iter.Close()
+	permlinks := []types.Permlink{}
+	for ; iter.Valid(); iter.Next() {
+		permlinks = append(permlinks, types.Permlink(iter.Value()))
+	}
+	return permlinks
+}
+
+// GetPostInfosByAuthor - get all post info created by author from KVStore
+func (ps PostStorage) GetPostInfosByAuthor(
+	ctx sdk.Context, author types.AccountKey) ([]PostInfo, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iter := sdk.KVStorePrefixIterator(
+		store, append(GetPostInfoPrefix(author), types.PermlinkSeparator...))
+	defer iter.Close()
+	postInfos := []PostInfo{}
+	for ; iter.Valid(); iter.Next() {
+		postInfo := new(PostInfo)
+		if err := ps.cdc.UnmarshalJSON(iter.Value(), postInfo); err != nil {
+			return nil, ErrFailedToUnmarshalPostInfo(err)
+		}
+		postInfos = append(postInfos, *postInfo)
+	}
+	return postInfos, nil
+}
+
+// Export - export all records in post KVStore
+func (ps PostStorage) Export(ctx sdk.Context) (*PostTables, sdk.Error) {
+	tables := &PostTables{}
+	store := ctx.KVStore(ps.key)
+
+	infoIter := sdk.KVStorePrefixIterator(store, postInfoSubStore)
+	defer infoIter.Close()
+	for ; infoIter.Valid(); infoIter.Next() {
+		row := PostRow{Permlink: types.Permlink(infoIter.Key()[len(postInfoSubStore):])}
+		if err := ps.cdc.UnmarshalJSON(infoIter.Value(), &row.Info); err != nil {
+			return nil, ErrFailedToUnmarshalPostInfo(err)
+		}
+		meta, err := ps.GetPostMeta(ctx, row.Permlink)
+		if err != nil {
+			return nil, err
+		}
+		row.Meta = *meta
+		tables.Posts = append(tables.Posts, row)
+	}
+
+	reportOrUpvoteIter := sdk.KVStorePrefixIterator(store, postReportOrUpvoteSubStore)
+	defer reportOrUpvoteIter.Close()
+	for ; reportOrUpvoteIter.Valid(); reportOrUpvoteIter.Next() {
+		row := ReportOrUpvoteRow{}
+		if err := ps.cdc.UnmarshalJSON(reportOrUpvoteIter.Value(), &row.ReportOrUpvote); err != nil {
+			return nil, ErrFailedToUnmarshalPostReportOrUpvote(err)
+		}
+		row.Permlink = getPermlinkFromKey(
+			reportOrUpvoteIter.Key(), postReportOrUpvoteSubStore, string(row.ReportOrUpvote.Username))
+		tables.ReportOrUpvotes = append(tables.ReportOrUpvotes, row)
+	}
+
+	commentIter := sdk.KVStorePrefixIterator(store, postCommentSubStore)
+	defer commentIter.Close()
+	for ; commentIter.Valid(); commentIter.Next() {
+		row := CommentRow{}
+		if err := ps.cdc.UnmarshalJSON(commentIter.Value(), &row.Comment); err != nil {
+			return nil, ErrFailedToUnmarshalPostComment(err)
+		}
+		row.Permlink = getPermlinkFromKey(
+			commentIter.Key(), postCommentSubStore,
+			string(types.GetPermlink(row.Comment.Author, row.Comment.PostID)))
+		tables.Comments = append(tables.Comments, row)
+	}
+
+	viewIter := sdk.KVStorePrefixIterator(store, postViewsSubStore)
+	defer viewIter.Close()
+	for ; viewIter.Valid(); viewIter.Next() {
+		row := ViewRow{}
+		if err := ps.cdc.UnmarshalJSON(viewIter.Value(), &row.View); err != nil {
+			return nil, ErrFailedToUnmarshalPostView(err)
+		}
+		row.Permlink = getPermlinkFromKey(viewIter.Key(), postViewsSubStore, string(row.View.Username))
+		tables.Views = append(tables.Views, row)
+	}
+
+	donationsIter := sdk.KVStorePrefixIterator(store, postDonationsSubStore)
+	defer donationsIter.Close()
+	for ; donationsIter.Valid(); donationsIter.Next() {
+		row := DonationsRow{}
+		if err := ps.cdc.UnmarshalJSON(donationsIter.Value(), &row.Donations); err != nil {
+			return nil, ErrFailedToUnmarshalPostDonations(err)
+		}
+		row.Permlink = getPermlinkFromKey(
+			donationsIter.Key(), postDonationsSubStore, string(row.Donations.Username))
+		tables.Donations = append(tables.Donations, row)
+	}
+
+	pendingRewards, err := ps.getPendingRewardsWithPrefix(ctx, postPendingRewardSubStore)
+	if err != nil {
+		return nil, err
+	}
+	tables.PendingRewards = pendingRewards
+	nextPendingRewardID, err := ps.GetNextPendingRewardID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables.NextPendingReward = *nextPendingRewardID
+
+	versions, err := ps.getPostVersionsWithPrefix(ctx, postVersionSubStore)
+	if err != nil {
+		return nil, err
+	}
+	tables.Versions = versions
+
+	paywallIter := sdk.KVStorePrefixIterator(store, postPaywallSubStore)
+	defer paywallIter.Close()
+	for ; paywallIter.Valid(); paywallIter.Next() {
+		row := PaywallRow{Permlink: types.Permlink(paywallIter.Key()[len(postPaywallSubStore):])}
+		if err := ps.cdc.UnmarshalJSON(paywallIter.Value(), &row.Paywall); err != nil {
+			return nil, ErrFailedToUnmarshalPostPaywall(err)
+		}
+		tables.Paywalls = append(tables.Paywalls, row)
+	}
+
+	unlockIter := sdk.KVStorePrefixIterator(store, postUnlockSubStore)
+	defer unlockIter.Close()
+	for ; unlockIter.Valid(); unlockIter.Next() {
+		row := UnlockRow{}
+		if err := ps.cdc.UnmarshalJSON(unlockIter.Value(), &row.Unlock); err != nil {
+			return nil, ErrFailedToUnmarshalPostUnlock(err)
+		}
+		row.Permlink = getPermlinkFromKey(unlockIter.Key(), postUnlockSubStore, string(row.Unlock.Username))
+		tables.Unlocks = append(tables.Unlocks, row)
+	}
+
+	coAuthorsIter := sdk.KVStorePrefixIterator(store, postCoAuthorsSubStore)
+	defer coAuthorsIter.Close()
+	for ; coAuthorsIter.Valid(); coAuthorsIter.Next() {
+		row := CoAuthorsRow{Permlink: types.Permlink(coAuthorsIter.Key()[len(postCoAuthorsSubStore):])}
+		if err := ps.cdc.UnmarshalJSON(coAuthorsIter.Value(), &row.CoAuthors); err != nil {
+			return nil, ErrFailedToUnmarshalPostCoAuthors(err)
+		}
+		tables.CoAuthors = append(tables.CoAuthors, row)
+	}
+	return tables, nil
+}
+
+// Import - import all records exported by Export to post KVStore
+func (ps PostStorage) Import(ctx sdk.Context, tables *PostTables) sdk.Error {
+	for _, row := range tables.Posts {
+		if err := ps.SetPostInfo(ctx, &row.Info); err != nil {
+			return err
+		}
+		if err := ps.SetPostMeta(ctx, row.Permlink, &row.Meta); err != nil {
+			return err
+		}
+		// tag and content hash index are rebuilt from post info instead of being exported
+		for _, tag := range row.Info.Tags {
+			taggedPost := &TaggedPost{
+				Author:    row.Info.Author,
+				PostID:    row.Info.PostID,
+				CreatedAt: row.Meta.CreatedAt,
+			}
+			if err := ps.SetTaggedPost(ctx, tag, taggedPost); err != nil {
+				return err
+			}
+		}
+		if row.Info.ContentReference != nil && !row.Meta.IsDeleted {
+			ps.SetContentReferencePost(ctx, row.Info.ContentReference.Hash, row.Permlink)
+		}
+	}
+	for _, row := range tables.ReportOrUpvotes {
+		if err := ps.SetPostReportOrUpvote(ctx, row.Permlink, &row.ReportOrUpvote); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.Comments {
+		if err := ps.SetPostComment(ctx, row.Permlink, &row.Comment); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.Views {
+		if err := ps.SetPostView(ctx, row.Permlink, &row.View); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.Donations {
+		if err := ps.SetPostDonations(ctx, row.Permlink, &row.Donations); err != nil {
+			return err
+		}
+	}
+	for _, reward := range tables.PendingRewards {
+		if err := ps.SetPendingReward(ctx, types.GetPermlink(reward.Author, reward.PostID), &reward); err != nil {
+			return err
+		}
+	}
+	if err := ps.SetNextPendingRewardID(ctx, &tables.NextPendingReward); err != nil {
+		return err
+	}
+	for _, version := range tables.Versions {
+		permlink := types.GetPermlink(version.Info.Author, version.Info.PostID)
+		if err := ps.SetPostVersion(ctx, permlink, &version); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.Paywalls {
+		if err := ps.SetPostPaywall(ctx, row.Permlink, &row.Paywall); err != nil {
+			return err
+		}
+		ps.SetContentReferencePost(ctx, row.Paywall.EncryptedContentReference.Hash, row.Permlink)
+	}
+	for _, row := range tables.Unlocks {
+		if err := ps.SetPostUnlock(ctx, row.Permlink, &row.Unlock); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.CoAuthors {
+		if err := ps.SetPostCoAuthors(ctx, row.Permlink, &row.CoAuthors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getPermlinkFromKey - get permlink from "substore" + "permlink" + "separator" + "suffix"
+func getPermlinkFromKey(key []byte, substore []byte, suffix string) types.Permlink {
+	end := len(key) - len(suffix) - len(types.KeySeparator)
+	if end < len(substore) {
+		return ""
+	}
+	return types.Permlink(key[len(substore):end])
+}
+
 // GetPostInfoPrefix - "post info substore" + "author"
 func GetPostInfoPrefix(author types.AccountKey) []byte {
 	return append(postInfoSubStore, author...)
@@ -217,6 +785,19 @@ func GetPostMetaKey(permlink types.Permlink) []byte {
 	return append(postMetaSubStore, permlink...)
 }
 
+// getPostVersionPrefix - "post version substore" + "permlink" + "separator"
+// which can be used to access all retained versions belong to this post
+func getPostVersionPrefix(permlink types.Permlink) []byte {
+	return append(append(postVersionSubStore, permlink...), types.KeySeparator...)
+}
+
+// getPostVersionKey - "post version substore" + "permlink" + "separator" + "version"
+func getPostVersionKey(permlink types.Permlink, version int64) []byte {
+	versionBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(versionBytes, uint64(version))
+	return append(getPostVersionPrefix(permlink), versionBytes...)
+}
+
 // getPostReportOrUpvotePrefix - "post report or upvote substore" + "permlink"
 // which can be used to access all reports belong to this post
 func getPostReportOrUpvotePrefix(permlink types.Permlink) []byte {
@@ -260,3 +841,67 @@ func getPostDonationsPrefix(permlink types.Permlink) []byte {
 func getPostDonationKey(permlink types.Permlink, donateUser types.AccountKey) []byte {
 	return append(getPostDonationsPrefix(permlink), donateUser...)
 }
+
+// getPendingRewardPrefix - "pending reward substore" + "permlink" + "separator"
+// which can be used to access all pending rewards belong to this post
+func getPendingRewardPrefix(permlink types.Permlink) []byte {
+	return append(append(postPendingRewardSubStore, permlink...), types.KeySeparator...)
+}
+
+// getPendingRewardKey - "pending reward substore" + "permlink" + "separator" + "scheduled time" + "id"
+func getPendingRewardKey(permlink types.Permlink, scheduledAt, id int64) []byte {
+	keyBytes := make([]byte, 16)
+	binary.BigEndian.PutUint64(keyBytes[:8], uint64(scheduledAt))
+	binary.BigEndian.PutUint64(keyBytes[8:], uint64(id))
+	return append(getPendingRewardPrefix(permlink), keyBytes...)
+}
+
+// getNextPendingRewardIDKey - "next pending reward id substore"
+func getNextPendingRewardIDKey() []byte {
+	return postPendingRewardIDSubStore
+}
+
+// getTaggedPostPrefix - "tag substore" + "tag" + "separator"
+// which can be used to access all posts under this tag
+func getTaggedPostPrefix(tag string) []byte {
+	return append(append(postTagSubStore, tag...), types.KeySeparator...)
+}
+
+// getTaggedPostKey - "tag substore" + "tag" + "separator" + "created time" + "permlink"
+func getTaggedPostKey(tag string, createdAt int64, permlink types.Permlink) []byte {
+	timeBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(timeBytes, uint64(createdAt))
+	return append(append(getTaggedPostPrefix(tag), timeBytes...), permlink...)
+}
+
+// getContentReferencePostPrefix - "content reference substore" + "hash" + "separator"
+// which can be used to access all posts referencing the content
+func getContentReferencePostPrefix(hash string) []byte {
+	return append(append(postContentRefSubStore, hash...), types.KeySeparator...)
+}
+
+// getContentReferencePostKey - "content reference substore" + "hash" + "separator" + "permlink"
+func getContentReferencePostKey(hash string, permlink types.Permlink) []byte {
+	return append(getContentReferencePostPrefix(hash), permlink...)
+}
+
+// getPostPaywallKey - "paywall substore" + "permlink"
+func getPostPaywallKey(permlink types.Permlink) []byte {
+	return append(postPaywallSubStore, permlink...)
+}
+
+// getPostUnlockPrefix - "unlock substore" + "permlink" + "separator"
+// which can be used to access all users unlocked the post
+func getPostUnlockPrefix(permlink types.Permlink) []byte {
+	return append(append(postUnlockSubStore, permlink...), types.KeySeparator...)
+}
+
+// getPostUnlockKey - "unlock substore" + "permlink" + "separator" + "username"
+func getPostUnlockKey(permlink types.Permlink, user types.AccountKey) []byte {
+	return append(getPostUnlockPrefix(permlink), user...)
+}
+
+// getPostCoAuthorsKey - "co-authors substore" + "permlink"
+func getPostCoAuthorsKey(permlink types.Permlink) []byte {
+	return append(postCoAuthorsSubStore, permlink...)
+}
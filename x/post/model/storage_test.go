@@ -126,6 +126,65 @@ func TestPostDonate(t *testing.T) {
 	})
 }
 
+func TestPendingReward(t *testing.T) {
+	reward := PendingReward{
+		ID:          2,
+		Author:      types.AccountKey("author"),
+		PostID:      "postID",
+		Consumer:    types.AccountKey("consumer"),
+		Evaluate:    types.NewCoinFromInt64(100),
+		Original:    types.NewCoinFromInt64(1000),
+		Friction:    types.NewCoinFromInt64(50),
+		FromApp:     types.AccountKey("app"),
+		ScheduledAt: 200,
+	}
+	earlierReward := reward
+	earlierReward.ID = 3
+	earlierReward.ScheduledAt = 100
+	identicalReward := reward
+	identicalReward.ID = 1
+	otherPostReward := reward
+	otherPostReward.ID = 4
+	otherPostReward.PostID = "postID2"
+	otherAuthorReward := reward
+	otherAuthorReward.ID = 5
+	otherAuthorReward.Author = types.AccountKey("author2")
+	permlink := types.GetPermlink(reward.Author, reward.PostID)
+
+	runTest(t, func(env TestEnv) {
+		pending, err := env.ps.GetPendingReward(env.ctx, permlink, reward.ScheduledAt, reward.ID)
+		assert.Nil(t, err)
+		assert.Nil(t, pending)
+		nextID, err := env.ps.GetNextPendingRewardID(env.ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), nextID.NextPendingRewardID)
+
+		for _, r := range []PendingReward{
+			reward, earlierReward, identicalReward, otherPostReward, otherAuthorReward} {
+			err = env.ps.SetPendingReward(env.ctx, types.GetPermlink(r.Author, r.PostID), &r)
+			assert.Nil(t, err)
+		}
+		pending, err = env.ps.GetPendingReward(env.ctx, permlink, reward.ScheduledAt, reward.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, reward, *pending)
+
+		// ordered by scheduled time then id
+		rewards, err := env.ps.GetPendingRewardsOfPost(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, []PendingReward{earlierReward, identicalReward, reward}, rewards)
+
+		rewards, err = env.ps.GetPendingRewardsOfAuthor(env.ctx, reward.Author)
+		assert.Nil(t, err)
+		assert.Equal(t, []PendingReward{earlierReward, identicalReward, reward, otherPostReward}, rewards)
+
+		// only the reward with exact key is removed
+		env.ps.RemovePendingReward(env.ctx, permlink, reward.ScheduledAt, reward.ID)
+		rewards, err = env.ps.GetPendingRewardsOfPost(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, []PendingReward{earlierReward, identicalReward}, rewards)
+	})
+}
+
 //
 // Test Environment setup
 //
@@ -135,6 +194,128 @@ type TestEnv struct {
 	ctx sdk.Context
 }
 
+func TestPostVersion(t *testing.T) {
+	postInfo := PostInfo{PostID: "postID", Title: "title", Content: "content", Author: types.AccountKey("author")}
+	permlink := types.GetPermlink(postInfo.Author, postInfo.PostID)
+	v1 := PostVersion{Version: 1, Info: postInfo, ContentHash: "hash1", UpdatedAt: 100, ArchivedAt: 200}
+	v2 := PostVersion{Version: 2, Info: postInfo, ContentHash: "hash2", UpdatedAt: 200, ArchivedAt: 300}
+
+	runTest(t, func(env TestEnv) {
+		_, err := env.ps.GetPostVersion(env.ctx, permlink, 1)
+		assert.Equal(t, ErrPostVersionNotFound(getPostVersionKey(permlink, 1)), err)
+
+		for _, v := range []PostVersion{v2, v1} {
+			err = env.ps.SetPostVersion(env.ctx, permlink, &v)
+			assert.Nil(t, err)
+		}
+		resultPtr, err := env.ps.GetPostVersion(env.ctx, permlink, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, v2, *resultPtr)
+
+		versions, err := env.ps.GetPostVersions(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, []PostVersion{v1, v2}, versions)
+
+		env.ps.RemovePostVersion(env.ctx, permlink, 1)
+		versions, err = env.ps.GetPostVersions(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, []PostVersion{v2}, versions)
+	})
+}
+
+func TestPostPaywall(t *testing.T) {
+	permlink := types.GetPermlink(types.AccountKey("author"), "postID")
+	paywall := PostPaywall{
+		Price: types.NewCoinFromInt64(100),
+		EncryptedContentReference: types.ContentReference{
+			Hash: "hash", Size: 100, MIMEType: "text/markdown", URI: "ipfs://QmTestContent"},
+	}
+	unlock := PostUnlock{Username: types.AccountKey("user"), Price: types.NewCoinFromInt64(100), UnlockedAt: 100}
+
+	runTest(t, func(env TestEnv) {
+		_, err := env.ps.GetPostPaywall(env.ctx, permlink)
+		assert.Equal(t, ErrPostPaywallNotFound(getPostPaywallKey(permlink)), err)
+		err = env.ps.SetPostPaywall(env.ctx, permlink, &paywall)
+		assert.Nil(t, err)
+		paywallPtr, err := env.ps.GetPostPaywall(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, paywall, *paywallPtr)
+
+		assert.False(t, env.ps.DoesPostUnlockExist(env.ctx, permlink, unlock.Username))
+		err = env.ps.SetPostUnlock(env.ctx, permlink, &unlock)
+		assert.Nil(t, err)
+		assert.True(t, env.ps.DoesPostUnlockExist(env.ctx, permlink, unlock.Username))
+		unlockPtr, err := env.ps.GetPostUnlock(env.ctx, permlink, unlock.Username)
+		assert.Nil(t, err)
+		assert.Equal(t, unlock, *unlockPtr)
+
+		env.ps.RemovePostPaywall(env.ctx, permlink)
+		_, err = env.ps.GetPostPaywall(env.ctx, permlink)
+		assert.Equal(t, ErrPostPaywallNotFound(getPostPaywallKey(permlink)), err)
+	})
+}
+
+func TestPostCoAuthors(t *testing.T) {
+	permlink := types.GetPermlink(types.AccountKey("author"), "postID")
+	coAuthors := PostCoAuthors{
+		CoAuthors: []CoAuthor{
+			{Username: types.AccountKey("author"), Share: sdk.NewRat(1, 2), Accepted: true},
+			{Username: types.AccountKey("coAuthor"), Share: sdk.NewRat(1, 2), Accepted: false},
+		},
+	}
+
+	runTest(t, func(env TestEnv) {
+		assert.False(t, env.ps.DoesPostCoAuthorsExist(env.ctx, permlink))
+		_, err := env.ps.GetPostCoAuthors(env.ctx, permlink)
+		assert.Equal(t, ErrPostCoAuthorsNotFound(getPostCoAuthorsKey(permlink)), err)
+		err = env.ps.SetPostCoAuthors(env.ctx, permlink, &coAuthors)
+		assert.Nil(t, err)
+		assert.True(t, env.ps.DoesPostCoAuthorsExist(env.ctx, permlink))
+		coAuthorsPtr, err := env.ps.GetPostCoAuthors(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, coAuthors, *coAuthorsPtr)
+	})
+}
+
+func TestTaggedPost(t *testing.T) {
+	oldPost := TaggedPost{Author: types.AccountKey("author"), PostID: "old", CreatedAt: 100}
+	newPost := TaggedPost{Author: types.AccountKey("author"), PostID: "new", CreatedAt: 200}
+	samePost := TaggedPost{Author: types.AccountKey("author2"), PostID: "same", CreatedAt: 200}
+	otherTagPost := TaggedPost{Author: types.AccountKey("author"), PostID: "other", CreatedAt: 300}
+
+	runTest(t, func(env TestEnv) {
+		for _, p := range []TaggedPost{oldPost, newPost, samePost} {
+			err := env.ps.SetTaggedPost(env.ctx, "music", &p)
+			assert.Nil(t, err)
+		}
+		// tag which is a prefix of another tag doesn't match its posts
+		err := env.ps.SetTaggedPost(env.ctx, "musi", &otherTagPost)
+		assert.Nil(t, err)
+
+		page, err := env.ps.GetTaggedPosts(env.ctx, "music", 0, 10)
+		assert.Nil(t, err)
+		assert.Equal(t, TaggedPostPage{Posts: []TaggedPost{samePost, newPost, oldPost}}, *page)
+
+		page, err = env.ps.GetTaggedPosts(env.ctx, "music", 0, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, TaggedPostPage{Posts: []TaggedPost{samePost, newPost}, HasMore: true}, *page)
+
+		page, err = env.ps.GetTaggedPosts(env.ctx, "music", 2, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, TaggedPostPage{Posts: []TaggedPost{oldPost}}, *page)
+
+		page, err = env.ps.GetTaggedPosts(env.ctx, "music", 3, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, TaggedPostPage{Posts: []TaggedPost{}}, *page)
+
+		env.ps.RemoveTaggedPost(
+			env.ctx, "music", newPost.CreatedAt, types.GetPermlink(newPost.Author, newPost.PostID))
+		page, err = env.ps.GetTaggedPosts(env.ctx, "music", 0, 10)
+		assert.Nil(t, err)
+		assert.Equal(t, TaggedPostPage{Posts: []TaggedPost{samePost, oldPost}}, *page)
+	})
+}
+
 func runTest(t *testing.T, fc func(env TestEnv)) {
 	env := TestEnv{
 		ps:  NewPostStorage(TestKVStoreKey),
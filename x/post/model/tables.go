@@ -0,0 +1,69 @@
+package model
+
+import (
+	"github.com/lino-network/lino/types"
+)
+
+// PostRow - info and meta of a post
+type PostRow struct {
+	Permlink types.Permlink `json:"permlink"`
+	Info     PostInfo       `json:"info"`
+	Meta     PostMeta       `json:"meta"`
+}
+
+// ReportOrUpvoteRow - report or upvote to a post
+type ReportOrUpvoteRow struct {
+	Permlink       types.Permlink `json:"permlink"`
+	ReportOrUpvote ReportOrUpvote `json:"report_or_upvote"`
+}
+
+// CommentRow - comment to a post
+type CommentRow struct {
+	Permlink types.Permlink `json:"permlink"`
+	Comment  Comment        `json:"comment"`
+}
+
+// ViewRow - view of a post
+type ViewRow struct {
+	Permlink types.Permlink `json:"permlink"`
+	View     View           `json:"view"`
+}
+
+// DonationsRow - donations to a post
+type DonationsRow struct {
+	Permlink  types.Permlink `json:"permlink"`
+	Donations Donations      `json:"donations"`
+}
+
+// PaywallRow - paywall of a paid post
+type PaywallRow struct {
+	Permlink types.Permlink `json:"permlink"`
+	Paywall  PostPaywall    `json:"paywall"`
+}
+
+// UnlockRow - unlock of a paid post
+type UnlockRow struct {
+	Permlink types.Permlink `json:"permlink"`
+	Unlock   PostUnlock     `json:"unlock"`
+}
+
+// CoAuthorsRow - co-authors of a post
+type CoAuthorsRow struct {
+	Permlink  types.Permlink `json:"permlink"`
+	CoAuthors PostCoAuthors  `json:"co_authors"`
+}
+
+// PostTables - state of post KVStore
+type PostTables struct {
+	Posts             []PostRow           `json:"posts"`
+	ReportOrUpvotes   []ReportOrUpvoteRow `json:"report_or_upvotes"`
+	Comments          []CommentRow        `json:"comments"`
+	Views             []ViewRow           `json:"views"`
+	Donations         []DonationsRow      `json:"donations"`
+	PendingRewards    []PendingReward     `json:"pending_rewards"`
+	NextPendingReward NextPendingRewardID `json:"next_pending_reward"`
+	Versions          []PostVersion       `json:"versions"`
+	Paywalls          []PaywallRow        `json:"paywalls"`
+	Unlocks           []UnlockRow         `json:"unlocks"`
+	CoAuthors         []CoAuthorsRow      `json:"co_authors"`
+}
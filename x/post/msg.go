@@ -2,6 +2,7 @@ package post
 
 import (
 	"fmt"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/lino-network/lino/types"
@@ -15,19 +16,33 @@ var _ types.Msg = DeletePostMsg{}
 var _ types.Msg = DonateMsg{}
 var _ types.Msg = ReportOrUpvoteMsg{}
 var _ types.Msg = ViewMsg{}
+var _ types.Msg = UnlockPostMsg{}
+var _ types.Msg = AcceptCoAuthorMsg{}
 
 // CreatePostMsg contains information to create a post
 type CreatePostMsg struct {
-	Author                  types.AccountKey       `json:"author"`
-	PostID                  string                 `json:"post_id"`
-	Title                   string                 `json:"title"`
-	Content                 string                 `json:"content"`
-	ParentAuthor            types.AccountKey       `json:"parent_author"`
-	ParentPostID            string                 `json:"parent_postID"`
-	SourceAuthor            types.AccountKey       `json:"source_author"`
-	SourcePostID            string                 `json:"source_postID"`
-	Links                   []types.IDToURLMapping `json:"links"`
-	RedistributionSplitRate string                 `json:"redistribution_split_rate"`
+	Author                    types.AccountKey        `json:"author"`
+	PostID                    string                  `json:"post_id"`
+	Title                     string                  `json:"title"`
+	Content                   string                  `json:"content"`
+	ParentAuthor              types.AccountKey        `json:"parent_author"`
+	ParentPostID              string                  `json:"parent_postID"`
+	SourceAuthor              types.AccountKey        `json:"source_author"`
+	SourcePostID              string                  `json:"source_postID"`
+	Links                     []types.IDToURLMapping  `json:"links"`
+	RedistributionSplitRate   string                  `json:"redistribution_split_rate"`
+	Tags                      []string                `json:"tags"`
+	ContentReference          *types.ContentReference `json:"content_reference"`
+	Price                     types.LNO               `json:"price"`
+	EncryptedContentReference *types.ContentReference `json:"encrypted_content_reference"`
+	CoAuthors                 []CoAuthorShare         `json:"co_authors"`
+}
+
+// CoAuthorShare - co-author of a post and its share of post revenue in decimal.
+// Shares of all co-authors, including the author, must sum to one
+type CoAuthorShare struct {
+	Username types.AccountKey `json:"username"`
+	Share    string           `json:"share"`
 }
 
 // UpdatePostMsg - update post
@@ -37,6 +52,7 @@ type UpdatePostMsg struct {
 	Title   string                 `json:"title"`
 	Content string                 `json:"content"`
 	Links   []types.IDToURLMapping `json:"links"`
+	Tags    []string               `json:"tags"`
 }
 
 // DeletePostMsg - sent from a user to a post
@@ -70,11 +86,28 @@ type ReportOrUpvoteMsg struct {
 	IsReport bool             `json:"is_report"`
 }
 
+// UnlockPostMsg - sent from a user to pay the price of a paid post. Amount must
+// be equal to the price of the post
+type UnlockPostMsg struct {
+	Username types.AccountKey `json:"username"`
+	Amount   types.LNO        `json:"amount"`
+	Author   types.AccountKey `json:"author"`
+	PostID   string           `json:"post_id"`
+	FromApp  types.AccountKey `json:"from_app"`
+}
+
+// AcceptCoAuthorMsg - sent from a co-author to accept its share of a post
+type AcceptCoAuthorMsg struct {
+	Username types.AccountKey `json:"username"`
+	Author   types.AccountKey `json:"author"`
+	PostID   string           `json:"post_id"`
+}
+
 // NewCreatePostMsg - constructs a post msg
 func NewCreatePostMsg(
 	author, postID, title, content, parentAuthor, parentPostID,
 	sourceAuthor, sourcePostID, redistributionSplitRate string,
-	links []types.IDToURLMapping) CreatePostMsg {
+	links []types.IDToURLMapping, tags []string, contentReference *types.ContentReference) CreatePostMsg {
 	return CreatePostMsg{
 		Author:       types.AccountKey(author),
 		PostID:       postID,
@@ -86,18 +119,21 @@ func NewCreatePostMsg(
 		SourcePostID: sourcePostID,
 		Links:        links,
 		RedistributionSplitRate: redistributionSplitRate,
+		Tags:                    tags,
+		ContentReference:        contentReference,
 	}
 }
 
 // NewUpdatePostMsg - constructs a UpdatePost msg
 func NewUpdatePostMsg(
-	author, postID, title, content string, links []types.IDToURLMapping) UpdatePostMsg {
+	author, postID, title, content string, links []types.IDToURLMapping, tags []string) UpdatePostMsg {
 	return UpdatePostMsg{
 		Author:  types.AccountKey(author),
 		PostID:  postID,
 		Title:   title,
 		Content: content,
 		Links:   links,
+		Tags:    tags,
 	}
 }
 
@@ -143,6 +179,26 @@ func NewReportOrUpvoteMsg(
 	}
 }
 
+// NewUnlockPostMsg - constructs a UnlockPost msg
+func NewUnlockPostMsg(user string, amount types.LNO, author, postID, fromApp string) UnlockPostMsg {
+	return UnlockPostMsg{
+		Username: types.AccountKey(user),
+		Amount:   amount,
+		Author:   types.AccountKey(author),
+		PostID:   postID,
+		FromApp:  types.AccountKey(fromApp),
+	}
+}
+
+// NewAcceptCoAuthorMsg - constructs a AcceptCoAuthor msg
+func NewAcceptCoAuthorMsg(user, author, postID string) AcceptCoAuthorMsg {
+	return AcceptCoAuthorMsg{
+		Username: types.AccountKey(user),
+		Author:   types.AccountKey(author),
+		PostID:   postID,
+	}
+}
+
 // Type - implements sdk.Msg
 func (msg CreatePostMsg) Type() string { return types.PostRouterName }
 
@@ -161,6 +217,12 @@ func (msg ReportOrUpvoteMsg) Type() string { return types.PostRouterName }
 // Type - implements sdk.Msg
 func (msg ViewMsg) Type() string { return types.PostRouterName }
 
+// Type - implements sdk.Msg
+func (msg UnlockPostMsg) Type() string { return types.PostRouterName }
+
+// Type - implements sdk.Msg
+func (msg AcceptCoAuthorMsg) Type() string { return types.PostRouterName }
+
 // ValidateBasic - implements sdk.Msg
 func (msg CreatePostMsg) ValidateBasic() sdk.Error {
 	// Ensure permlink exists
@@ -200,6 +262,41 @@ func (msg CreatePostMsg) ValidateBasic() sdk.Error {
 		}
 	}
 
+	if err := validateTags(msg.Tags); err != nil {
+		return err
+	}
+
+	if msg.ContentReference != nil {
+		if len(msg.Content) > 0 {
+			return ErrInvalidContentReference("inline content must be empty")
+		}
+		if err := validateContentReference(msg.ContentReference); err != nil {
+			return err
+		}
+	}
+
+	// inline content and content reference of paid post are public preview
+	if len(msg.Price) > 0 || msg.EncryptedContentReference != nil {
+		if len(msg.Price) == 0 {
+			return ErrInvalidPaidPost("price is required")
+		}
+		if _, err := types.LinoToCoin(msg.Price); err != nil {
+			return ErrInvalidPaidPost("invalid price")
+		}
+		if msg.EncryptedContentReference == nil {
+			return ErrInvalidPaidPost("encrypted content reference is required")
+		}
+		if err := validateContentReference(msg.EncryptedContentReference); err != nil {
+			return err
+		}
+	}
+
+	if len(msg.CoAuthors) > 0 {
+		if _, err := parseCoAuthorShares(msg.Author, msg.CoAuthors); err != nil {
+			return err
+		}
+	}
+
 	splitRate, err := sdk.NewRatFromDecimal(msg.RedistributionSplitRate, types.NewRatFromDecimalPrecision)
 	if err != nil {
 		return err
@@ -234,9 +331,82 @@ func (msg UpdatePostMsg) ValidateBasic() sdk.Error {
 			return ErrURLLengthTooLong()
 		}
 	}
+
+	if err := validateTags(msg.Tags); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateTags - tags are bounded in number and length, unique in a post,
+// and can't contain key separator since tag is part of the index key
+func validateTags(tags []string) sdk.Error {
+	if len(tags) > types.MaximumNumOfTags {
+		return ErrTooManyTags()
+	}
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		if len(tag) == 0 || utf8.RuneCountInString(tag) > types.MaximumLengthOfTag ||
+			strings.Contains(tag, types.KeySeparator) || seen[tag] {
+			return ErrInvalidTag(tag)
+		}
+		seen[tag] = true
+	}
 	return nil
 }
 
+func validateContentReference(ref *types.ContentReference) sdk.Error {
+	if !types.IsValidContentHash(ref.Hash) {
+		return ErrInvalidContentReference("hash must be hex encoded sha256")
+	}
+	if ref.Size <= 0 || ref.Size > types.MaximumContentReferenceSize {
+		return ErrInvalidContentReference("invalid size")
+	}
+	if len(ref.MIMEType) == 0 || len(ref.MIMEType) > types.MaximumLengthOfMIMEType {
+		return ErrInvalidContentReference("invalid MIME type")
+	}
+	if len(ref.URI) == 0 || len(ref.URI) > types.MaximumLengthOfContentURI {
+		return ErrInvalidContentReference("invalid URI")
+	}
+	return nil
+}
+
+// parseCoAuthorShares - co-authors are bounded in number, unique and must include the author.
+// Each share is positive and all shares sum to exactly one
+func parseCoAuthorShares(author types.AccountKey, coAuthors []CoAuthorShare) ([]sdk.Rat, sdk.Error) {
+	if len(coAuthors) > types.MaximumNumOfCoAuthors {
+		return nil, ErrInvalidCoAuthors("too many co-authors")
+	}
+	shares := []sdk.Rat{}
+	total := sdk.ZeroRat()
+	seen := map[types.AccountKey]bool{}
+	for _, coAuthor := range coAuthors {
+		if len(coAuthor.Username) == 0 || seen[coAuthor.Username] {
+			return nil, ErrInvalidCoAuthors("co-author is empty or duplicated")
+		}
+		seen[coAuthor.Username] = true
+		if len(coAuthor.Share) > types.MaximumSdkRatLength {
+			return nil, ErrInvalidCoAuthors("share is too long")
+		}
+		share, err := sdk.NewRatFromDecimal(coAuthor.Share, types.NewRatFromDecimalPrecision)
+		if err != nil {
+			return nil, ErrInvalidCoAuthors("invalid share")
+		}
+		if !share.GT(sdk.ZeroRat()) {
+			return nil, ErrInvalidCoAuthors("share must be positive")
+		}
+		total = total.Add(share)
+		shares = append(shares, share)
+	}
+	if !seen[author] {
+		return nil, ErrInvalidCoAuthors("author must be one of co-authors")
+	}
+	if !total.Equal(sdk.OneRat()) {
+		return nil, ErrInvalidCoAuthors("shares must sum to one")
+	}
+	return shares, nil
+}
+
 // ValidateBasic - implements sdk.Msg
 func (msg DeletePostMsg) ValidateBasic() sdk.Error {
 	if len(msg.PostID) == 0 {
@@ -292,6 +462,31 @@ func (msg ViewMsg) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// ValidateBasic - implements sdk.Msg
+func (msg UnlockPostMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) == 0 {
+		return ErrNoUsername()
+	}
+	if len(msg.Author) == 0 || len(msg.PostID) == 0 {
+		return ErrInvalidTarget()
+	}
+	if _, err := types.LinoToCoin(msg.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateBasic - implements sdk.Msg
+func (msg AcceptCoAuthorMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) == 0 {
+		return ErrNoUsername()
+	}
+	if len(msg.Author) == 0 || len(msg.PostID) == 0 {
+		return ErrInvalidTarget()
+	}
+	return nil
+}
+
 // GetPermission - implements types.Msg
 func (msg CreatePostMsg) GetPermission() types.Permission {
 	return types.AppPermission
@@ -322,6 +517,16 @@ func (msg ViewMsg) GetPermission() types.Permission {
 	return types.AppPermission
 }
 
+// GetPermission - implements types.Msg
+func (msg UnlockPostMsg) GetPermission() types.Permission {
+	return types.PreAuthorizationPermission
+}
+
+// GetPermission - implements types.Msg
+func (msg AcceptCoAuthorMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
 // GetSignBytes - implements sdk.Msg
 func (msg CreatePostMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
@@ -352,6 +557,16 @@ func (msg ViewMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
 }
 
+// GetSignBytes - implements sdk.Msg
+func (msg UnlockPostMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg AcceptCoAuthorMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
 func getSignBytes(msg sdk.Msg) []byte {
 	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
 	if err != nil {
@@ -390,17 +605,29 @@ func (msg ViewMsg) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
 }
 
+// GetSigners - implements sdk.Msg
+func (msg UnlockPostMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetSigners - implements sdk.Msg
+func (msg AcceptCoAuthorMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
 // String implements Stringer
 func (msg CreatePostMsg) String() string {
 	return fmt.Sprintf("Post.CreatePostMsg{author:%v, postID:%v, title:%v, content:%v, parentAuthor:%v,"+
-		"parentPostID:%v, sourceAuthor:%v, sourcePostID:%v,links:%v, redistribution split rate:%v}",
+		"parentPostID:%v, sourceAuthor:%v, sourcePostID:%v,links:%v, redistribution split rate:%v, tags:%v, content reference:%v,"+
+		"price:%v, encrypted content reference:%v, co-authors:%v}",
 		msg.Author, msg.PostID, msg.Title, msg.Content, msg.ParentAuthor, msg.ParentPostID, msg.SourceAuthor, msg.SourcePostID,
-		msg.Links, msg.RedistributionSplitRate)
+		msg.Links, msg.RedistributionSplitRate, msg.Tags, msg.ContentReference, msg.Price, msg.EncryptedContentReference,
+		msg.CoAuthors)
 }
 
 func (msg UpdatePostMsg) String() string {
-	return fmt.Sprintf("Post.UpdatePostMsg{author:%v, postID:%v, title:%v, content:%v, links:%v}",
-		msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links)
+	return fmt.Sprintf("Post.UpdatePostMsg{author:%v, postID:%v, title:%v, content:%v, links:%v, tags:%v}",
+		msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links, msg.Tags)
 }
 
 func (msg DeletePostMsg) String() string {
@@ -425,6 +652,18 @@ func (msg ViewMsg) String() string {
 		msg.Username, msg.Author, msg.PostID)
 }
 
+func (msg UnlockPostMsg) String() string {
+	return fmt.Sprintf(
+		"Post.UnlockPostMsg{from: %v, amount: %v, post author:%v, post id: %v}",
+		msg.Username, msg.Amount, msg.Author, msg.PostID)
+}
+
+func (msg AcceptCoAuthorMsg) String() string {
+	return fmt.Sprintf(
+		"Post.AcceptCoAuthorMsg{from: %v, post author:%v, post id: %v}",
+		msg.Username, msg.Author, msg.PostID)
+}
+
 // GetConsumeAmount - implements types.Msg
 func (msg CreatePostMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
@@ -455,3 +694,14 @@ func (msg ReportOrUpvoteMsg) GetConsumeAmount() types.Coin {
 func (msg ViewMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// GetConsumeAmount - implements types.Msg
+func (msg UnlockPostMsg) GetConsumeAmount() types.Coin {
+	coin, _ := types.LinoToCoin(msg.Amount)
+	return coin
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg AcceptCoAuthorMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
@@ -1,6 +1,7 @@
 package post
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/lino-network/lino/types"
@@ -11,6 +12,9 @@ import (
 )
 
 var (
+	// sha256 of "content"
+	testContentHash = "ed7002b439e9ac845f22357d822bac1444730fbdb6016d3ec9432297b9ec9f73"
+
 	memo1       = "memo1"
 	invalidMemo = "Memo is too long!!! Memo is too long!!! Memo is too long!!! Memo is too long!!! Memo is too long!!! Memo is too long!!! "
 
@@ -56,7 +60,7 @@ func getCommentAndRepost(
 	t *testing.T, parentAuthor, parentPostID, sourceAuthor, sourcePostID string) CreatePostMsg {
 	return NewCreatePostMsg(
 		"author", "TestPostID", string(make([]byte, 100)), string(make([]byte, 1000)),
-		parentAuthor, parentPostID, sourceAuthor, sourcePostID, "0", nil)
+		parentAuthor, parentPostID, sourceAuthor, sourcePostID, "0", nil, nil, nil)
 }
 
 func TestCreatePostMsg(t *testing.T) {
@@ -267,6 +271,278 @@ func TestCreatePostMsg(t *testing.T) {
 			},
 			expectedResult: ErrURLLengthTooLong(),
 		},
+		{
+			testName: "post with tags",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				Tags:                    []string{"music", "音乐", string(make([]byte, 30))},
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "too many tags",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				Tags:                    []string{"a", "b", "c", "d", "e", "f"},
+			},
+			expectedResult: ErrTooManyTags(),
+		},
+		{
+			testName: "empty tag",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				Tags:                    []string{""},
+			},
+			expectedResult: ErrInvalidTag(""),
+		},
+		{
+			testName: "tag is too long",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				Tags:                    []string{string(make([]byte, 31))},
+			},
+			expectedResult: ErrInvalidTag(string(make([]byte, 31))),
+		},
+		{
+			testName: "tag contains key separator",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				Tags:                    []string{"music/rock"},
+			},
+			expectedResult: ErrInvalidTag("music/rock"),
+		},
+		{
+			testName: "duplicate tags",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				Tags:                    []string{"music", "music"},
+			},
+			expectedResult: ErrInvalidTag("music"),
+		},
+		{
+			testName: "off-chain content reference",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				ContentReference: &types.ContentReference{
+					Hash:     testContentHash,
+					Size:     1 << 20,
+					MIMEType: "text/markdown",
+					URI:      "ipfs://QmTestContent",
+				},
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "content reference with inline content",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				Content:                 "content",
+				RedistributionSplitRate: "0",
+				ContentReference: &types.ContentReference{
+					Hash:     testContentHash,
+					Size:     1 << 20,
+					MIMEType: "text/markdown",
+					URI:      "ipfs://QmTestContent",
+				},
+			},
+			expectedResult: ErrInvalidContentReference("inline content must be empty"),
+		},
+		{
+			testName: "content reference with invalid hash",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				ContentReference: &types.ContentReference{
+					Hash:     strings.ToUpper(testContentHash),
+					Size:     1 << 20,
+					MIMEType: "text/markdown",
+					URI:      "ipfs://QmTestContent",
+				},
+			},
+			expectedResult: ErrInvalidContentReference("hash must be hex encoded sha256"),
+		},
+		{
+			testName: "content reference exceeds max size",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				ContentReference: &types.ContentReference{
+					Hash:     testContentHash,
+					Size:     types.MaximumContentReferenceSize + 1,
+					MIMEType: "text/markdown",
+					URI:      "ipfs://QmTestContent",
+				},
+			},
+			expectedResult: ErrInvalidContentReference("invalid size"),
+		},
+		{
+			testName: "content reference without URI",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				ContentReference: &types.ContentReference{
+					Hash:     testContentHash,
+					Size:     1 << 20,
+					MIMEType: "text/markdown",
+				},
+			},
+			expectedResult: ErrInvalidContentReference("invalid URI"),
+		},
+		{
+			testName: "paid post",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				Content:                 "preview",
+				RedistributionSplitRate: "0",
+				Price:                   "10",
+				EncryptedContentReference: &types.ContentReference{
+					Hash:     testContentHash,
+					Size:     1 << 20,
+					MIMEType: "application/octet-stream",
+					URI:      "ipfs://QmEncryptedContent",
+				},
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "paid post without encrypted content reference",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				Price:                   "10",
+			},
+			expectedResult: ErrInvalidPaidPost("encrypted content reference is required"),
+		},
+		{
+			testName: "encrypted content reference without price",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				EncryptedContentReference: &types.ContentReference{
+					Hash:     testContentHash,
+					Size:     1 << 20,
+					MIMEType: "application/octet-stream",
+					URI:      "ipfs://QmEncryptedContent",
+				},
+			},
+			expectedResult: ErrInvalidPaidPost("price is required"),
+		},
+		{
+			testName: "paid post with zero price",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				Price:                   "0",
+				EncryptedContentReference: &types.ContentReference{
+					Hash:     testContentHash,
+					Size:     1 << 20,
+					MIMEType: "application/octet-stream",
+					URI:      "ipfs://QmEncryptedContent",
+				},
+			},
+			expectedResult: ErrInvalidPaidPost("invalid price"),
+		},
+		{
+			testName: "co-authored post",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				CoAuthors: []CoAuthorShare{
+					{Username: author, Share: "0.6"}, {Username: "coAuthor", Share: "0.4"}},
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "co-authors without author",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				CoAuthors: []CoAuthorShare{
+					{Username: "coAuthor1", Share: "0.6"}, {Username: "coAuthor2", Share: "0.4"}},
+			},
+			expectedResult: ErrInvalidCoAuthors("author must be one of co-authors"),
+		},
+		{
+			testName: "duplicate co-author",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				CoAuthors: []CoAuthorShare{
+					{Username: author, Share: "0.6"}, {Username: author, Share: "0.4"}},
+			},
+			expectedResult: ErrInvalidCoAuthors("co-author is empty or duplicated"),
+		},
+		{
+			testName: "shares don't sum to one",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				CoAuthors: []CoAuthorShare{
+					{Username: author, Share: "0.6"}, {Username: "coAuthor", Share: "0.3"}},
+			},
+			expectedResult: ErrInvalidCoAuthors("shares must sum to one"),
+		},
+		{
+			testName: "zero share",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				CoAuthors: []CoAuthorShare{
+					{Username: author, Share: "1"}, {Username: "coAuthor", Share: "0"}},
+			},
+			expectedResult: ErrInvalidCoAuthors("share must be positive"),
+		},
+		{
+			testName: "invalid share",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				CoAuthors: []CoAuthorShare{
+					{Username: author, Share: "0.6"}, {Username: "coAuthor", Share: "share"}},
+			},
+			expectedResult: ErrInvalidCoAuthors("invalid share"),
+		},
+		{
+			testName: "too many co-authors",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				CoAuthors: []CoAuthorShare{
+					{Username: author, Share: "0.5"}, {Username: "coAuthor1", Share: "0.1"},
+					{Username: "coAuthor2", Share: "0.1"}, {Username: "coAuthor3", Share: "0.1"},
+					{Username: "coAuthor4", Share: "0.1"}, {Username: "coAuthor5", Share: "0.1"}},
+			},
+			expectedResult: ErrInvalidCoAuthors("too many co-authors"),
+		},
 	}
 	for _, tc := range testCases {
 		result := tc.msg.ValidateBasic()
@@ -285,65 +561,78 @@ func TestUpdatePostMsg(t *testing.T) {
 		{
 			testName: "normal case 1",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "normal case 2",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "utf8 title",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", maxLenOfUTF8Title, "content", []types.IDToURLMapping{}),
+				"author", "postID", maxLenOfUTF8Title, "content", []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "utf8 content",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", "title", maxLenOfUTF8Content, []types.IDToURLMapping{}),
+				"author", "postID", "title", maxLenOfUTF8Content, []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "no author",
 			updatePostMsg: NewUpdatePostMsg(
-				"", "postID", "title", "content", []types.IDToURLMapping{}),
+				"", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrNoAuthor(),
 		},
 		{
 			testName: "no post id",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "", "title", "content", []types.IDToURLMapping{}),
+				"author", "", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrNoPostID(),
 		},
 		{
 			testName: "post tile is too long",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", string(make([]byte, 101)), "content", []types.IDToURLMapping{}),
+				"author", "postID", string(make([]byte, 101)), "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostTitleExceedMaxLength(),
 		},
 		{
 			testName: "post utf8 tile is too long",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", tooLongOfUTF8Title, "content", []types.IDToURLMapping{}),
+				"author", "postID", tooLongOfUTF8Title, "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostTitleExceedMaxLength(),
 		},
 		{
 			testName: "post content is too long",
 			updatePostMsg: NewUpdatePostMsg(
 				"author", "postID", string(make([]byte, 100)), string(make([]byte, 1001)),
-				[]types.IDToURLMapping{}),
+				[]types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostContentExceedMaxLength(),
 		},
 		{
 			testName: "post utf8 content is too long",
 			updatePostMsg: NewUpdatePostMsg(
 				"author", "postID", string(make([]byte, 100)), tooLongOfUTF8Content,
-				[]types.IDToURLMapping{}),
+				[]types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostContentExceedMaxLength(),
 		},
+		{
+			testName: "update tags",
+			updatePostMsg: NewUpdatePostMsg(
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, []string{"music"}),
+			expectedResult: nil,
+		},
+		{
+			testName: "update with too many tags",
+			updatePostMsg: NewUpdatePostMsg(
+				"author", "postID", "title", "content", []types.IDToURLMapping{},
+				[]string{"a", "b", "c", "d", "e", "f"}),
+			expectedResult: ErrTooManyTags(),
+		},
 	}
 	for _, tc := range testCases {
 		result := tc.updatePostMsg.ValidateBasic()
@@ -523,6 +812,73 @@ func TestDonationMsg(t *testing.T) {
 	}
 }
 
+func TestUnlockPostMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		unlockPostMsg UnlockPostMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			unlockPostMsg: NewUnlockPostMsg("test", types.LNO("1"), "author", "postID", ""),
+			expectedError: nil,
+		},
+		{
+			testName:      "no username",
+			unlockPostMsg: NewUnlockPostMsg("", types.LNO("1"), "author", "postID", ""),
+			expectedError: ErrNoUsername(),
+		},
+		{
+			testName:      "invalid target - no post id",
+			unlockPostMsg: NewUnlockPostMsg("test", types.LNO("1"), "author", "", ""),
+			expectedError: ErrInvalidTarget(),
+		},
+		{
+			testName:      "zero coin is less than lower bound",
+			unlockPostMsg: NewUnlockPostMsg("test", types.LNO("0"), "author", "postID", ""),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.unlockPostMsg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestAcceptCoAuthorMsg(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		acceptCoAuthorMsg AcceptCoAuthorMsg
+		expectedError     sdk.Error
+	}{
+		{
+			testName:          "normal case",
+			acceptCoAuthorMsg: NewAcceptCoAuthorMsg("test", "author", "postID"),
+			expectedError:     nil,
+		},
+		{
+			testName:          "no username",
+			acceptCoAuthorMsg: NewAcceptCoAuthorMsg("", "author", "postID"),
+			expectedError:     ErrNoUsername(),
+		},
+		{
+			testName:          "invalid target - no post id",
+			acceptCoAuthorMsg: NewAcceptCoAuthorMsg("test", "author", ""),
+			expectedError:     ErrInvalidTarget(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.acceptCoAuthorMsg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestReportOrUpvoteMsg(t *testing.T) {
 	testCases := []struct {
 		testName          string
@@ -618,6 +974,16 @@ func TestMsgPermission(t *testing.T) {
 				"author", "postID", "", memo1),
 			expectedPermission: types.PreAuthorizationPermission,
 		},
+		{
+			testName:           "unlock post",
+			msg:                NewUnlockPostMsg("test", types.LNO("1"), "author", "postID", ""),
+			expectedPermission: types.PreAuthorizationPermission,
+		},
+		{
+			testName:           "accept co-author",
+			msg:                NewAcceptCoAuthorMsg("test", "author", "postID"),
+			expectedPermission: types.TransactionPermission,
+		},
 		{
 			testName: "create post",
 			msg: CreatePostMsg{
@@ -660,7 +1026,7 @@ func TestMsgPermission(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedPermission: types.AppPermission,
 		},
 	}
@@ -722,7 +1088,7 @@ func TestGetSignBytes(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 		},
 	}
 
@@ -786,7 +1152,7 @@ func TestGetSigners(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectSigners: []types.AccountKey{"author"},
 		},
 	}
@@ -818,6 +1184,11 @@ func TestGetConsumeAmount(t *testing.T) {
 				"author", "postID", "", memo1),
 			expectAmount: types.NewCoinFromInt64(1 * types.Decimals),
 		},
+		{
+			testName:     "unlock post",
+			msg:          NewUnlockPostMsg("test", types.LNO("1"), "author", "postID", ""),
+			expectAmount: types.NewCoinFromInt64(1 * types.Decimals),
+		},
 		{
 			testName: "create post",
 			msg: CreatePostMsg{
@@ -860,7 +1231,7 @@ func TestGetConsumeAmount(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectAmount: types.NewCoinFromInt64(0),
 		},
 	}
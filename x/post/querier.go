@@ -0,0 +1,394 @@
+package post
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/post/model"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
+	rep "github.com/lino-network/lino/x/reputation"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryPostInfo - query post info, path: info/<permlink>
+	QueryPostInfo = "info"
+	// QueryPostMeta - query post meta, path: meta/<permlink>
+	QueryPostMeta = "meta"
+	// QueryPostReportOrUpvote - query report or upvote of a user, path: reportOrUpvote/<permlink>/<username>
+	QueryPostReportOrUpvote = "reportOrUpvote"
+	// QueryPostComment - query comment of a post, path: comment/<permlink>/<comment permlink>
+	QueryPostComment = "comment"
+	// QueryPostView - query view of a user, path: view/<permlink>/<username>
+	QueryPostView = "view"
+	// QueryPostDonations - query donations of a user, path: donations/<permlink>/<username>
+	QueryPostDonations = "donations"
+	// QueryPostsByAuthor - query all post info of an author, path: postsByAuthor/<author>
+	QueryPostsByAuthor = "postsByAuthor"
+	// QueryDonationEstimate - dry run a donation, path: donationEstimate/<username>/<author>/<postID>/<amount>
+	QueryDonationEstimate = "donationEstimate"
+	// QueryPendingRewards - query pending content rewards of a post, path: pendingRewards/<permlink>
+	QueryPendingRewards = "pendingRewards"
+	// QueryPendingRewardsByAuthor - query pending content rewards of an author, path: pendingRewardsByAuthor/<author>
+	QueryPendingRewardsByAuthor = "pendingRewardsByAuthor"
+	// QueryPostsByTag - query a page of posts under a tag newest first, path: postsByTag/<tag>/<offset>/<limit>
+	QueryPostsByTag = "postsByTag"
+	// QueryCommentTree - query a page of comments with nested replies,
+	// path: commentTree/<permlink>/<sort>/<max depth>/<limit>[/<cursor permlink>]
+	QueryCommentTree = "commentTree"
+	// QueryPostVersions - query all retained previous versions of a post, path: versions/<permlink>
+	QueryPostVersions = "versions"
+	// QueryPostVersion - query a previous version of a post, path: version/<permlink>/<version>
+	QueryPostVersion = "version"
+	// QueryPostsByContentHash - query all posts referencing off-chain content, path: postsByContentHash/<hash>
+	QueryPostsByContentHash = "postsByContentHash"
+	// QueryPostPaywall - query price and encrypted content reference of paid post, path: paywall/<permlink>
+	QueryPostPaywall = "paywall"
+	// QueryPostUnlock - query unlock of paid post by a user, path: unlock/<permlink>/<username>
+	QueryPostUnlock = "unlock"
+	// QueryPostCoAuthors - query co-authors and their revenue shares of a post, path: coAuthors/<permlink>
+	QueryPostCoAuthors = "coAuthors"
+)
+
+// NewQuerier - create a querier which serves custom queries under post route
+func NewQuerier(
+	pm PostManager, am acc.AccountManager, gm global.GlobalManager,
+	dm dev.DeveloperManager, rm rep.ReputationManager) sdk.Querier {
+	cdc := wire.NewCodec()
+	wire.RegisterCrypto(cdc)
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) == 0 {
+			return nil, types.ErrUnknownQueryPath("")
+		}
+		switch path[0] {
+		case QueryPostInfo:
+			return queryPostInfo(ctx, cdc, path[1:], pm)
+		case QueryPostMeta:
+			return queryPostMeta(ctx, cdc, path[1:], pm)
+		case QueryPostReportOrUpvote:
+			return queryPostReportOrUpvote(ctx, cdc, path[1:], pm)
+		case QueryPostComment:
+			return queryPostComment(ctx, cdc, path[1:], pm)
+		case QueryPostView:
+			return queryPostView(ctx, cdc, path[1:], pm)
+		case QueryPostDonations:
+			return queryPostDonations(ctx, cdc, path[1:], pm)
+		case QueryPostsByAuthor:
+			return queryPostsByAuthor(ctx, cdc, path[1:], pm)
+		case QueryDonationEstimate:
+			return queryDonationEstimate(ctx, cdc, path[1:], pm, am, gm, dm, rm)
+		case QueryPendingRewards:
+			return queryPendingRewards(ctx, cdc, path[1:], pm, rm)
+		case QueryPendingRewardsByAuthor:
+			return queryPendingRewardsByAuthor(ctx, cdc, path[1:], pm, rm)
+		case QueryPostsByTag:
+			return queryPostsByTag(ctx, cdc, path[1:], pm)
+		case QueryCommentTree:
+			return queryCommentTree(ctx, cdc, path[1:], pm)
+		case QueryPostVersions:
+			return queryPostVersions(ctx, cdc, path[1:], pm)
+		case QueryPostVersion:
+			return queryPostVersion(ctx, cdc, path[1:], pm)
+		case QueryPostsByContentHash:
+			return queryPostsByContentHash(ctx, cdc, path[1:], pm)
+		case QueryPostPaywall:
+			return queryPostPaywall(ctx, cdc, path[1:], pm)
+		case QueryPostUnlock:
+			return queryPostUnlock(ctx, cdc, path[1:], pm)
+		case QueryPostCoAuthors:
+			return queryPostCoAuthors(ctx, cdc, path[1:], pm)
+		default:
+			return nil, types.ErrUnknownQueryPath(path[0])
+		}
+	}
+}
+
+func queryPostInfo(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, types.Permlink(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, postInfo)
+}
+
+func queryPostMeta(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, types.Permlink(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, postMeta)
+}
+
+func queryPostReportOrUpvote(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 2); err != nil {
+		return nil, err
+	}
+	reportOrUpvote, err := pm.postStorage.GetPostReportOrUpvote(
+		ctx, types.Permlink(path[0]), types.AccountKey(path[1]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, reportOrUpvote)
+}
+
+func queryPostComment(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 2); err != nil {
+		return nil, err
+	}
+	comment, err := pm.postStorage.GetPostComment(
+		ctx, types.Permlink(path[0]), types.Permlink(path[1]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, comment)
+}
+
+func queryPostView(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 2); err != nil {
+		return nil, err
+	}
+	view, err := pm.postStorage.GetPostView(
+		ctx, types.Permlink(path[0]), types.AccountKey(path[1]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, view)
+}
+
+func queryPostDonations(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 2); err != nil {
+		return nil, err
+	}
+	donations, err := pm.postStorage.GetPostDonations(
+		ctx, types.Permlink(path[0]), types.AccountKey(path[1]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, donations)
+}
+
+func queryPostsByAuthor(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	postInfos, err := pm.postStorage.GetPostInfosByAuthor(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, postInfos)
+}
+
+func queryDonationEstimate(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager, am acc.AccountManager,
+	gm global.GlobalManager, dm dev.DeveloperManager, rm rep.ReputationManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 4); err != nil {
+		return nil, err
+	}
+	msg := NewDonateMsg(path[0], types.LNO(path[3]), path[1], path[2], "", "")
+	estimate, err := EstimateDonation(ctx, msg, pm, am, gm, dm, rm)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, estimate)
+}
+
+func queryPendingRewards(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager, rm rep.ReputationManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	pendingRewards, err := pm.GetPendingRewardsOfPost(ctx, types.Permlink(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := getPendingRewardStatuses(ctx, pendingRewards, pm, rm)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, statuses)
+}
+
+func queryPendingRewardsByAuthor(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager, rm rep.ReputationManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	pendingRewards, err := pm.GetPendingRewardsOfAuthor(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := getPendingRewardStatuses(ctx, pendingRewards, pm, rm)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, statuses)
+}
+
+func queryPostsByTag(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 3); err != nil {
+		return nil, err
+	}
+	offset, parseErr := strconv.ParseInt(path[1], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	limit, parseErr := strconv.ParseInt(path[2], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	page, err := pm.GetPostsByTag(ctx, path[0], offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, page)
+}
+
+func queryCommentTree(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	// cursor is omitted for the first page
+	expected := 4
+	if len(path) > expected {
+		expected = 5
+	}
+	if err := types.CheckQueryParams(path, expected); err != nil {
+		return nil, err
+	}
+	maxDepth, parseErr := strconv.ParseInt(path[2], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	limit, parseErr := strconv.ParseInt(path[3], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	filter := CommentTreeFilter{
+		Sort:     path[1],
+		MaxDepth: maxDepth,
+		Limit:    limit,
+	}
+	if len(path) == 5 {
+		filter.Cursor = types.Permlink(path[4])
+	}
+	tree, err := pm.GetCommentTree(ctx, types.Permlink(path[0]), filter)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, tree)
+}
+
+func queryPostVersions(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	versions, err := pm.GetPostVersions(ctx, types.Permlink(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, versions)
+}
+
+func queryPostVersion(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 2); err != nil {
+		return nil, err
+	}
+	version, parseErr := strconv.ParseInt(path[1], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryParams(parseErr.Error())
+	}
+	postVersion, err := pm.GetPostVersion(ctx, types.Permlink(path[0]), version)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, postVersion)
+}
+
+// getPendingRewardStatuses - attach current penalty score of the post to each pending reward
+func getPendingRewardStatuses(
+	ctx sdk.Context, pendingRewards []model.PendingReward,
+	pm PostManager, rm rep.ReputationManager) ([]model.PendingRewardStatus, sdk.Error) {
+	penaltyScores := map[types.Permlink]sdk.Rat{}
+	statuses := []model.PendingRewardStatus{}
+	for _, reward := range pendingRewards {
+		permlink := types.GetPermlink(reward.Author, reward.PostID)
+		penaltyScore, ok := penaltyScores[permlink]
+		if !ok {
+			event := RewardEvent{PostAuthor: reward.Author, PostID: reward.PostID}
+			score, err := event.penaltyScore(ctx, pm, rm)
+			if err != nil {
+				return nil, err
+			}
+			penaltyScores[permlink] = score
+			penaltyScore = score
+		}
+		statuses = append(statuses, model.PendingRewardStatus{
+			Reward:       reward,
+			PenaltyScore: penaltyScore,
+		})
+	}
+	return statuses, nil
+}
+
+func queryPostsByContentHash(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, pm.GetPostsByContentHash(ctx, path[0]))
+}
+
+func queryPostPaywall(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	paywall, err := pm.GetPostPaywall(ctx, types.Permlink(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, paywall)
+}
+
+func queryPostUnlock(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 2); err != nil {
+		return nil, err
+	}
+	unlock, err := pm.GetPostUnlock(ctx, types.Permlink(path[0]), types.AccountKey(path[1]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, unlock)
+}
+
+func queryPostCoAuthors(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	coAuthors, err := pm.GetPostCoAuthors(ctx, types.Permlink(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, coAuthors)
+}
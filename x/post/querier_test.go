@@ -0,0 +1,54 @@
+package post
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+	"github.com/stretchr/testify/assert"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestQueryPost(t *testing.T) {
+	ctx, am, _, pm, gm, dm, _, rm := setupTest(t, 1)
+	querier := NewQuerier(pm, am, gm, dm, rm)
+	cdc := wire.NewCodec()
+
+	user1, postID1 := createTestPost(t, ctx, "user1", "postID1", am, pm, "0")
+	_, postID2 := createTestRepost(t, ctx, "user2", "postID2", am, pm, user1, postID1)
+	permlink := types.GetPermlink(user1, postID1)
+
+	res, err := querier(ctx, []string{QueryPostInfo, string(permlink)}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	postInfo := new(model.PostInfo)
+	assert.Nil(t, cdc.UnmarshalJSON(res, postInfo))
+	assert.Equal(t, user1, postInfo.Author)
+	assert.Equal(t, postID1, postInfo.PostID)
+
+	res, err = querier(ctx, []string{QueryPostMeta, string(permlink)}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	postMeta := new(model.PostMeta)
+	assert.Nil(t, cdc.UnmarshalJSON(res, postMeta))
+	assert.Equal(t, ctx.BlockHeader().Time.Unix(), postMeta.CreatedAt)
+
+	res, err = querier(ctx, []string{QueryPostsByAuthor, string(user1)}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	var postInfos []model.PostInfo
+	assert.Nil(t, cdc.UnmarshalJSON(res, &postInfos))
+	assert.Equal(t, 1, len(postInfos))
+	assert.Equal(t, postID1, postInfos[0].PostID)
+
+	res, err = querier(ctx, []string{QueryPostsByAuthor, "user2"}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	assert.Nil(t, cdc.UnmarshalJSON(res, &postInfos))
+	assert.Equal(t, 1, len(postInfos))
+	assert.Equal(t, postID2, postInfos[0].PostID)
+
+	_, err = querier(ctx, []string{QueryPostInfo, "user3#postID"}, abci.RequestQuery{})
+	assert.Equal(t, model.ErrPostNotFound(model.GetPostInfoKey("user3#postID")), err)
+
+	_, err = querier(ctx, []string{"unknown"}, abci.RequestQuery{})
+	assert.Equal(t, types.ErrUnknownQueryPath("unknown"), err)
+}
@@ -119,7 +119,7 @@ func createTestPost(
 	err = pm.CreatePost(
 		ctx, types.AccountKey(user), postID, "", "", "", "",
 		string(make([]byte, 1000)), string(make([]byte, 50)),
-		splitRate, []types.IDToURLMapping{})
+		splitRate, []types.IDToURLMapping{}, nil, nil)
 	assert.Nil(t, err)
 	return user, postID
 }
@@ -133,7 +133,7 @@ func createTestRepost(
 	err := pm.CreatePost(
 		ctx, types.AccountKey(user), postID, sourceUser, sourcePostID, "", "",
 		string(make([]byte, 1000)), string(make([]byte, 50)),
-		sdk.ZeroRat(), []types.IDToURLMapping{})
+		sdk.ZeroRat(), []types.IDToURLMapping{}, nil, nil)
 	assert.Nil(t, err)
 	return user, postID
 }
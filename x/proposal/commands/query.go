@@ -10,13 +10,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/proposal"
 	"github.com/lino-network/lino/x/proposal/model"
 )
 
 // GetProposalCmd returns a specific ongoing proposal
-func GetOngoingProposalCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetOngoingProposalCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -27,9 +28,9 @@ func GetOngoingProposalCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 }
 
 // GetProposalCmd returns a specific expired proposal
-func GetExpiredProposalCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetExpiredProposalCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -40,8 +41,8 @@ func GetExpiredProposalCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 }
 
 type commander struct {
-	storeName string
-	cdc       *wire.Codec
+	queryRoute string
+	cdc        *wire.Codec
 }
 
 func (c commander) getOngoingProposalCmd(cmd *cobra.Command, args []string) error {
@@ -52,7 +53,7 @@ func (c commander) getOngoingProposalCmd(cmd *cobra.Command, args []string) erro
 
 	proposalID := types.ProposalKey(args[0])
 
-	res, err := ctx.Query(model.GetOngoingProposalKey(proposalID), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, proposal.QueryOngoingProposal, string(proposalID))
 	if err != nil {
 		return err
 	}
@@ -78,7 +79,7 @@ func (c commander) getExpiredProposalCmd(cmd *cobra.Command, args []string) erro
 
 	proposalID := types.ProposalKey(args[0])
 
-	res, err := ctx.Query(model.GetExpiredProposalKey(proposalID), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, proposal.QueryExpiredProposal, string(proposalID))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,52 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/proposal"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecoverFailedEventTxCmd will create a recoverFailedEvent tx and sign it with the given key
+func RecoverFailedEventTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recover-failed-event",
+		Short: "propose to replay or drop a failed time event",
+		RunE:  sendRecoverFailedEventTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "proposal creator")
+	cmd.Flags().Int64(client.FlagEventID, -1, "failed event id")
+	cmd.Flags().Bool(client.FlagReplay, false, "replay the event, drop it if false")
+	cmd.Flags().String(client.FlagReason, "", "reason of the proposal")
+	return cmd
+}
+
+func sendRecoverFailedEventTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		creator := viper.GetString(client.FlagUser)
+		eventID := viper.GetInt64(client.FlagEventID)
+		replay := viper.GetBool(client.FlagReplay)
+		reason := viper.GetString(client.FlagReason)
+
+		// create the message
+		msg := proposal.NewRecoverFailedEventMsg(creator, eventID, replay, reason)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
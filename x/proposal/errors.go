@@ -71,3 +71,38 @@ func ErrCensorshipPostNotFound() sdk.Error {
 func ErrIllegalParameter() sdk.Error {
 	return types.NewError(types.CodeIllegalParameter, fmt.Sprintf("invalid parameter"))
 }
+
+// ErrInvalidUpgradeName - error if protocol upgrade name is invalid
+func ErrInvalidUpgradeName() sdk.Error {
+	return types.NewError(types.CodeInvalidUpgradeName, fmt.Sprintf("invalid upgrade name"))
+}
+
+// ErrInvalidUpgradeHeight - error if protocol upgrade height is invalid
+func ErrInvalidUpgradeHeight() sdk.Error {
+	return types.NewError(types.CodeInvalidUpgradeHeight, fmt.Sprintf("invalid upgrade height"))
+}
+
+// ErrUpgradeHeightPassed - error if passed protocol upgrade height is already reached
+func ErrUpgradeHeightPassed(name string, height int64) sdk.Error {
+	return types.NewError(types.CodeUpgradeHeightPassed, fmt.Sprintf("upgrade %s height %v already passed", name, height))
+}
+
+// ErrUpgradeAlreadyScheduled - error if upgrade with same name is scheduled or applied
+func ErrUpgradeAlreadyScheduled(name string) sdk.Error {
+	return types.NewError(types.CodeUpgradeAlreadyScheduled, fmt.Sprintf("upgrade %s is already scheduled", name))
+}
+
+// ErrUnknownUpgrade - error if scheduled upgrade has no handler in this binary
+func ErrUnknownUpgrade(name string, height int64) sdk.Error {
+	return types.NewError(types.CodeUnknownUpgrade, fmt.Sprintf("upgrade %s needed at height %v, binary doesn't know it", name, height))
+}
+
+// ErrInvalidFailedEventID - error if failed event id is invalid
+func ErrInvalidFailedEventID() sdk.Error {
+	return types.NewError(types.CodeInvalidFailedEventID, fmt.Sprintf("invalid failed event id"))
+}
+
+// ErrFailedEventNotFound - error if failed event is not in dead-letter store
+func ErrFailedEventNotFound(id int64) sdk.Error {
+	return types.NewError(types.CodeProposalFailedEventNotFound, fmt.Sprintf("failed event %v not found", id))
+}
@@ -76,6 +76,10 @@ func (dpe DecideProposalEvent) Execute(
 		if err := dpe.ExecuteProtocolUpgrade(ctx, dpe.ProposalID, proposalManager); err != nil {
 			return err
 		}
+	case types.FailedEventRecovery:
+		if err := dpe.ExecuteFailedEventRecovery(ctx, dpe.ProposalID, proposalManager, gm); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -113,8 +117,33 @@ func (dpe DecideProposalEvent) ExecuteContentCensorship(
 	return nil
 }
 
-// ExecuteProtocolUpgrade - since execute protocol upgrade engage code change, the process need to be done manually
+// ExecuteProtocolUpgrade - schedule upgrade plan, the registered upgrade handlers
+// run in begin blocker once the target height is reached
 func (dpe DecideProposalEvent) ExecuteProtocolUpgrade(
 	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager) sdk.Error {
-	return nil
+	return proposalManager.ScheduleUpgrade(ctx, curID)
+}
+
+// ExecuteFailedEventRecovery - replay or drop target failed event in dead-letter store
+func (dpe DecideProposalEvent) ExecuteFailedEventRecovery(
+	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
+	gm global.GlobalManager) sdk.Error {
+	eventID, replay, err := proposalManager.GetFailedEventAction(ctx, curID)
+	if err != nil {
+		return err
+	}
+	if replay {
+		return gm.ReplayFailedEvent(ctx, eventID)
+	}
+	failedEvent, err := gm.GetFailedEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	// coin of dropped coin return can't reach its owner, add it back to inflation pool
+	if returnEvent, ok := failedEvent.Event.(acc.ReturnCoinEvent); ok {
+		if err := gm.AddToValidatorInflationPool(ctx, returnEvent.Amount); err != nil {
+			return err
+		}
+	}
+	return gm.DropFailedEvent(ctx, eventID)
 }
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
+	acc "github.com/lino-network/lino/x/account"
 	"github.com/lino-network/lino/x/proposal/model"
 	"github.com/stretchr/testify/assert"
 )
@@ -218,3 +219,42 @@ func TestDecideProposal(t *testing.T) {
 		assert.Equal(t, expectExpiredProposalList, expiredList)
 	}
 }
+
+func TestExecuteFailedEventRecovery(t *testing.T) {
+	ctx, _, pm, _, _, _, gm := setupTest(t, 0)
+	pm.InitGenesis(ctx)
+
+	returnEvent := acc.ReturnCoinEvent{Username: "nonexist", Amount: types.NewCoinFromInt64(100)}
+	assert.Nil(t, gm.AddFailedEvent(ctx, returnEvent, 0, acc.ErrAccountNotFound("nonexist")))
+	assert.Nil(t, gm.AddFailedEvent(ctx, returnEvent, 0, acc.ErrAccountNotFound("nonexist")))
+
+	addExpiredFailedEventProposal := func(eventID int64, replay bool) types.ProposalKey {
+		proposal := pm.CreateFailedEventProposal(ctx, eventID, replay, "")
+		proposalID, err := pm.AddProposal(ctx, types.AccountKey("creator"), proposal, 10)
+		assert.Nil(t, err)
+		assert.Nil(t, pm.storage.DeleteOngoingProposal(ctx, proposalID))
+		assert.Nil(t, pm.storage.SetExpiredProposal(ctx, proposalID, proposal))
+		return proposalID
+	}
+	event := DecideProposalEvent{ProposalType: types.FailedEventRecovery}
+
+	// replay gives failed event one more retry
+	assert.Nil(t, event.ExecuteFailedEventRecovery(ctx, addExpiredFailedEventProposal(1, true), pm, gm))
+	failedEvent, err := gm.GetFailedEvent(ctx, 1)
+	assert.Nil(t, err)
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+	assert.Equal(t, proposalParam.FailedEventMaxRetries+1, failedEvent.RemainingRetries)
+
+	// coin of dropped coin return is added back to inflation pool, drain pool first
+	_, err = gm.GetValidatorHourlyInflation(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, event.ExecuteFailedEventRecovery(ctx, addExpiredFailedEventProposal(2, false), pm, gm))
+	assert.False(t, gm.DoesFailedEventExist(ctx, 2))
+	assert.True(t, gm.DoesFailedEventExist(ctx, 1))
+	pool, err := gm.GetValidatorHourlyInflation(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, returnEvent.Amount, pool)
+
+	// dropped event can't be dropped again
+	assert.NotNil(t, event.ExecuteFailedEventRecovery(ctx, addExpiredFailedEventProposal(2, false), pm, gm))
+}
@@ -25,6 +25,8 @@ func NewHandler(
 			return handleContentCensorshipMsg(ctx, am, proposalManager, postManager, gm, msg)
 		case ProtocolUpgradeMsg:
 			return handleProtocolUpgradeMsg(ctx, am, proposalManager, gm, msg)
+		case FailedEventMsg:
+			return handleFailedEventMsg(ctx, am, proposalManager, gm, msg)
 		case VoteProposalMsg:
 			return handleVoteProposalMsg(ctx, proposalManager, vm, msg)
 		default:
@@ -70,7 +72,13 @@ func handleChangeParamMsg(
 		param.ChangeParamDecideSec, param.ChangeParamMinDeposit); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionChangeParam),
+			types.TagSender, []byte(msg.GetCreator()),
+			types.TagProposalID, []byte(proposalID),
+		),
+	}
 }
 
 func handleProtocolUpgradeMsg(
@@ -85,7 +93,16 @@ func handleProtocolUpgradeMsg(
 		return err.Result()
 	}
 
-	proposal := pm.CreateProtocolUpgradeProposal(ctx, msg.GetLink(), msg.GetReason())
+	// a block takes at least one second, upgrade height must not be reached
+	// before the proposal is decided
+	if err := pm.CheckUpgradePlan(
+		ctx, msg.GetName(), msg.GetHeight(),
+		ctx.BlockHeight()+param.ProtocolUpgradeDecideSec); err != nil {
+		return err.Result()
+	}
+
+	proposal := pm.CreateProtocolUpgradeProposal(
+		ctx, msg.GetName(), msg.GetHeight(), msg.GetLink(), msg.GetReason())
 	proposalID, err := pm.AddProposal(ctx, msg.GetCreator(), proposal, param.ProtocolUpgradeDecideSec)
 	if err != nil {
 		return err.Result()
@@ -109,7 +126,63 @@ func handleProtocolUpgradeMsg(
 		param.ProtocolUpgradeDecideSec, param.ProtocolUpgradeMinDeposit); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionProtocolUpgrade),
+			types.TagSender, []byte(msg.GetCreator()),
+			types.TagProposalID, []byte(proposalID),
+		),
+	}
+}
+
+func handleFailedEventMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm global.GlobalManager,
+	msg FailedEventMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.GetCreator()) {
+		return ErrAccountNotFound().Result()
+	}
+
+	if !gm.DoesFailedEventExist(ctx, msg.GetEventID()) {
+		return ErrFailedEventNotFound(msg.GetEventID()).Result()
+	}
+
+	// failed event proposal shares requirements with parameter change proposal
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+
+	proposal := pm.CreateFailedEventProposal(ctx, msg.GetEventID(), msg.GetReplay(), msg.GetReason())
+	proposalID, err := pm.AddProposal(ctx, msg.GetCreator(), proposal, param.ChangeParamDecideSec)
+	if err != nil {
+		return err.Result()
+	}
+	//  set a time event to decide the proposal
+	event := pm.CreateDecideProposalEvent(ctx, types.FailedEventRecovery, proposalID)
+
+	if err := gm.RegisterProposalDecideEvent(ctx, param.ChangeParamDecideSec, event); err != nil {
+		return err.Result()
+	}
+
+	// minus coin from account and return when deciding the proposal
+	if err = am.MinusSavingCoin(
+		ctx, msg.GetCreator(), param.ChangeParamMinDeposit,
+		"", string(proposalID), types.ProposalDeposit); err != nil {
+		return err.Result()
+	}
+
+	if err := returnCoinTo(
+		ctx, msg.GetCreator(), gm, am, int64(1),
+		param.ChangeParamDecideSec, param.ChangeParamMinDeposit); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionFailedEvent),
+			types.TagSender, []byte(msg.GetCreator()),
+			types.TagProposalID, []byte(proposalID),
+		),
+	}
 }
 
 func handleContentCensorshipMsg(
@@ -159,7 +232,14 @@ func handleContentCensorshipMsg(
 		param.ContentCensorshipDecideSec, param.ContentCensorshipMinDeposit); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionContentCensorship),
+			types.TagSender, []byte(msg.GetCreator()),
+			types.TagPermlink, []byte(msg.GetPermlink()),
+			types.TagProposalID, []byte(proposalID),
+		),
+	}
 }
 
 func handleVoteProposalMsg(ctx sdk.Context, proposalManager ProposalManager, vm vote.VoteManager, msg VoteProposalMsg) sdk.Result {
@@ -185,7 +265,13 @@ func handleVoteProposalMsg(ctx sdk.Context, proposalManager ProposalManager, vm
 		return err.Result()
 	}
 
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionVoteProposal),
+			types.TagSender, []byte(msg.Voter),
+			types.TagProposalID, []byte(msg.ProposalID),
+		),
+	}
 }
 
 func returnCoinTo(
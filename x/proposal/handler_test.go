@@ -69,9 +69,15 @@ func TestChangeParamProposal(t *testing.T) {
 				Creator:   user1,
 				Parameter: allocation,
 			},
-			proposalID:          proposalID1,
-			wantOK:              true,
-			wantRes:             sdk.Result{},
+			proposalID: proposalID1,
+			wantOK:     true,
+			wantRes: sdk.Result{
+				Tags: sdk.NewTags(
+					types.TagAction, []byte(types.ActionChangeParam),
+					types.TagSender, []byte(user1),
+					types.TagProposalID, []byte(proposalID1),
+				),
+			},
 			wantCreatorBalance:  c460000.Minus(proposalParam.ChangeParamMinDeposit),
 			wantOngoingProposal: []model.Proposal{proposal1},
 			wantProposal:        proposal1,
@@ -165,12 +171,19 @@ func TestContentCensorshipProposal(t *testing.T) {
 		wantProposal        model.Proposal
 	}{
 		{
-			testName:            "user2 censorship user1's post successfully",
-			creator:             user2,
-			permlink:            types.GetPermlink(user1, postID1),
-			proposalID:          proposalID1,
-			wantOK:              true,
-			wantRes:             sdk.Result{},
+			testName:   "user2 censorship user1's post successfully",
+			creator:    user2,
+			permlink:   types.GetPermlink(user1, postID1),
+			proposalID: proposalID1,
+			wantOK:     true,
+			wantRes: sdk.Result{
+				Tags: sdk.NewTags(
+					types.TagAction, []byte(types.ActionContentCensorship),
+					types.TagSender, []byte(user2),
+					types.TagPermlink, []byte(types.GetPermlink(user1, postID1)),
+					types.TagProposalID, []byte(proposalID1),
+				),
+			},
 			wantCreatorBalance:  c4600.Minus(proposalParam.ContentCensorshipMinDeposit),
 			wantOngoingProposal: []model.Proposal{proposal1},
 			wantProposal:        proposal1,
@@ -260,6 +273,123 @@ func TestContentCensorshipProposal(t *testing.T) {
 	}
 }
 
+func TestProtocolUpgradeProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 10)
+	handler := NewHandler(am, proposalManager, postManager, gm, vm)
+	proposalManager.InitGenesis(ctx)
+
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	minHeight := ctx.BlockHeight() + proposalParam.ProtocolUpgradeDecideSec
+	user1 := createTestAccount(ctx, am, "user1", proposalParam.ProtocolUpgradeMinDeposit.Plus(c460000))
+	assert.Nil(t, proposalManager.storage.SetUpgradePlan(ctx, &model.UpgradePlan{
+		Name: "scheduled", Height: minHeight + 1, ProposalID: "0"}))
+
+	testCases := []struct {
+		testName string
+		msg      UpgradeProtocolMsg
+		wantRes  sdk.Result
+	}{
+		{
+			testName: "upgrade height reached before proposal is decided",
+			msg:      NewUpgradeProtocolMsg("user1", "upgrade1", minHeight, "link", ""),
+			wantRes:  ErrUpgradeHeightPassed("upgrade1", minHeight).Result(),
+		},
+		{
+			testName: "upgrade name already scheduled",
+			msg:      NewUpgradeProtocolMsg("user1", "scheduled", minHeight+1, "link", ""),
+			wantRes:  ErrUpgradeAlreadyScheduled("scheduled").Result(),
+		},
+		{
+			testName: "user1 creates protocol upgrade proposal successfully",
+			msg:      NewUpgradeProtocolMsg("user1", "upgrade1", minHeight+1, "link", ""),
+			wantRes: sdk.Result{
+				Tags: sdk.NewTags(
+					types.TagAction, []byte(types.ActionProtocolUpgrade),
+					types.TagSender, []byte(user1),
+					types.TagProposalID, []byte("1"),
+				),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
+		}
+	}
+
+	creatorBalance, _ := am.GetSavingFromBank(ctx, user1)
+	assert.True(t, creatorBalance.IsEqual(c460000))
+}
+
+func TestFailedEventProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, gm, vm)
+	proposalManager.InitGenesis(ctx)
+
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	user1 := createTestAccount(ctx, am, "user1", c460000)
+	createTestAccount(ctx, am, "user2", c46)
+	failedEvent := acc.ReturnCoinEvent{Username: "nonexist", Amount: c46}
+	assert.Nil(t, gm.AddFailedEvent(ctx, failedEvent, 0, acc.ErrAccountNotFound("nonexist")))
+
+	testCases := []struct {
+		testName           string
+		msg                RecoverFailedEventMsg
+		wantRes            sdk.Result
+		wantCreatorBalance types.Coin
+	}{
+		{
+			testName:           "creator doesn't exist",
+			msg:                NewRecoverFailedEventMsg("nonexist", 1, false, ""),
+			wantRes:            ErrAccountNotFound().Result(),
+			wantCreatorBalance: types.NewCoinFromInt64(0),
+		},
+		{
+			testName:           "failed event doesn't exist",
+			msg:                NewRecoverFailedEventMsg("user1", 2, false, ""),
+			wantRes:            ErrFailedEventNotFound(2).Result(),
+			wantCreatorBalance: c460000,
+		},
+		{
+			testName: "user1 creates failed event proposal successfully",
+			msg:      NewRecoverFailedEventMsg("user1", 1, true, "replay"),
+			wantRes: sdk.Result{
+				Tags: sdk.NewTags(
+					types.TagAction, []byte(types.ActionFailedEvent),
+					types.TagSender, []byte(user1),
+					types.TagProposalID, []byte("1"),
+				),
+			},
+			wantCreatorBalance: c460000.Minus(proposalParam.ChangeParamMinDeposit),
+		},
+		{
+			testName:           "user2 doesn't have enough money to create proposal",
+			msg:                NewRecoverFailedEventMsg("user2", 1, false, ""),
+			wantRes:            acc.ErrAccountSavingCoinNotEnough().Result(),
+			wantCreatorBalance: c46,
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
+		}
+		creatorBalance, _ := am.GetSavingFromBank(ctx, tc.msg.GetCreator())
+		if tc.msg.GetCreator() != "nonexist" && !creatorBalance.IsEqual(tc.wantCreatorBalance) {
+			t.Errorf("%s: diff bank balance: got %v, want %v", tc.testName, creatorBalance, tc.wantCreatorBalance)
+		}
+	}
+
+	proposal, err := proposalManager.storage.GetOngoingProposal(ctx, "1")
+	assert.Nil(t, err)
+	p, ok := proposal.(*model.FailedEventProposal)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), p.EventID)
+	assert.True(t, p.Replay)
+	assert.Equal(t, user1, p.Creator)
+}
+
 func TestAddFrozenMoney(t *testing.T) {
 	ctx, am, proposalManager, _, _, _, gm := setupTest(t, 0)
 	proposalManager.InitGenesis(ctx)
@@ -404,8 +534,14 @@ func TestVoteProposalBasic(t *testing.T) {
 				ProposalID: proposalID1,
 				Result:     true,
 			},
-			wantRes: sdk.Result{},
-			wantOK:  true,
+			wantRes: sdk.Result{
+				Tags: sdk.NewTags(
+					types.TagAction, []byte(types.ActionVoteProposal),
+					types.TagSender, []byte(user1),
+					types.TagProposalID, []byte(proposalID1),
+				),
+			},
+			wantOK: true,
 			wantProposal: &model.ContentCensorshipProposal{
 				ProposalInfo: model.ProposalInfo{
 					Creator:       user1,
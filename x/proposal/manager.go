@@ -53,13 +53,26 @@ func (pm ProposalManager) CreateContentCensorshipProposal(
 }
 
 // CreateProtocolUpgradeProposal - create a protocol upgrade proposal
-func (pm ProposalManager) CreateProtocolUpgradeProposal(ctx sdk.Context, link string, reason string) model.Proposal {
+func (pm ProposalManager) CreateProtocolUpgradeProposal(
+	ctx sdk.Context, name string, height int64, link string, reason string) model.Proposal {
 	return &model.ProtocolUpgradeProposal{
+		Name:   name,
+		Height: height,
 		Link:   link,
 		Reason: reason,
 	}
 }
 
+// CreateFailedEventProposal - create a proposal to replay or drop failed event
+func (pm ProposalManager) CreateFailedEventProposal(
+	ctx sdk.Context, eventID int64, replay bool, reason string) model.Proposal {
+	return &model.FailedEventProposal{
+		EventID: eventID,
+		Replay:  replay,
+		Reason:  reason,
+	}
+}
+
 // CreateChangeParamProposal - create a change parameters proposal
 func (pm ProposalManager) CreateChangeParamProposal(
 	ctx sdk.Context, parameter param.Parameter, reason string) model.Proposal {
@@ -138,6 +151,8 @@ func (pm ProposalManager) GetProposalPassParam(
 		return param.ContentCensorshipPassRatio, param.ContentCensorshipPassVotes, nil
 	case types.ProtocolUpgrade:
 		return param.ProtocolUpgradePassRatio, param.ProtocolUpgradePassVotes, nil
+	case types.FailedEventRecovery:
+		return param.ChangeParamPassRatio, param.ChangeParamPassVotes, nil
 	default:
 		return sdk.NewRat(1, 1), types.NewCoinFromInt64(0), ErrIncorrectProposalType()
 	}
@@ -286,7 +301,32 @@ func (pm ProposalManager) GetPermlink(ctx sdk.Context, proposalID types.Proposal
 	return p.Permlink, nil
 }
 
+// GetFailedEventAction - get failed event id and whether to replay it from a failed event proposal
+func (pm ProposalManager) GetFailedEventAction(
+	ctx sdk.Context, proposalID types.ProposalKey) (int64, bool, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	p, ok := proposal.(*model.FailedEventProposal)
+	if !ok {
+		return 0, false, ErrIncorrectProposalType()
+	}
+	return p.EventID, p.Replay, nil
+}
+
 // GetOngoingProposalList - get ongoing proposal list
 func (pm ProposalManager) GetOngoingProposalList(ctx sdk.Context) ([]model.Proposal, sdk.Error) {
 	return pm.storage.GetOngoingProposalList(ctx)
 }
+
+// Export - export proposal KVStore for genesis
+func (pm ProposalManager) Export(ctx sdk.Context) (*model.ProposalTables, sdk.Error) {
+	return pm.storage.Export(ctx)
+}
+
+// Import - import proposal KVStore from exported genesis
+func (pm ProposalManager) Import(ctx sdk.Context, tables *model.ProposalTables) sdk.Error {
+	return pm.storage.Import(ctx, tables)
+}
@@ -9,6 +9,7 @@ import (
 // 1) change parameter proposal
 // 2) content censorship proposal
 // 3) protocol upgrade proposal
+// 4) failed event proposal
 type Proposal interface {
 	GetProposalInfo() ProposalInfo
 	SetProposalInfo(ProposalInfo)
@@ -52,9 +53,12 @@ func (p *ContentCensorshipProposal) GetProposalInfo() ProposalInfo { return p.Pr
 // SetProposalInfo - implements Proposal
 func (p *ContentCensorshipProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
 
-// ProtocolUpgradeProposal - protocol upgrade proposal
+// ProtocolUpgradeProposal - protocol upgrade proposal, once passed the
+// upgrade handlers registered under Name run at block Height
 type ProtocolUpgradeProposal struct {
 	ProposalInfo
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
 	Link   string `json:"link"`
 	Reason string `json:"reason"`
 }
@@ -65,6 +69,27 @@ func (p *ProtocolUpgradeProposal) GetProposalInfo() ProposalInfo { return p.Prop
 // SetProposalInfo - implements Proposal
 func (p *ProtocolUpgradeProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
 
+// FailedEventProposal - proposal to replay or drop a failed time event in dead-letter store
+type FailedEventProposal struct {
+	ProposalInfo
+	EventID int64  `json:"event_id"`
+	Replay  bool   `json:"replay"`
+	Reason  string `json:"reason"`
+}
+
+// GetProposalInfo - implements Proposal
+func (p *FailedEventProposal) GetProposalInfo() ProposalInfo { return p.ProposalInfo }
+
+// SetProposalInfo - implements Proposal
+func (p *FailedEventProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
+
+// UpgradePlan - protocol upgrade scheduled by a passed proposal
+type UpgradePlan struct {
+	Name       string            `json:"name"`
+	Height     int64             `json:"height"`
+	ProposalID types.ProposalKey `json:"proposal_id"`
+}
+
 // NextProposalID - store next proposal ID to KVStore
 type NextProposalID struct {
 	NextProposalID int64 `json:"next_proposal_id"`
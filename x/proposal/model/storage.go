@@ -11,6 +11,8 @@ var (
 	nextProposalIDSubstore  = []byte{0x00}
 	ongoingProposalSubStore = []byte{0x01}
 	expiredProposalSubStore = []byte{0x02}
+	upgradePlanSubStore     = []byte{0x03}
+	appliedUpgradeSubStore  = []byte{0x04}
 )
 
 // ProposalStorage - proposal storage
@@ -25,6 +27,7 @@ func NewProposalStorage(key sdk.StoreKey) ProposalStorage {
 	cdc.RegisterInterface((*Proposal)(nil), nil)
 	cdc.RegisterConcrete(&ChangeParamProposal{}, "changeParam", nil)
 	cdc.RegisterConcrete(&ProtocolUpgradeProposal{}, "upgrade", nil)
+	cdc.RegisterConcrete(&FailedEventProposal{}, "failedEvent", nil)
 	cdc.RegisterConcrete(&ContentCensorshipProposal{}, "censorship", nil)
 
 	cdc.RegisterInterface((*param.Parameter)(nil), nil)
@@ -48,6 +51,11 @@ func NewProposalStorage(key sdk.StoreKey) ProposalStorage {
 	return vs
 }
 
+// WireCodec - access to proposal storage codec
+func (ps ProposalStorage) WireCodec() *wire.Codec {
+	return ps.cdc
+}
+
 // InitGenesis - initialize proposal storage
 func (ps ProposalStorage) InitGenesis(ctx sdk.Context) sdk.Error {
 	nextProposalID := &NextProposalID{
@@ -194,6 +202,117 @@ func (ps ProposalStorage) SetNextProposalID(ctx sdk.Context, nextProposalID *Nex
 	return nil
 }
 
+// DoesUpgradePlanExist - check if upgrade is scheduled or already applied
+func (ps ProposalStorage) DoesUpgradePlanExist(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(getUpgradePlanKey(name)) || store.Has(getAppliedUpgradeKey(name))
+}
+
+// SetUpgradePlan - schedule upgrade plan
+func (ps ProposalStorage) SetUpgradePlan(ctx sdk.Context, plan *UpgradePlan) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	planByte, err := ps.cdc.MarshalJSON(*plan)
+	if err != nil {
+		return ErrFailedToMarshalUpgradePlan(err)
+	}
+	store.Set(getUpgradePlanKey(plan.Name), planByte)
+	return nil
+}
+
+// GetUpgradePlanList - get all scheduled upgrade plans
+func (ps ProposalStorage) GetUpgradePlanList(ctx sdk.Context) ([]UpgradePlan, sdk.Error) {
+	return ps.getUpgradePlans(ctx, upgradePlanSubStore)
+}
+
+// SetAppliedUpgrade - move upgrade plan from scheduled to applied
+func (ps ProposalStorage) SetAppliedUpgrade(ctx sdk.Context, plan *UpgradePlan) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	planByte, err := ps.cdc.MarshalJSON(*plan)
+	if err != nil {
+		return ErrFailedToMarshalUpgradePlan(err)
+	}
+	store.Delete(getUpgradePlanKey(plan.Name))
+	store.Set(getAppliedUpgradeKey(plan.Name), planByte)
+	return nil
+}
+
+// GetAppliedUpgradeList - get all applied upgrade plans
+func (ps ProposalStorage) GetAppliedUpgradeList(ctx sdk.Context) ([]UpgradePlan, sdk.Error) {
+	return ps.getUpgradePlans(ctx, appliedUpgradeSubStore)
+}
+
+func (ps ProposalStorage) getUpgradePlans(ctx sdk.Context, substore []byte) ([]UpgradePlan, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iter := sdk.KVStorePrefixIterator(store, substore)
+	defer iter.Close()
+
+	plans := []UpgradePlan{}
+	for ; iter.Valid(); iter.Next() {
+		var plan UpgradePlan
+		if err := ps.cdc.UnmarshalJSON(iter.Value(), &plan); err != nil {
+			return nil, ErrFailedToUnmarshalUpgradePlan(err)
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// Export - export all records in proposal KVStore
+func (ps ProposalStorage) Export(ctx sdk.Context) (*ProposalTables, sdk.Error) {
+	ongoing, err := ps.GetOngoingProposalList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	expired, err := ps.GetExpiredProposalList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nextProposalID, err := ps.GetNextProposalID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	upgradePlans, err := ps.GetUpgradePlanList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	appliedUpgrades, err := ps.GetAppliedUpgradeList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ProposalTables{
+		OngoingProposals: ongoing,
+		ExpiredProposals: expired,
+		NextProposalID:   *nextProposalID,
+		UpgradePlans:     upgradePlans,
+		AppliedUpgrades:  appliedUpgrades,
+	}, nil
+}
+
+// Import - import all records exported by Export to proposal KVStore
+func (ps ProposalStorage) Import(ctx sdk.Context, tables *ProposalTables) sdk.Error {
+	for _, proposal := range tables.OngoingProposals {
+		if err := ps.SetOngoingProposal(ctx, proposal.GetProposalInfo().ProposalID, proposal); err != nil {
+			return err
+		}
+	}
+	for _, proposal := range tables.ExpiredProposals {
+		if err := ps.SetExpiredProposal(ctx, proposal.GetProposalInfo().ProposalID, proposal); err != nil {
+			return err
+		}
+	}
+	for _, plan := range tables.UpgradePlans {
+		if err := ps.SetUpgradePlan(ctx, &plan); err != nil {
+			return err
+		}
+	}
+	for _, plan := range tables.AppliedUpgrades {
+		if err := ps.SetAppliedUpgrade(ctx, &plan); err != nil {
+			return err
+		}
+	}
+	return ps.SetNextProposalID(ctx, &tables.NextProposalID)
+}
+
 // GetOngoingProposalKey - "ongoing proposal substore" + "proposal ID"
 func GetOngoingProposalKey(proposalID types.ProposalKey) []byte {
 	return append(ongoingProposalSubStore, proposalID...)
@@ -204,6 +323,16 @@ func GetExpiredProposalKey(proposalID types.ProposalKey) []byte {
 	return append(expiredProposalSubStore, proposalID...)
 }
 
+// getUpgradePlanKey - "upgrade plan substore" + "upgrade name"
+func getUpgradePlanKey(name string) []byte {
+	return append(upgradePlanSubStore, name...)
+}
+
+// getAppliedUpgradeKey - "applied upgrade substore" + "upgrade name"
+func getAppliedUpgradeKey(name string) []byte {
+	return append(appliedUpgradeSubStore, name...)
+}
+
 func getNextProposalIDKey() []byte {
 	return nextProposalIDSubstore
 }
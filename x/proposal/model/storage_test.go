@@ -171,3 +171,31 @@ func TestNextProposalID(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, nextProposalID, id)
 }
+
+func TestUpgradePlan(t *testing.T) {
+	ctx, ps := setup(t)
+
+	plans, err := ps.GetUpgradePlanList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []UpgradePlan{}, plans)
+
+	plan := UpgradePlan{Name: "upgrade", Height: 100, ProposalID: types.ProposalKey("1")}
+	assert.False(t, ps.DoesUpgradePlanExist(ctx, plan.Name))
+	err = ps.SetUpgradePlan(ctx, &plan)
+	assert.Nil(t, err)
+	assert.True(t, ps.DoesUpgradePlanExist(ctx, plan.Name))
+
+	plans, err = ps.GetUpgradePlanList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []UpgradePlan{plan}, plans)
+
+	err = ps.SetAppliedUpgrade(ctx, &plan)
+	assert.Nil(t, err)
+	assert.True(t, ps.DoesUpgradePlanExist(ctx, plan.Name))
+	plans, err = ps.GetUpgradePlanList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []UpgradePlan{}, plans)
+	applied, err := ps.GetAppliedUpgradeList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []UpgradePlan{plan}, applied)
+}
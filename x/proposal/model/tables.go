@@ -0,0 +1,10 @@
+package model
+
+// ProposalTables - state of proposal KVStore
+type ProposalTables struct {
+	OngoingProposals []Proposal     `json:"ongoing_proposals"`
+	ExpiredProposals []Proposal     `json:"expired_proposals"`
+	NextProposalID   NextProposalID `json:"next_proposal_id"`
+	UpgradePlans     []UpgradePlan  `json:"upgrade_plans"`
+	AppliedUpgrades  []UpgradePlan  `json:"applied_upgrades"`
+}
@@ -12,6 +12,7 @@ import (
 
 var _ types.Msg = DeletePostContentMsg{}
 var _ types.Msg = UpgradeProtocolMsg{}
+var _ types.Msg = RecoverFailedEventMsg{}
 var _ types.Msg = ChangeGlobalAllocationParamMsg{}
 var _ types.Msg = ChangeEvaluateOfContentValueParamMsg{}
 var _ types.Msg = ChangeInfraInternalAllocationParamMsg{}
@@ -39,6 +40,8 @@ var _ ContentCensorshipMsg = DeletePostContentMsg{}
 
 var _ ProtocolUpgradeMsg = UpgradeProtocolMsg{}
 
+var _ FailedEventMsg = RecoverFailedEventMsg{}
+
 // ChangeParamMsg - change parameter msg
 type ChangeParamMsg interface {
 	GetParameter() param.Parameter
@@ -56,10 +59,20 @@ type ContentCensorshipMsg interface {
 // ProtocolUpgradeMsg - protocol upgrade msg
 type ProtocolUpgradeMsg interface {
 	GetCreator() types.AccountKey
+	GetName() string
+	GetHeight() int64
 	GetLink() string
 	GetReason() string
 }
 
+// FailedEventMsg - drop or replay failed time event msg
+type FailedEventMsg interface {
+	GetCreator() types.AccountKey
+	GetEventID() int64
+	GetReplay() bool
+	GetReason() string
+}
+
 // DeletePostContentMsg - implement of content censorship msg
 type DeletePostContentMsg struct {
 	Creator  types.AccountKey `json:"creator"`
@@ -70,10 +83,21 @@ type DeletePostContentMsg struct {
 // UpgradeProtocolMsg - implement of protocol upgrade msg
 type UpgradeProtocolMsg struct {
 	Creator types.AccountKey `json:"creator"`
+	Name    string           `json:"name"`
+	Height  int64            `json:"height"`
 	Link    string           `json:"link"`
 	Reason  string           `json:"reason"`
 }
 
+// RecoverFailedEventMsg - implement of failed event msg, failed event
+// is replayed if Replay is true, otherwise it's dropped
+type RecoverFailedEventMsg struct {
+	Creator types.AccountKey `json:"creator"`
+	EventID int64            `json:"event_id"`
+	Replay  bool             `json:"replay"`
+	Reason  string           `json:"reason"`
+}
+
 // ChangeGlobalAllocationParamMsg - implement of change parameter msg
 type ChangeGlobalAllocationParamMsg struct {
 	Creator   types.AccountKey            `json:"creator"`
@@ -222,9 +246,11 @@ func (msg DeletePostContentMsg) GetConsumeAmount() types.Coin {
 // UpgradeProtocolMsg Msg Implementations
 
 func NewUpgradeProtocolMsg(
-	creator, link, reason string) UpgradeProtocolMsg {
+	creator, name string, height int64, link, reason string) UpgradeProtocolMsg {
 	return UpgradeProtocolMsg{
 		Creator: types.AccountKey(creator),
+		Name:    name,
+		Height:  height,
 		Link:    link,
 		Reason:  reason,
 	}
@@ -233,6 +259,12 @@ func NewUpgradeProtocolMsg(
 // GetCreator - implement UpgradeProtocolMsg
 func (msg UpgradeProtocolMsg) GetCreator() types.AccountKey { return msg.Creator }
 
+// GetName - implement UpgradeProtocolMsg
+func (msg UpgradeProtocolMsg) GetName() string { return msg.Name }
+
+// GetHeight - implement UpgradeProtocolMsg
+func (msg UpgradeProtocolMsg) GetHeight() int64 { return msg.Height }
+
 // GetLink - implement UpgradeProtocolMsg
 func (msg UpgradeProtocolMsg) GetLink() string { return msg.Link }
 
@@ -248,6 +280,12 @@ func (msg UpgradeProtocolMsg) ValidateBasic() sdk.Error {
 		len(msg.Creator) > types.MaximumUsernameLength {
 		return ErrInvalidUsername()
 	}
+	if len(msg.Name) == 0 || len(msg.Name) > types.MaximumLengthOfUpgradeName {
+		return ErrInvalidUpgradeName()
+	}
+	if msg.Height <= 0 {
+		return ErrInvalidUpgradeHeight()
+	}
 	if len(msg.GetLink()) == 0 {
 		return ErrInvalidLink()
 	}
@@ -261,7 +299,8 @@ func (msg UpgradeProtocolMsg) ValidateBasic() sdk.Error {
 }
 
 func (msg UpgradeProtocolMsg) String() string {
-	return fmt.Sprintf("UpgradeProtocolMsg{Creator:%v, Link:%v}", msg.Creator, msg.GetLink())
+	return fmt.Sprintf("UpgradeProtocolMsg{Creator:%v, Name:%v, Height:%v, Link:%v}",
+		msg.Creator, msg.Name, msg.Height, msg.GetLink())
 }
 
 // GetPermission - implement types.Msg
@@ -288,6 +327,78 @@ func (msg UpgradeProtocolMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
+//----------------------------------------
+// RecoverFailedEventMsg Msg Implementations
+
+func NewRecoverFailedEventMsg(
+	creator string, eventID int64, replay bool, reason string) RecoverFailedEventMsg {
+	return RecoverFailedEventMsg{
+		Creator: types.AccountKey(creator),
+		EventID: eventID,
+		Replay:  replay,
+		Reason:  reason,
+	}
+}
+
+// GetCreator - implement FailedEventMsg
+func (msg RecoverFailedEventMsg) GetCreator() types.AccountKey { return msg.Creator }
+
+// GetEventID - implement FailedEventMsg
+func (msg RecoverFailedEventMsg) GetEventID() int64 { return msg.EventID }
+
+// GetReplay - implement FailedEventMsg
+func (msg RecoverFailedEventMsg) GetReplay() bool { return msg.Replay }
+
+// GetReason - implement FailedEventMsg
+func (msg RecoverFailedEventMsg) GetReason() string { return msg.Reason }
+
+// Type - implement sdk.Msg
+func (msg RecoverFailedEventMsg) Type() string { return types.ProposalRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg RecoverFailedEventMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if msg.EventID <= 0 {
+		return ErrInvalidFailedEventID()
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	return nil
+}
+
+func (msg RecoverFailedEventMsg) String() string {
+	return fmt.Sprintf("RecoverFailedEventMsg{Creator:%v, EventID:%v, Replay:%v}",
+		msg.Creator, msg.EventID, msg.Replay)
+}
+
+// GetPermission - implement types.Msg
+func (msg RecoverFailedEventMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg RecoverFailedEventMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg RecoverFailedEventMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg RecoverFailedEventMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
 //----------------------------------------
 // ChangeGlobalAllocationParamMsg Msg Implementations
 
@@ -619,7 +730,8 @@ func (msg ChangeProposalParamMsg) ValidateBasic() sdk.Error {
 	if msg.Parameter.ContentCensorshipDecideSec <= 0 ||
 		msg.Parameter.ChangeParamExecutionSec <= 0 ||
 		msg.Parameter.ChangeParamDecideSec <= 0 ||
-		msg.Parameter.ProtocolUpgradeDecideSec <= 0 {
+		msg.Parameter.ProtocolUpgradeDecideSec <= 0 ||
+		msg.Parameter.FailedEventMaxRetries < 0 {
 		return ErrIllegalParameter()
 	}
 
@@ -782,7 +894,17 @@ func (msg ChangeValidatorParamMsg) ValidateBasic() sdk.Error {
 	if msg.Parameter.ValidatorCoinReturnIntervalSec <= 0 ||
 		msg.Parameter.ValidatorCoinReturnTimes <= 0 ||
 		msg.Parameter.AbsentCommitLimitation <= 0 ||
-		msg.Parameter.ValidatorListSize <= 0 {
+		msg.Parameter.ValidatorListSize <= 0 ||
+		msg.Parameter.ValidatorUnbondingPeriodSec < 0 ||
+		msg.Parameter.SignedBlocksWindow < 0 ||
+		msg.Parameter.SignedBlocksWindow > types.MaximumSignedBlocksWindow {
+		return ErrIllegalParameter()
+	}
+
+	if msg.Parameter.SignedBlocksWindow > 0 &&
+		(msg.Parameter.MinSignedPerWindow.Rat == nil ||
+			msg.Parameter.MinSignedPerWindow.LT(sdk.ZeroRat()) ||
+			msg.Parameter.MinSignedPerWindow.GT(sdk.NewRat(1, 1))) {
 		return ErrIllegalParameter()
 	}
 
@@ -934,7 +1056,8 @@ func (msg ChangePostParamMsg) ValidateBasic() sdk.Error {
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
-	if msg.Parameter.PostIntervalSec < 0 || msg.Parameter.ReportOrUpvoteIntervalSec < 0 {
+	if msg.Parameter.PostIntervalSec < 0 || msg.Parameter.ReportOrUpvoteIntervalSec < 0 ||
+		msg.Parameter.MaxNumOfPostVersions < 0 {
 		return ErrIllegalParameter()
 	}
 	return nil
@@ -385,6 +385,23 @@ func TestChangeValidatorParamMsg(t *testing.T) {
 	p11 := p1
 	p11.ValidatorListSize = int64(-1)
 
+	p12 := p1
+	p12.ValidatorUnbondingPeriodSec = int64(-1)
+
+	p13 := p1
+	p13.SignedBlocksWindow = int64(-1)
+
+	p14 := p1
+	p14.SignedBlocksWindow = int64(100)
+	p14.MinSignedPerWindow = sdk.NewRat(3, 2)
+
+	p15 := p1
+	p15.SignedBlocksWindow = int64(100)
+	p15.MinSignedPerWindow = sdk.NewRat(1, 2)
+
+	p16 := p15
+	p16.SignedBlocksWindow = int64(types.MaximumSignedBlocksWindow + 1)
+
 	testCases := []struct {
 		testName                string
 		ChangeValidatorParamMsg ChangeValidatorParamMsg
@@ -445,6 +462,31 @@ func TestChangeValidatorParamMsg(t *testing.T) {
 			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p11, ""),
 			expectedError:           ErrIllegalParameter(),
 		},
+		{
+			testName:                "negative ValidatorUnbondingPeriodSec is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p12, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "negative SignedBlocksWindow is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p13, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "MinSignedPerWindow larger than one is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p14, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "signed blocks window with valid min signed ratio",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p15, ""),
+			expectedError:           nil,
+		},
+		{
+			testName:                "SignedBlocksWindow larger than maximum is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p16, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
 		{
 			testName:                "empty username is illegal",
 			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("", p1, ""),
@@ -527,6 +569,9 @@ func TestChangeProposalParamMsg(t *testing.T) {
 	p13 := p1
 	p13.ProtocolUpgradeMinDeposit = types.NewCoinFromInt64(-1000000 * types.Decimals)
 
+	p14 := p1
+	p14.FailedEventMaxRetries = -1
+
 	testCases := []struct {
 		testName               string
 		ChangeProposalParamMsg ChangeProposalParamMsg
@@ -602,6 +647,11 @@ func TestChangeProposalParamMsg(t *testing.T) {
 			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p13, ""),
 			expectedError:          ErrIllegalParameter(),
 		},
+		{
+			testName:               "negative FailedEventMaxRetries is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p14, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
 		{
 			testName: "reason is too long",
 			ChangeProposalParamMsg: NewChangeProposalParamMsg(
@@ -799,6 +849,9 @@ func TestChangePostParamMsg(t *testing.T) {
 	p3 := p1
 	p3.PostIntervalSec = int64(-1)
 
+	p4 := p1
+	p4.MaxNumOfPostVersions = int64(-1)
+
 	testCases := []struct {
 		testName           string
 		changePostParamMsg ChangePostParamMsg
@@ -819,6 +872,11 @@ func TestChangePostParamMsg(t *testing.T) {
 			changePostParamMsg: NewChangePostParamMsg("user1", p3, ""),
 			expectedError:      ErrIllegalParameter(),
 		},
+		{
+			testName:           "illegal max number of post versions",
+			changePostParamMsg: NewChangePostParamMsg("user1", p4, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
 		{
 			testName:           "username too short",
 			changePostParamMsg: NewChangePostParamMsg("us", p1, ""),
@@ -963,32 +1021,47 @@ func TestUpgradeProtocolMsg(t *testing.T) {
 	}{
 		{
 			testName:           "normal case",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "link", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "upgrade", 100, "link", ""),
 			expectedError:      nil,
 		},
 		{
 			testName:           "too short username is illegal",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("us", "link", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("us", "upgrade", 100, "link", ""),
 			expectedError:      ErrInvalidUsername(),
 		},
 		{
 			testName:           "too long username is illegal",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1user1user1user1user1user1", "link", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1user1user1user1user1user1", "upgrade", 100, "link", ""),
 			expectedError:      ErrInvalidUsername(),
 		},
+		{
+			testName:           "empty upgrade name is illegal",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", 100, "link", ""),
+			expectedError:      ErrInvalidUpgradeName(),
+		},
+		{
+			testName:           "too long upgrade name is illegal",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", string(make([]byte, types.MaximumLengthOfUpgradeName+1)), 100, "link", ""),
+			expectedError:      ErrInvalidUpgradeName(),
+		},
+		{
+			testName:           "zero upgrade height is illegal",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "upgrade", 0, "link", ""),
+			expectedError:      ErrInvalidUpgradeHeight(),
+		},
 		{
 			testName:           "empty link is illegal",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "upgrade", 100, "", ""),
 			expectedError:      ErrInvalidLink(),
 		},
 		{
 			testName:           "reason is too long",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", string(make([]byte, types.MaximumLengthOfProposalReason+1))),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "upgrade", 100, "", string(make([]byte, types.MaximumLengthOfProposalReason+1))),
 			expectedError:      ErrInvalidLink(),
 		},
 		{
 			testName:           "utf8 reason is too long",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", tooLongOfUTF8Reason),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "upgrade", 100, "", tooLongOfUTF8Reason),
 			expectedError:      ErrInvalidLink(),
 		},
 	}
@@ -1001,6 +1074,42 @@ func TestUpgradeProtocolMsg(t *testing.T) {
 	}
 }
 
+func TestRecoverFailedEventMsg(t *testing.T) {
+	testCases := []struct {
+		testName              string
+		recoverFailedEventMsg RecoverFailedEventMsg
+		expectedError         sdk.Error
+	}{
+		{
+			testName:              "normal case",
+			recoverFailedEventMsg: NewRecoverFailedEventMsg("user1", 1, true, ""),
+			expectedError:         nil,
+		},
+		{
+			testName:              "too short username is illegal",
+			recoverFailedEventMsg: NewRecoverFailedEventMsg("us", 1, true, ""),
+			expectedError:         ErrInvalidUsername(),
+		},
+		{
+			testName:              "zero failed event id is illegal",
+			recoverFailedEventMsg: NewRecoverFailedEventMsg("user1", 0, false, ""),
+			expectedError:         ErrInvalidFailedEventID(),
+		},
+		{
+			testName:              "utf8 reason is too long",
+			recoverFailedEventMsg: NewRecoverFailedEventMsg("user1", 1, false, tooLongOfUTF8Reason),
+			expectedError:         ErrReasonTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.recoverFailedEventMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName         string
@@ -1015,7 +1124,12 @@ func TestMsgPermission(t *testing.T) {
 		},
 		{
 			testName:         "upgrade protocol msg",
-			msg:              NewUpgradeProtocolMsg("creator", "link", ""),
+			msg:              NewUpgradeProtocolMsg("creator", "upgrade", 100, "link", ""),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "recover failed event msg",
+			msg:              NewRecoverFailedEventMsg("creator", 1, true, ""),
 			expectPermission: types.TransactionPermission,
 		},
 		{
@@ -1106,7 +1220,7 @@ func TestGetSignBytes(t *testing.T) {
 		},
 		{
 			testName: "upgrade protocol msg",
-			msg:      NewUpgradeProtocolMsg("creator", "link", ""),
+			msg:      NewUpgradeProtocolMsg("creator", "upgrade", 100, "link", ""),
 		},
 		{
 			testName: "change global allocaiton param msg",
@@ -1183,7 +1297,7 @@ func TestGetSigners(t *testing.T) {
 		},
 		{
 			testName:      "upgrade protocol msg",
-			msg:           NewUpgradeProtocolMsg("creator", "link", ""),
+			msg:           NewUpgradeProtocolMsg("creator", "upgrade", 100, "link", ""),
 			expectSigners: []types.AccountKey{"creator"},
 		},
 		{
@@ -0,0 +1,122 @@
+package proposal
+
+import (
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryOngoingProposal - query ongoing proposal, path: ongoingProposal/<proposal id>
+	QueryOngoingProposal = "ongoingProposal"
+	// QueryExpiredProposal - query expired proposal, path: expiredProposal/<proposal id>
+	QueryExpiredProposal = "expiredProposal"
+	// QueryOngoingProposalList - query all ongoing proposals, path: ongoingProposalList
+	QueryOngoingProposalList = "ongoingProposalList"
+	// QueryExpiredProposalList - query all expired proposals, path: expiredProposalList
+	QueryExpiredProposalList = "expiredProposalList"
+	// QueryNextProposalID - query next proposal id, path: nextProposalID
+	QueryNextProposalID = "nextProposalID"
+	// QueryUpgradePlanList - query all scheduled upgrade plans, path: upgradePlanList
+	QueryUpgradePlanList = "upgradePlanList"
+)
+
+// NewQuerier - create a querier which serves custom queries under proposal route
+func NewQuerier(pm ProposalManager) sdk.Querier {
+	cdc := pm.storage.WireCodec()
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) == 0 {
+			return nil, types.ErrUnknownQueryPath("")
+		}
+		switch path[0] {
+		case QueryOngoingProposal:
+			return queryOngoingProposal(ctx, cdc, path[1:], pm)
+		case QueryExpiredProposal:
+			return queryExpiredProposal(ctx, cdc, path[1:], pm)
+		case QueryOngoingProposalList:
+			return queryOngoingProposalList(ctx, cdc, path[1:], pm)
+		case QueryExpiredProposalList:
+			return queryExpiredProposalList(ctx, cdc, path[1:], pm)
+		case QueryNextProposalID:
+			return queryNextProposalID(ctx, cdc, path[1:], pm)
+		case QueryUpgradePlanList:
+			return queryUpgradePlanList(ctx, cdc, path[1:], pm)
+		default:
+			return nil, types.ErrUnknownQueryPath(path[0])
+		}
+	}
+}
+
+func queryOngoingProposal(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm ProposalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	proposal, err := pm.storage.GetOngoingProposal(ctx, types.ProposalKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, proposal)
+}
+
+func queryExpiredProposal(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm ProposalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	proposal, err := pm.storage.GetExpiredProposal(ctx, types.ProposalKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, proposal)
+}
+
+func queryOngoingProposalList(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm ProposalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	proposalList, err := pm.storage.GetOngoingProposalList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, proposalList)
+}
+
+func queryExpiredProposalList(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm ProposalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	proposalList, err := pm.storage.GetExpiredProposalList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, proposalList)
+}
+
+func queryNextProposalID(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm ProposalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	nextProposalID, err := pm.storage.GetNextProposalID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, nextProposalID)
+}
+
+func queryUpgradePlanList(
+	ctx sdk.Context, cdc *wire.Codec, path []string, pm ProposalManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	plans, err := pm.storage.GetUpgradePlanList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, plans)
+}
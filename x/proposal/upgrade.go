@@ -0,0 +1,109 @@
+package proposal
+
+import (
+	"fmt"
+
+	"github.com/lino-network/lino/x/proposal/model"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/lino-network/lino/types"
+)
+
+// UpgradeHandler - one migration step of a protocol upgrade
+type UpgradeHandler func(ctx sdk.Context) sdk.Error
+
+// UpgradeRegistry - upgrade handlers known by this binary, keyed by upgrade name
+type UpgradeRegistry struct {
+	handlers map[string][]UpgradeHandler
+}
+
+// NewUpgradeRegistry - new empty upgrade registry
+func NewUpgradeRegistry() UpgradeRegistry {
+	return UpgradeRegistry{
+		handlers: make(map[string][]UpgradeHandler),
+	}
+}
+
+// Register - register migration steps of an upgrade, steps run in the given order
+func (reg UpgradeRegistry) Register(name string, steps ...UpgradeHandler) {
+	if _, ok := reg.handlers[name]; ok {
+		panic(fmt.Sprintf("upgrade %s is already registered", name))
+	}
+	reg.handlers[name] = steps
+}
+
+// IsKnown - check if binary has handlers for upgrade
+func (reg UpgradeRegistry) IsKnown(name string) bool {
+	_, ok := reg.handlers[name]
+	return ok
+}
+
+// CheckUpgradePlan - check upgrade height is after min height and upgrade name
+// is neither scheduled nor applied
+func (pm ProposalManager) CheckUpgradePlan(
+	ctx sdk.Context, name string, height, minHeight int64) sdk.Error {
+	if height <= minHeight {
+		return ErrUpgradeHeightPassed(name, height)
+	}
+	if pm.storage.DoesUpgradePlanExist(ctx, name) {
+		return ErrUpgradeAlreadyScheduled(name)
+	}
+	return nil
+}
+
+// ScheduleUpgrade - schedule upgrade plan of a passed protocol upgrade proposal,
+// proposal with stale plan is marked as not passed
+func (pm ProposalManager) ScheduleUpgrade(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	p, ok := proposal.(*model.ProtocolUpgradeProposal)
+	if !ok {
+		return ErrIncorrectProposalType()
+	}
+	// plan became stale while proposal was voted, reject the proposal
+	// instead of failing the decide event
+	if err := pm.CheckUpgradePlan(ctx, p.Name, p.Height, ctx.BlockHeight()); err != nil {
+		ctx.Logger().Info("reject stale upgrade proposal", "proposal", proposalID, "err", err)
+		p.Result = types.ProposalNotPass
+		return pm.storage.SetExpiredProposal(ctx, proposalID, p)
+	}
+	return pm.storage.SetUpgradePlan(ctx, &model.UpgradePlan{
+		Name:       p.Name,
+		Height:     p.Height,
+		ProposalID: proposalID,
+	})
+}
+
+// GetUpgradePlanList - get all scheduled upgrade plans
+func (pm ProposalManager) GetUpgradePlanList(ctx sdk.Context) ([]model.UpgradePlan, sdk.Error) {
+	return pm.storage.GetUpgradePlanList(ctx)
+}
+
+// ApplyUpgrades - run migrations of upgrades whose height is reached. If this
+// binary doesn't know a due upgrade an error is returned and caller must halt.
+func (pm ProposalManager) ApplyUpgrades(ctx sdk.Context, reg UpgradeRegistry) sdk.Error {
+	plans, err := pm.storage.GetUpgradePlanList(ctx)
+	if err != nil {
+		return err
+	}
+	for _, plan := range plans {
+		if plan.Height > ctx.BlockHeight() {
+			continue
+		}
+		steps, ok := reg.handlers[plan.Name]
+		if !ok {
+			return ErrUnknownUpgrade(plan.Name, plan.Height)
+		}
+		for _, step := range steps {
+			if err := step(ctx); err != nil {
+				return err
+			}
+		}
+		if err := pm.storage.SetAppliedUpgrade(ctx, &plan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package proposal
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/proposal/model"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func addExpiredUpgradeProposal(
+	t *testing.T, ctx sdk.Context, pm ProposalManager, name string, height int64) types.ProposalKey {
+	proposal := pm.CreateProtocolUpgradeProposal(ctx, name, height, "link", "")
+	proposalID, err := pm.AddProposal(ctx, types.AccountKey("creator"), proposal, 10)
+	assert.Nil(t, err)
+	proposal, err = pm.storage.GetOngoingProposal(ctx, proposalID)
+	assert.Nil(t, err)
+	info := proposal.GetProposalInfo()
+	info.Result = types.ProposalPass
+	proposal.SetProposalInfo(info)
+	assert.Nil(t, pm.storage.DeleteOngoingProposal(ctx, proposalID))
+	assert.Nil(t, pm.storage.SetExpiredProposal(ctx, proposalID, proposal))
+	return proposalID
+}
+
+func TestScheduleUpgrade(t *testing.T) {
+	ctx, _, pm, _, _, _, _ := setupTest(t, 10)
+	pm.InitGenesis(ctx)
+
+	id1 := addExpiredUpgradeProposal(t, ctx, pm, "upgrade1", 100)
+	id2 := addExpiredUpgradeProposal(t, ctx, pm, "upgrade1", 200)
+	id3 := addExpiredUpgradeProposal(t, ctx, pm, "upgrade2", 10)
+	censorship := pm.CreateContentCensorshipProposal(ctx, types.Permlink("permlink"), "")
+	id4, err := pm.AddProposal(ctx, types.AccountKey("creator"), censorship, 10)
+	assert.Nil(t, err)
+	assert.Nil(t, pm.storage.SetExpiredProposal(ctx, id4, censorship))
+
+	testCases := []struct {
+		testName     string
+		proposalID   types.ProposalKey
+		expectErr    sdk.Error
+		expectResult types.ProposalResult
+	}{
+		{
+			testName:     "schedule upgrade",
+			proposalID:   id1,
+			expectErr:    nil,
+			expectResult: types.ProposalPass,
+		},
+		{
+			testName:     "upgrade name already scheduled",
+			proposalID:   id2,
+			expectErr:    nil,
+			expectResult: types.ProposalNotPass,
+		},
+		{
+			testName:     "upgrade height already passed",
+			proposalID:   id3,
+			expectErr:    nil,
+			expectResult: types.ProposalNotPass,
+		},
+	}
+	for _, tc := range testCases {
+		err := pm.ScheduleUpgrade(ctx, tc.proposalID)
+		if !assert.Equal(t, tc.expectErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
+		}
+		proposal, err := pm.storage.GetExpiredProposal(ctx, tc.proposalID)
+		assert.Nil(t, err)
+		if proposal.GetProposalInfo().Result != tc.expectResult {
+			t.Errorf("%s: diff result, got %v, want %v",
+				tc.testName, proposal.GetProposalInfo().Result, tc.expectResult)
+		}
+	}
+	assert.Equal(t, ErrIncorrectProposalType(), pm.ScheduleUpgrade(ctx, id4))
+
+	plans, err := pm.GetUpgradePlanList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.UpgradePlan{{Name: "upgrade1", Height: 100, ProposalID: id1}}, plans)
+}
+
+func TestApplyUpgrades(t *testing.T) {
+	ctx, _, pm, _, _, _, _ := setupTest(t, 10)
+	pm.InitGenesis(ctx)
+
+	assert.Nil(t, pm.ScheduleUpgrade(ctx, addExpiredUpgradeProposal(t, ctx, pm, "upgrade1", 100)))
+	assert.Nil(t, pm.ScheduleUpgrade(ctx, addExpiredUpgradeProposal(t, ctx, pm, "upgrade2", 200)))
+
+	steps := []string{}
+	reg := NewUpgradeRegistry()
+	reg.Register("upgrade1",
+		func(ctx sdk.Context) sdk.Error {
+			steps = append(steps, "step1")
+			return nil
+		},
+		func(ctx sdk.Context) sdk.Error {
+			steps = append(steps, "step2")
+			return nil
+		})
+	assert.True(t, reg.IsKnown("upgrade1"))
+	assert.False(t, reg.IsKnown("upgrade2"))
+	assert.Panics(t, func() { reg.Register("upgrade1") })
+
+	// upgrade height not reached
+	assert.Nil(t, pm.ApplyUpgrades(ctx, reg))
+	assert.Equal(t, 0, len(steps))
+
+	// upgrade1 is applied once at its height
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 100})
+	assert.Nil(t, pm.ApplyUpgrades(ctx, reg))
+	assert.Equal(t, []string{"step1", "step2"}, steps)
+	assert.Nil(t, pm.ApplyUpgrades(ctx, reg))
+	assert.Equal(t, []string{"step1", "step2"}, steps)
+
+	// binary doesn't know upgrade2
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 200})
+	assert.Equal(t, ErrUnknownUpgrade("upgrade2", 200), pm.ApplyUpgrades(ctx, reg))
+
+	plans, err := pm.GetUpgradePlanList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.UpgradePlan{{Name: "upgrade2", Height: 200, ProposalID: "2"}}, plans)
+}
@@ -9,6 +9,7 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(VoteProposalMsg{}, "lino/voteProposal", nil)
 	cdc.RegisterConcrete(DeletePostContentMsg{}, "lino/deletePostContent", nil)
 	cdc.RegisterConcrete(UpgradeProtocolMsg{}, "lino/upgradeProtocol", nil)
+	cdc.RegisterConcrete(RecoverFailedEventMsg{}, "lino/recoverFailedEvent", nil)
 	cdc.RegisterConcrete(ChangeGlobalAllocationParamMsg{}, "lino/changeGlobalAllocation", nil)
 	cdc.RegisterConcrete(ChangeEvaluateOfContentValueParamMsg{}, "lino/changeEvaluation", nil)
 	cdc.RegisterConcrete(ChangeInfraInternalAllocationParamMsg{}, "lino/changeInfraAllocation", nil)
@@ -178,3 +178,30 @@ func (rep ReputationManager) GetCurrentRound(ctx sdk.Context) (int64, sdk.Error)
 	_, ts := handler.GetCurrentRound()
 	return ts, nil
 }
+
+// KVPair - raw record in reputation KVStore
+type KVPair struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// Export - export raw reputation KVStore for genesis, the encoding of
+// reputation records is internal to the reputation system.
+func (rep ReputationManager) Export(ctx sdk.Context) []KVPair {
+	store := ctx.KVStore(rep.storeKey)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	var pairs []KVPair
+	for ; iter.Valid(); iter.Next() {
+		pairs = append(pairs, KVPair{Key: iter.Key(), Value: iter.Value()})
+	}
+	return pairs
+}
+
+// Import - import raw reputation KVStore from exported genesis
+func (rep ReputationManager) Import(ctx sdk.Context, pairs []KVPair) {
+	store := ctx.KVStore(rep.storeKey)
+	for _, pair := range pairs {
+		store.Set(pair.Key, pair.Value)
+	}
+}
@@ -0,0 +1,74 @@
+package reputation
+
+import (
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryReputation - query reputation of a user, path: reputation/<username>
+	QueryReputation = "reputation"
+	// QuerySumRep - query sum of reputation donated to a post, path: sumRep/<permlink>
+	QuerySumRep = "sumRep"
+	// QueryCurrentRound - query start time of current round, path: currentRound
+	QueryCurrentRound = "currentRound"
+)
+
+// NewQuerier - create a querier which serves custom queries under reputation route
+func NewQuerier(rm ReputationManager) sdk.Querier {
+	cdc := wire.NewCodec()
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) == 0 {
+			return nil, types.ErrUnknownQueryPath("")
+		}
+		switch path[0] {
+		case QueryReputation:
+			return queryReputation(ctx, cdc, path[1:], rm)
+		case QuerySumRep:
+			return querySumRep(ctx, cdc, path[1:], rm)
+		case QueryCurrentRound:
+			return queryCurrentRound(ctx, cdc, path[1:], rm)
+		default:
+			return nil, types.ErrUnknownQueryPath(path[0])
+		}
+	}
+}
+
+func queryReputation(
+	ctx sdk.Context, cdc *wire.Codec, path []string, rm ReputationManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	reputation, err := rm.GetReputation(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, reputation)
+}
+
+func querySumRep(
+	ctx sdk.Context, cdc *wire.Codec, path []string, rm ReputationManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	sumRep, err := rm.GetSumRep(ctx, types.Permlink(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, sumRep)
+}
+
+func queryCurrentRound(
+	ctx sdk.Context, cdc *wire.Codec, path []string, rm ReputationManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	roundStartAt, err := rm.GetCurrentRound(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, roundStartAt)
+}
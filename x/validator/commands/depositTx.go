@@ -28,6 +28,8 @@ func DepositValidatorTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagUser, "", "user of this transaction")
 	cmd.Flags().String(client.FlagAmount, "", "amount of the donation")
 	cmd.Flags().String(client.FlagLink, "", "link of the validator")
+	cmd.Flags().String(client.FlagCommissionRate, "", "commission rate of validator inflation, e.g. 0.1 (optional, can only be declared once)")
+	cmd.Flags().String(client.FlagMaxCommissionChangeRate, "", "max commission rate change in one update (optional, can only be declared once)")
 	return cmd
 }
 
@@ -58,8 +60,9 @@ func sendDepositValidatorTx(cdc *wire.Codec) client.CommandTxCallback {
 		pubKey := privValidator.GetPubKey()
 
 		// create the message
-		msg := validator.NewValidatorDepositMsg(
-			name, types.LNO(viper.GetString(client.FlagAmount)), pubKey, viper.GetString(client.FlagLink))
+		msg := validator.NewValidatorDepositMsgWithCommission(
+			name, types.LNO(viper.GetString(client.FlagAmount)), pubKey, viper.GetString(client.FlagLink),
+			viper.GetString(client.FlagCommissionRate), viper.GetString(client.FlagMaxCommissionChangeRate))
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
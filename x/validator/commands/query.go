@@ -10,13 +10,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/validator"
 	"github.com/lino-network/lino/x/validator/model"
 )
 
 // GetValidatorsCmd returns all validators relative information
-func GetValidatorsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetValidatorsCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -27,9 +28,9 @@ func GetValidatorsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 }
 
 // GetValidatorCmd returns target validator information
-func GetValidatorCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetValidatorCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -39,14 +40,66 @@ func GetValidatorCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetCommissionCmd returns commission declared by target validator
+func GetCommissionCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "validator-commission",
+		Short: "Query validator commission",
+		RunE:  cmdr.getCommissionCmd,
+	}
+}
+
+// GetJailedValidatorsCmd returns all jailed validators and their release time
+func GetJailedValidatorsCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "jailed-validators",
+		Short: "Query jailed validators",
+		RunE:  cmdr.getJailedValidatorsCmd,
+	}
+}
+
+// GetPunishmentHistoryCmd returns punishment log of target validator
+func GetPunishmentHistoryCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "validator-punishments",
+		Short: "Query validator punishment history",
+		RunE:  cmdr.getPunishmentHistoryCmd,
+	}
+}
+
+// GetUptimeCmd returns signed ratio of target validator in recent blocks
+func GetUptimeCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "validator-uptime",
+		Short: "Query validator uptime in the signed blocks window",
+		RunE:  cmdr.getUptimeCmd,
+	}
+}
+
 type commander struct {
-	storeName string
-	cdc       *wire.Codec
+	queryRoute string
+	cdc        *wire.Codec
 }
 
 func (c commander) getValidatorsCmd(cmd *cobra.Command, args []string) error {
 	ctx := client.NewCoreContextFromViper()
-	res, err := ctx.Query(model.GetValidatorListKey(), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, validator.QueryValidatorList)
 	if err != nil {
 		return err
 	}
@@ -74,7 +127,7 @@ func (c commander) getValidatorCmd(cmd *cobra.Command, args []string) error {
 
 	accKey := types.AccountKey(args[0])
 
-	res, err := ctx.Query(model.GetValidatorKey(accKey), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, validator.QueryValidator, string(accKey))
 	if err != nil {
 		return err
 	}
@@ -90,3 +143,91 @@ func (c commander) getValidatorCmd(cmd *cobra.Command, args []string) error {
 	fmt.Println(string(output))
 	return nil
 }
+
+func (c commander) getCommissionCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+
+	res, err := ctx.QueryCustom(c.queryRoute, validator.QueryCommission, args[0])
+	if err != nil {
+		return err
+	}
+	commission := new(model.Commission)
+	if err := c.cdc.UnmarshalJSON(res, commission); err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(commission, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getJailedValidatorsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	res, err := ctx.QueryCustom(c.queryRoute, validator.QueryJailedValidators)
+	if err != nil {
+		return err
+	}
+	jailed := []model.JailedValidator{}
+	if err := c.cdc.UnmarshalJSON(res, &jailed); err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(jailed, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getPunishmentHistoryCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+
+	res, err := ctx.QueryCustom(c.queryRoute, validator.QueryPunishmentHistory, args[0])
+	if err != nil {
+		return err
+	}
+	history := new(model.PunishmentHistory)
+	if err := c.cdc.UnmarshalJSON(res, history); err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getUptimeCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+
+	res, err := ctx.QueryCustom(c.queryRoute, validator.QueryUptime, args[0])
+	if err != nil {
+		return err
+	}
+	uptime := new(model.ValidatorUptime)
+	if err := c.cdc.UnmarshalJSON(res, uptime); err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(uptime, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
@@ -0,0 +1,87 @@
+package validator
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/validator/model"
+)
+
+// parseCommissionRate - parse decimal commission rate and make sure it's in [0, 1]
+func parseCommissionRate(rate string) (sdk.Rat, sdk.Error) {
+	if len(rate) == 0 || len(rate) > types.MaximumSdkRatLength {
+		return sdk.ZeroRat(), ErrInvalidCommissionRate()
+	}
+	res, err := sdk.NewRatFromDecimal(rate, types.NewRatFromDecimalPrecision)
+	if err != nil {
+		return sdk.ZeroRat(), ErrInvalidCommissionRate()
+	}
+	if res.LT(sdk.ZeroRat()) || res.GT(sdk.OneRat()) {
+		return sdk.ZeroRat(), ErrInvalidCommissionRate()
+	}
+	return res, nil
+}
+
+// GetCommissionRate - get commission rate of validator, validator which doesn't
+// declare commission keeps all inflation
+func (vm ValidatorManager) GetCommissionRate(ctx sdk.Context, username types.AccountKey) (sdk.Rat, sdk.Error) {
+	commission, err := vm.storage.GetCommission(ctx, username)
+	if err != nil {
+		return sdk.OneRat(), err
+	}
+	if commission == nil {
+		return sdk.OneRat(), nil
+	}
+	return commission.Rate, nil
+}
+
+// GetCommission - get commission declared by validator, returns nil if not declared
+func (vm ValidatorManager) GetCommission(ctx sdk.Context, username types.AccountKey) (*model.Commission, sdk.Error) {
+	return vm.storage.GetCommission(ctx, username)
+}
+
+// DeclareCommission - declare commission rate and max change rate, can only be declared once
+func (vm ValidatorManager) DeclareCommission(
+	ctx sdk.Context, username types.AccountKey, rate, maxChangeRate sdk.Rat) sdk.Error {
+	if !vm.storage.DoesValidatorExist(ctx, username) {
+		return ErrAccountNotFound()
+	}
+	commission, err := vm.storage.GetCommission(ctx, username)
+	if err != nil {
+		return err
+	}
+	if commission != nil {
+		return ErrCommissionAlreadyDeclared()
+	}
+	return vm.storage.SetCommission(ctx, username, &model.Commission{
+		Rate:          rate,
+		MaxChangeRate: maxChangeRate,
+		UpdatedAt:     ctx.BlockHeader().Time.Unix(),
+	})
+}
+
+// UpdateCommissionRate - update commission rate, the change can't exceed max change rate
+// and can only happen once per CommissionChangeIntervalSec
+func (vm ValidatorManager) UpdateCommissionRate(
+	ctx sdk.Context, username types.AccountKey, rate sdk.Rat) sdk.Error {
+	commission, err := vm.storage.GetCommission(ctx, username)
+	if err != nil {
+		return err
+	}
+	if commission == nil {
+		return ErrCommissionNotDeclared()
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	if now-commission.UpdatedAt < types.CommissionChangeIntervalSec {
+		return ErrCommissionChangeTooOften()
+	}
+	diff := rate.Sub(commission.Rate)
+	if rate.LT(commission.Rate) {
+		diff = commission.Rate.Sub(rate)
+	}
+	if diff.GT(commission.MaxChangeRate) {
+		return ErrCommissionChangeTooLarge()
+	}
+	commission.Rate = rate
+	commission.UpdatedAt = now
+	return vm.storage.SetCommission(ctx, username, commission)
+}
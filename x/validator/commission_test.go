@@ -0,0 +1,136 @@
+package validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lino-network/lino/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestDeclareCommission(t *testing.T) {
+	ctx, _, valManager, _, _ := setupTest(t, 0)
+	valManager.InitGenesis(ctx)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1000, 0)})
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	user1 := types.AccountKey("user1")
+
+	// validator must exist
+	err := valManager.DeclareCommission(ctx, user1, sdk.NewRat(1, 10), sdk.NewRat(1, 100))
+	assert.Equal(t, ErrAccountNotFound(), err)
+
+	err = valManager.RegisterValidator(
+		ctx, user1, secp256k1.GenPrivKey().PubKey(), valParam.ValidatorMinCommittingDeposit, "")
+	assert.Nil(t, err)
+
+	// validator without commission keeps all inflation
+	rate, err := valManager.GetCommissionRate(ctx, user1)
+	assert.Nil(t, err)
+	assert.True(t, sdk.OneRat().Equal(rate))
+
+	err = valManager.DeclareCommission(ctx, user1, sdk.NewRat(1, 10), sdk.NewRat(1, 100))
+	assert.Nil(t, err)
+	commission, err := valManager.GetCommission(ctx, user1)
+	assert.Nil(t, err)
+	assert.True(t, sdk.NewRat(1, 10).Equal(commission.Rate))
+	assert.True(t, sdk.NewRat(1, 100).Equal(commission.MaxChangeRate))
+	assert.Equal(t, int64(1000), commission.UpdatedAt)
+
+	// commission can only be declared once
+	err = valManager.DeclareCommission(ctx, user1, sdk.NewRat(1, 5), sdk.NewRat(1, 100))
+	assert.Equal(t, ErrCommissionAlreadyDeclared(), err)
+}
+
+func TestUpdateCommissionRate(t *testing.T) {
+	ctx, _, valManager, _, _ := setupTest(t, 0)
+	valManager.InitGenesis(ctx)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0)})
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	valManager.RegisterValidator(
+		ctx, user1, secp256k1.GenPrivKey().PubKey(), valParam.ValidatorMinCommittingDeposit, "")
+	valManager.RegisterValidator(
+		ctx, user2, secp256k1.GenPrivKey().PubKey(), valParam.ValidatorMinCommittingDeposit, "")
+	valManager.DeclareCommission(ctx, user1, sdk.NewRat(1, 10), sdk.NewRat(1, 10))
+
+	testCases := []struct {
+		testName     string
+		username     types.AccountKey
+		rate         sdk.Rat
+		atWhen       int64
+		expectErr    sdk.Error
+		expectRate   sdk.Rat
+		expectUpdate int64
+	}{
+		{
+			testName:     "commission not declared",
+			username:     user2,
+			rate:         sdk.NewRat(1, 10),
+			atWhen:       types.CommissionChangeIntervalSec,
+			expectErr:    ErrCommissionNotDeclared(),
+			expectRate:   sdk.NewRat(1, 10),
+			expectUpdate: 0,
+		},
+		{
+			testName:     "change too often",
+			username:     user1,
+			rate:         sdk.NewRat(1, 5),
+			atWhen:       types.CommissionChangeIntervalSec - 1,
+			expectErr:    ErrCommissionChangeTooOften(),
+			expectRate:   sdk.NewRat(1, 10),
+			expectUpdate: 0,
+		},
+		{
+			testName:     "change too large",
+			username:     user1,
+			rate:         sdk.NewRat(3, 10),
+			atWhen:       types.CommissionChangeIntervalSec,
+			expectErr:    ErrCommissionChangeTooLarge(),
+			expectRate:   sdk.NewRat(1, 10),
+			expectUpdate: 0,
+		},
+		{
+			testName:     "increase commission rate",
+			username:     user1,
+			rate:         sdk.NewRat(1, 5),
+			atWhen:       types.CommissionChangeIntervalSec,
+			expectErr:    nil,
+			expectRate:   sdk.NewRat(1, 5),
+			expectUpdate: types.CommissionChangeIntervalSec,
+		},
+		{
+			testName:     "decrease commission rate",
+			username:     user1,
+			rate:         sdk.NewRat(1, 10),
+			atWhen:       2 * types.CommissionChangeIntervalSec,
+			expectErr:    nil,
+			expectRate:   sdk.NewRat(1, 10),
+			expectUpdate: 2 * types.CommissionChangeIntervalSec,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(tc.atWhen, 0)})
+		err := valManager.UpdateCommissionRate(ctx, tc.username, tc.rate)
+		if !assert.Equal(t, tc.expectErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
+		}
+		commission, _ := valManager.GetCommission(ctx, tc.username)
+		if commission == nil {
+			continue
+		}
+		if !tc.expectRate.Equal(commission.Rate) {
+			t.Errorf("%s: diff rate, got %v, want %v", tc.testName, commission.Rate, tc.expectRate)
+		}
+		if commission.UpdatedAt != tc.expectUpdate {
+			t.Errorf("%s: diff updated at, got %v, want %v", tc.testName, commission.UpdatedAt, tc.expectUpdate)
+		}
+	}
+}
@@ -46,3 +46,38 @@ func ErrInvalidWebsite() sdk.Error {
 func ErrValidatorPubKeyAlreadyExist() sdk.Error {
 	return types.NewError(types.CodeValidatorPubKeyAlreadyExist, fmt.Sprintf("validator public key has been registered"))
 }
+
+// ErrInvalidCommissionRate - error if commission rate is invalid
+func ErrInvalidCommissionRate() sdk.Error {
+	return types.NewError(types.CodeInvalidCommissionRate, fmt.Sprintf("commission rate must be in [0, 1]"))
+}
+
+// ErrCommissionAlreadyDeclared - error if validator declares commission again
+func ErrCommissionAlreadyDeclared() sdk.Error {
+	return types.NewError(types.CodeCommissionAlreadyDeclared, fmt.Sprintf("commission has been declared"))
+}
+
+// ErrCommissionNotDeclared - error if validator updates commission before declaring it
+func ErrCommissionNotDeclared() sdk.Error {
+	return types.NewError(types.CodeCommissionNotDeclared, fmt.Sprintf("commission is not declared"))
+}
+
+// ErrCommissionChangeTooLarge - error if commission rate change exceeds max change rate
+func ErrCommissionChangeTooLarge() sdk.Error {
+	return types.NewError(types.CodeCommissionChangeTooLarge, fmt.Sprintf("commission rate change exceeds max change rate"))
+}
+
+// ErrCommissionChangeTooOften - error if commission rate is changed too often
+func ErrCommissionChangeTooOften() sdk.Error {
+	return types.NewError(types.CodeCommissionChangeTooOften, fmt.Sprintf("commission rate is changed too often"))
+}
+
+// ErrValidatorNotJailed - error if unjail a validator which is not jailed
+func ErrValidatorNotJailed() sdk.Error {
+	return types.NewError(types.CodeValidatorNotJailed, fmt.Sprintf("validator is not jailed"))
+}
+
+// ErrValidatorStillJailed - error if unjail before jail period ends
+func ErrValidatorStillJailed(jailUntil int64) sdk.Error {
+	return types.NewError(types.CodeValidatorStillJailed, fmt.Sprintf("validator is jailed until %v", jailUntil))
+}
@@ -0,0 +1,32 @@
+package validator
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+	acc "github.com/lino-network/lino/x/account"
+	"github.com/lino-network/lino/x/global"
+)
+
+// UnbondingEvent - return unbonding deposit to validator when unbonding period ends
+type UnbondingEvent struct {
+	Username      types.AccountKey `json:"username"`
+	CreatedHeight int64            `json:"created_height"`
+}
+
+// Execute - return unbonding deposit in coin return events, nothing returned if slashed
+func (event UnbondingEvent) Execute(
+	ctx sdk.Context, vm ValidatorManager, am acc.AccountManager, gm global.GlobalManager) sdk.Error {
+	coin, err := vm.CompleteUnbondingDeposit(ctx, event.Username, event.CreatedHeight)
+	if err != nil {
+		return err
+	}
+	if coin.IsZero() {
+		return nil
+	}
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return err
+	}
+	return returnCoinTo(
+		ctx, event.Username, gm, am, param.ValidatorCoinReturnTimes, param.ValidatorCoinReturnIntervalSec, coin)
+}
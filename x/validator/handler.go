@@ -21,6 +21,10 @@ func NewHandler(am acc.AccountManager, valManager ValidatorManager, voteManager
 			return handleWithdrawMsg(ctx, valManager, gm, am, msg)
 		case ValidatorRevokeMsg:
 			return handleRevokeMsg(ctx, valManager, gm, am, msg)
+		case ValidatorUpdateCommissionMsg:
+			return handleUpdateCommissionMsg(ctx, valManager, msg)
+		case ValidatorUnjailMsg:
+			return handleUnjailMsg(ctx, valManager, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized validator msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -41,6 +45,14 @@ func handleDepositMsg(
 		return err.Result()
 	}
 
+	if msg.HasCommission() {
+		if commission, err := valManager.GetCommission(ctx, msg.Username); err != nil {
+			return err.Result()
+		} else if commission != nil {
+			return ErrCommissionAlreadyDeclared().Result()
+		}
+	}
+
 	// withdraw money from validator's bank
 	if err = am.MinusSavingCoin(ctx, msg.Username, coin, "", "", types.ValidatorDeposit); err != nil {
 		return err.Result()
@@ -73,11 +85,31 @@ func handleDepositMsg(
 		return ErrUnbalancedAccount().Result()
 	}
 
+	if msg.HasCommission() {
+		rate, err := parseCommissionRate(msg.CommissionRate)
+		if err != nil {
+			return err.Result()
+		}
+		maxChangeRate, err := parseCommissionRate(msg.MaxCommissionChangeRate)
+		if err != nil {
+			return err.Result()
+		}
+		if err := valManager.DeclareCommission(ctx, msg.Username, rate, maxChangeRate); err != nil {
+			return err.Result()
+		}
+	}
+
 	// Try to become oncall validator
 	if err := valManager.TryBecomeOncallValidator(ctx, msg.Username); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionValidatorDeposit),
+			types.TagSender, []byte(msg.Username),
+			types.TagAmount, []byte(msg.Deposit),
+		),
+	}
 }
 
 // Handle Withdraw Msg
@@ -97,17 +129,16 @@ func handleWithdrawMsg(
 		return err.Result()
 	}
 
-	param, err := vm.paramHolder.GetValidatorParam(ctx)
-	if err != nil {
+	if err := unbondCoinTo(ctx, msg.Username, vm, gm, am, coin); err != nil {
 		return err.Result()
 	}
-
-	if err := returnCoinTo(
-		ctx, msg.Username, gm, am, param.ValidatorCoinReturnTimes,
-		param.ValidatorCoinReturnIntervalSec, coin); err != nil {
-		return err.Result()
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionValidatorWithdraw),
+			types.TagSender, []byte(msg.Username),
+			types.TagAmount, []byte(msg.Amount),
+		),
 	}
-	return sdk.Result{}
 }
 
 func handleRevokeMsg(
@@ -122,17 +153,69 @@ func handleRevokeMsg(
 		return err.Result()
 	}
 
-	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err := unbondCoinTo(ctx, msg.Username, vm, gm, am, coin); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionValidatorRevoke),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
+}
+
+func handleUpdateCommissionMsg(
+	ctx sdk.Context, vm ValidatorManager, msg ValidatorUpdateCommissionMsg) sdk.Result {
+	rate, err := parseCommissionRate(msg.CommissionRate)
 	if err != nil {
 		return err.Result()
 	}
+	if err := vm.UpdateCommissionRate(ctx, msg.Username, rate); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionValidatorUpdateCommission),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
+}
 
-	if err := returnCoinTo(
-		ctx, msg.Username, gm, am, param.ValidatorCoinReturnTimes,
-		param.ValidatorCoinReturnIntervalSec, coin); err != nil {
+func handleUnjailMsg(ctx sdk.Context, vm ValidatorManager, msg ValidatorUnjailMsg) sdk.Result {
+	if err := vm.UnjailValidator(ctx, msg.Username); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionValidatorUnjail),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
+}
+
+// unbondCoinTo - return withdrawn deposit after unbonding period, during which
+// the deposit is still slashable. Return immediately if unbonding period is 0
+func unbondCoinTo(
+	ctx sdk.Context, name types.AccountKey, vm ValidatorManager, gm global.GlobalManager,
+	am acc.AccountManager, coin types.Coin) sdk.Error {
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return err
+	}
+	if param.ValidatorUnbondingPeriodSec == 0 {
+		return returnCoinTo(
+			ctx, name, gm, am, param.ValidatorCoinReturnTimes, param.ValidatorCoinReturnIntervalSec, coin)
+	}
+
+	deposit, isNew, err := vm.AddUnbondingDeposit(ctx, name, coin, param.ValidatorUnbondingPeriodSec)
+	if err != nil {
+		return err
+	}
+	if !isNew {
+		return nil
+	}
+	return gm.RegisterValidatorUnbondingEvent(
+		ctx, deposit.CompleteAt, UnbondingEvent{Username: name, CreatedHeight: deposit.CreatedHeight})
 }
 
 func returnCoinTo(
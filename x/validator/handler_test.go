@@ -3,10 +3,12 @@ package validator
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/validator/model"
 	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -31,7 +33,7 @@ func TestRegisterBasic(t *testing.T) {
 	valKey := secp256k1.GenPrivKey().PubKey()
 	msg := NewValidatorDepositMsg("user1", deposit, valKey, "")
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, depositResult(msg), result)
 
 	// check acc1's money has been withdrawn
 	acc1Balance, _ := am.GetSavingFromBank(ctx, user1)
@@ -97,7 +99,7 @@ func TestRevokeBasic(t *testing.T) {
 	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
 	msg := NewValidatorDepositMsg("user1", deposit, valKey, "")
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, depositResult(msg), result)
 
 	// now user1 should be the only validator
 	verifyList, _ := valManager.storage.GetValidatorList(ctx)
@@ -107,7 +109,7 @@ func TestRevokeBasic(t *testing.T) {
 	// let user1 revoke candidancy
 	msg2 := NewValidatorRevokeMsg("user1")
 	result2 := handler(ctx, msg2)
-	assert.Equal(t, sdk.Result{}, result2)
+	assert.Equal(t, revokeResult(msg2), result2)
 
 	verifyList2, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, 0, len(verifyList2.OncallValidators))
@@ -151,7 +153,7 @@ func TestRevokeOncallValidatorAndSubstitutionExists(t *testing.T) {
 		valKeys[i] = secp256k1.GenPrivKey().PubKey()
 		msg := NewValidatorDepositMsg("user"+strconv.Itoa(i+1), deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, depositResult(msg), result)
 	}
 
 	lst, _ := valManager.storage.GetValidatorList(ctx)
@@ -166,7 +168,7 @@ func TestRevokeOncallValidatorAndSubstitutionExists(t *testing.T) {
 	result := handler(ctx, msg)
 
 	lst2, _ := valManager.storage.GetValidatorList(ctx)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, depositResult(msg), result)
 	assert.Equal(t, valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(50*types.Decimals)), lst2.LowestPower)
 	assert.Equal(t, users[4], lst2.LowestValidator)
 
@@ -178,11 +180,11 @@ func TestRevokeOncallValidatorAndSubstitutionExists(t *testing.T) {
 
 	withdrawMsg2 := NewValidatorWithdrawMsg("user2", coinToString(valParam.ValidatorMinWithdraw))
 	resultWithdraw2 := handler(ctx, withdrawMsg2)
-	assert.Equal(t, sdk.Result{}, resultWithdraw2)
+	assert.Equal(t, withdrawResult(withdrawMsg2), resultWithdraw2)
 	//revoke a non oncall valodator wont change anything related to oncall list
 	revokeMsg := NewValidatorRevokeMsg("user2")
 	result2 := handler(ctx, revokeMsg)
-	assert.Equal(t, sdk.Result{}, result2)
+	assert.Equal(t, revokeResult(revokeMsg), result2)
 
 	lst3, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(50*types.Decimals)), lst3.LowestPower)
@@ -195,7 +197,7 @@ func TestRevokeOncallValidatorAndSubstitutionExists(t *testing.T) {
 	// list become the lowest validator
 	revokeMsg2 := NewValidatorRevokeMsg("user6")
 	result3 := handler(ctx, revokeMsg2)
-	assert.Equal(t, sdk.Result{}, result3)
+	assert.Equal(t, revokeResult(revokeMsg2), result3)
 
 	lst4, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(30*types.Decimals)), lst4.LowestPower)
@@ -220,7 +222,7 @@ func TestRevokeAndDepositAgain(t *testing.T) {
 	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
 	msg := NewValidatorDepositMsg("user1", deposit, valKey, "")
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, depositResult(msg), result)
 
 	lst, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, 1, len(lst.AllValidators))
@@ -229,7 +231,7 @@ func TestRevokeAndDepositAgain(t *testing.T) {
 	// let user1 revoke candidancy
 	msg2 := NewValidatorRevokeMsg("user1")
 	result2 := handler(ctx, msg2)
-	assert.Equal(t, sdk.Result{}, result2)
+	assert.Equal(t, revokeResult(msg2), result2)
 
 	lstEmpty, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, 0, len(lstEmpty.AllValidators))
@@ -240,7 +242,7 @@ func TestRevokeAndDepositAgain(t *testing.T) {
 	result3 := handler(ctx, msg3)
 
 	lst2, _ := valManager.storage.GetValidatorList(ctx)
-	assert.Equal(t, sdk.Result{}, result3)
+	assert.Equal(t, depositResult(msg3), result3)
 	assert.Equal(t, 1, len(lst2.AllValidators))
 	assert.Equal(t, 1, len(lst2.OncallValidators))
 
@@ -263,7 +265,7 @@ func TestWithdrawBasic(t *testing.T) {
 	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
 	msg := NewValidatorDepositMsg("user1", deposit, valKey, "")
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, depositResult(msg), result)
 
 	// now user1 should be the only validator
 	verifyList, _ := valManager.storage.GetValidatorList(ctx)
@@ -295,7 +297,7 @@ func TestDepositBasic(t *testing.T) {
 	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
 	msg := NewValidatorDepositMsg("user1", deposit, valKey, "")
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, depositResult(msg), result)
 
 	// check acc1's money has been withdrawn
 	acc1Balance, _ := am.GetSavingFromBank(ctx, user1)
@@ -314,6 +316,51 @@ func TestDepositBasic(t *testing.T) {
 	assert.Equal(t, true, validator.Deposit.IsEqual(valParam.ValidatorMinCommittingDeposit))
 }
 
+func TestDepositWithCommission(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+	voteManager.AddVoter(ctx, "user1", valParam.ValidatorMinVotingDeposit)
+
+	valKey := secp256k1.GenPrivKey().PubKey()
+	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
+	msg := NewValidatorDepositMsgWithCommission("user1", deposit, valKey, "", "0.2", "0.05")
+	result := handler(ctx, msg)
+	assert.Equal(t, depositResult(msg), result)
+
+	commission, err := valManager.GetCommission(ctx, user1)
+	assert.Nil(t, err)
+	assert.True(t, sdk.NewRat(1, 5).Equal(commission.Rate))
+	assert.True(t, sdk.NewRat(1, 20).Equal(commission.MaxChangeRate))
+
+	// commission can't be declared again
+	msg = NewValidatorDepositMsgWithCommission("user1", coinToString(minBalance), valKey, "", "0.1", "0.05")
+	result = handler(ctx, msg)
+	assert.Equal(t, ErrCommissionAlreadyDeclared().Result(), result)
+
+	// update commission after change interval
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(types.CommissionChangeIntervalSec, 0)})
+	updateMsg := NewValidatorUpdateCommissionMsg("user1", "0.25")
+	result = handler(ctx, updateMsg)
+	assert.Equal(t, sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionValidatorUpdateCommission),
+			types.TagSender, []byte(updateMsg.Username),
+		),
+	}, result)
+	commission, _ = valManager.GetCommission(ctx, user1)
+	assert.True(t, sdk.NewRat(1, 4).Equal(commission.Rate))
+
+	// change exceeds max change rate
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(2*types.CommissionChangeIntervalSec, 0)})
+	result = handler(ctx, NewValidatorUpdateCommissionMsg("user1", "0.31"))
+	assert.Equal(t, ErrCommissionChangeTooLarge().Result(), result)
+}
+
 func TestCommittingDepositExceedVotingDeposit(t *testing.T) {
 	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
 	handler := NewHandler(am, valManager, voteManager, gm)
@@ -369,7 +416,7 @@ func TestValidatorReplacement(t *testing.T) {
 		valKeys[i] = secp256k1.GenPrivKey().PubKey()
 		msg := NewValidatorDepositMsg("user"+strconv.Itoa(i+1), deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, depositResult(msg), result)
 	}
 
 	// check validator list, the lowest power is 10
@@ -389,11 +436,11 @@ func TestValidatorReplacement(t *testing.T) {
 	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
 	msg := NewValidatorDepositMsg("noPowerUser", deposit, valKey, "")
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, depositResult(msg), result)
 
 	//check the user hasn't been added to oncall validators but in the pool
 	verifyList2, _ := valManager.storage.GetValidatorList(ctx)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, depositResult(msg), result)
 	assert.Equal(t, true,
 		verifyList2.LowestPower.IsEqual(valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(10*types.Decimals))))
 	assert.Equal(t, users[0], verifyList2.LowestValidator)
@@ -410,7 +457,7 @@ func TestValidatorReplacement(t *testing.T) {
 	deposit = coinToString(valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(88 * types.Decimals)))
 	msg = NewValidatorDepositMsg("powerfulUser", deposit, valKey, "")
 	result = handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, depositResult(msg), result)
 
 	verifyList3, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, true,
@@ -560,3 +607,32 @@ func TestAddFrozenMoney(t *testing.T) {
 		}
 	}
 }
+
+func depositResult(msg ValidatorDepositMsg) sdk.Result {
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionValidatorDeposit),
+			types.TagSender, []byte(msg.Username),
+			types.TagAmount, []byte(msg.Deposit),
+		),
+	}
+}
+
+func withdrawResult(msg ValidatorWithdrawMsg) sdk.Result {
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionValidatorWithdraw),
+			types.TagSender, []byte(msg.Username),
+			types.TagAmount, []byte(msg.Amount),
+		),
+	}
+}
+
+func revokeResult(msg ValidatorRevokeMsg) sdk.Result {
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionValidatorRevoke),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
+}
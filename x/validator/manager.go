@@ -1,6 +1,8 @@
 package validator
 
 import (
+	"bytes"
+	"encoding/hex"
 	"math"
 	"reflect"
 
@@ -11,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	crypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmtypes "github.com/tendermint/tendermint/types"
 )
 
@@ -101,6 +104,7 @@ func (vm ValidatorManager) GetUpdateValidatorList(ctx sdk.Context) ([]abci.Valid
 			}
 			if validator.Deposit.IsZero() {
 				vm.storage.DeleteValidator(ctx, validator.Username)
+				vm.storage.DeleteCommission(ctx, validator.Username)
 			}
 
 			validator.ABCIValidator.Power = 0
@@ -138,12 +142,17 @@ func (vm ValidatorManager) SetValidatorList(ctx sdk.Context, lst *model.Validato
 }
 
 // UpdateSigningValidator - based on info in beginBlocker, record last block singing info
+// and update sliding window of oncall validators if signed blocks window is enabled
 func (vm ValidatorManager) UpdateSigningValidator(
 	ctx sdk.Context, signingValidators []abci.SigningValidator) sdk.Error {
 	lst, err := vm.storage.GetValidatorList(ctx)
 	if err != nil {
 		panic(err)
 	}
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return err
+	}
 
 	pkToSigningInfo := make(map[string]bool)
 
@@ -170,14 +179,73 @@ func (vm ValidatorManager) UpdateSigningValidator(
 		if err := vm.storage.SetValidator(ctx, curValidator, validator); err != nil {
 			panic(err)
 		}
+		if param.SignedBlocksWindow > 0 {
+			if err := vm.updateSigningInfo(
+				ctx, curValidator, !exist || !signedLastBlock, param.SignedBlocksWindow); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
 // PunishOncallValidator - punish oncall validator if 1) byzantine or 2) missing blocks reach limiation
+// validator missing blocks but still having enough deposit is jailed instead of removed
 func (vm ValidatorManager) PunishOncallValidator(
 	ctx sdk.Context, username types.AccountKey, penalty types.Coin, punishType types.PunishType) (types.Coin, sdk.Error) {
+	return vm.punishOncallValidator(ctx, username, penalty, punishType, nil)
+}
+
+// punishOncallValidator - punish validator and append the punishment to its punishment log,
+// deposit unbonding since the infraction height is slashed as well if evidence is given
+func (vm ValidatorManager) punishOncallValidator(
+	ctx sdk.Context, username types.AccountKey, penalty types.Coin,
+	punishType types.PunishType, evidence *abci.Evidence) (types.Coin, sdk.Error) {
+	actualPenalty := types.NewCoinFromInt64(0)
+	// validator revoked and removed at end of block has no deposit left,
+	// it is still punished through unbonding deposits given the evidence
+	if vm.storage.DoesValidatorExist(ctx, username) {
+		penaltyOnDeposit, err := vm.punishValidatorDeposit(ctx, username, penalty, punishType)
+		if err != nil {
+			return actualPenalty, err
+		}
+		actualPenalty = penaltyOnDeposit
+	} else if evidence == nil {
+		return actualPenalty, model.ErrValidatorNotFound()
+	}
+
+	hash := ""
+	if evidence != nil {
+		slashed, err := vm.slashUnbondingDeposits(ctx, username, evidence.Height)
+		if err != nil {
+			return actualPenalty, err
+		}
+		actualPenalty = actualPenalty.Plus(slashed)
+		hash = evidenceHash(*evidence)
+	}
+
+	if err := vm.addPunishmentRecord(ctx, username, model.PunishmentRecord{
+		Height:       ctx.BlockHeight(),
+		Time:         ctx.BlockHeader().Time.Unix(),
+		PunishType:   punishType,
+		Penalty:      actualPenalty,
+		EvidenceHash: hash,
+	}); err != nil {
+		return actualPenalty, err
+	}
+
+	if err := vm.AdjustValidatorList(ctx); err != nil {
+		return actualPenalty, err
+	}
+	return actualPenalty, nil
+}
+
+// punishValidatorDeposit - deduct penalty from validator deposit, validator is removed if
+// byzantine or remaining deposit is not enough, jailed if missing blocks
+func (vm ValidatorManager) punishValidatorDeposit(
+	ctx sdk.Context, username types.AccountKey, penalty types.Coin,
+	punishType types.PunishType) (types.Coin, sdk.Error) {
 	actualPenalty := penalty
 	validator, err := vm.storage.GetValidator(ctx, username)
 	if err != nil {
@@ -193,6 +261,7 @@ func (vm ValidatorManager) PunishOncallValidator(
 
 	if punishType == types.PunishAbsentCommit {
 		validator.AbsentCommit = 0
+		vm.storage.DeleteSigningInfo(ctx, username)
 	}
 
 	param, err := vm.paramHolder.GetValidatorParam(ctx)
@@ -209,62 +278,257 @@ func (vm ValidatorManager) PunishOncallValidator(
 		}
 		actualPenalty = actualPenalty.Plus(validator.Deposit)
 		validator.Deposit = types.NewCoinFromInt64(0)
+	} else if punishType == types.PunishAbsentCommit {
+		// remove from oncall list until unjailed, still in all validators list
+		lst, err := vm.storage.GetValidatorList(ctx)
+		if err != nil {
+			return actualPenalty, err
+		}
+		lst.OncallValidators = remove(validator.Username, lst.OncallValidators)
+		if err := vm.storage.SetValidatorList(ctx, lst); err != nil {
+			return actualPenalty, err
+		}
+		validator.IsJailed = true
+		validator.JailUntil = ctx.BlockHeader().Time.Unix() + types.ValidatorJailDurationSec
 	}
 
 	if err := vm.storage.SetValidator(ctx, username, validator); err != nil {
 		return actualPenalty, err
 	}
+	return actualPenalty, nil
+}
 
-	if err := vm.AdjustValidatorList(ctx); err != nil {
-		return actualPenalty, err
+func (vm ValidatorManager) addPunishmentRecord(
+	ctx sdk.Context, username types.AccountKey, record model.PunishmentRecord) sdk.Error {
+	history, err := vm.storage.GetPunishmentHistory(ctx, username)
+	if err != nil {
+		return err
 	}
-	return actualPenalty, nil
+	history.Records = append(history.Records, record)
+	return vm.storage.SetPunishmentHistory(ctx, username, history)
 }
 
-// FireIncompetentValidator - fire oncall validator if 1) deposit insufficient 2) byzantine
+// GetPunishmentHistory - get punishment log of the validator
+func (vm ValidatorManager) GetPunishmentHistory(
+	ctx sdk.Context, username types.AccountKey) (*model.PunishmentHistory, sdk.Error) {
+	return vm.storage.GetPunishmentHistory(ctx, username)
+}
+
+// FireIncompetentValidator - fire validator if 1) byzantine at evidence height 2) oncall validator
+// missing blocks reach limitation. Each punishment is applied in its own cached context, a failed
+// one is logged and discarded without affecting the others
 func (vm ValidatorManager) FireIncompetentValidator(
 	ctx sdk.Context, byzantineValidators []abci.Evidence) (types.Coin, sdk.Error) {
 	totalPenalty := types.NewCoinFromInt64(0)
-	lst, err := vm.storage.GetValidatorList(ctx)
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
 	if err != nil {
 		return totalPenalty, err
 	}
 
-	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	// punish whoever held the key at infraction height, at most once per block
+	punished := make(map[types.AccountKey]bool)
+	for i := range byzantineValidators {
+		evidence := byzantineValidators[i]
+		cacheCtx, write := ctx.CacheContext()
+		username, err := vm.getValidatorAtHeight(cacheCtx, evidence.Validator.Address, evidence.Height)
+		if err != nil {
+			ctx.Logger().Error("failed to find byzantine validator", "height", evidence.Height, "err", err)
+			continue
+		}
+		if username == "" || punished[username] {
+			continue
+		}
+		actualPenalty, err := vm.punishOncallValidator(
+			cacheCtx, username, param.PenaltyByzantine, types.PunishByzantine, &evidence)
+		if err != nil {
+			ctx.Logger().Error("failed to punish byzantine validator", "validator", username, "err", err)
+			continue
+		}
+		write()
+		totalPenalty = totalPenalty.Plus(actualPenalty)
+		punished[username] = true
+	}
+
+	lst, err := vm.storage.GetValidatorList(ctx)
 	if err != nil {
 		return totalPenalty, err
 	}
 
 	for _, validatorName := range lst.OncallValidators {
-		validator, err := vm.storage.GetValidator(ctx, validatorName)
+		if punished[validatorName] {
+			continue
+		}
+		actualPenalty, err := vm.punishAbsentValidator(ctx, validatorName, param)
 		if err != nil {
-			return totalPenalty, err
+			ctx.Logger().Error("failed to punish absent validator", "validator", validatorName, "err", err)
+			continue
 		}
+		totalPenalty = totalPenalty.Plus(actualPenalty)
+	}
 
-		for _, evidence := range byzantineValidators {
-			if reflect.DeepEqual(validator.ABCIValidator.Address, evidence.Validator.Address) {
-				actualPenalty, err := vm.PunishOncallValidator(
-					ctx, validator.Username, param.PenaltyByzantine, types.PunishByzantine)
-				if err != nil {
-					return totalPenalty, err
-				}
-				totalPenalty = totalPenalty.Plus(actualPenalty)
-				break
+	return totalPenalty, nil
+}
+
+// punishAbsentValidator - punish oncall validator if missing blocks reach limitation,
+// state is only written if the punishment succeeds
+func (vm ValidatorManager) punishAbsentValidator(
+	ctx sdk.Context, validatorName types.AccountKey, valParam *param.ValidatorParam) (types.Coin, sdk.Error) {
+	cacheCtx, write := ctx.CacheContext()
+	validator, err := vm.storage.GetValidator(cacheCtx, validatorName)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	isAbsent, err := vm.isAbsentBeyondLimit(cacheCtx, validator, valParam)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if !isAbsent {
+		return types.NewCoinFromInt64(0), nil
+	}
+	actualPenalty, err := vm.PunishOncallValidator(
+		cacheCtx, validator.Username, valParam.PenaltyMissCommit, types.PunishAbsentCommit)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	write()
+	return actualPenalty, nil
+}
+
+// getValidatorAtHeight - find the validator holding the address at given height from validator
+// set history, fall back to previous block and oncall validators. Returns empty name if not found
+func (vm ValidatorManager) getValidatorAtHeight(
+	ctx sdk.Context, address []byte, height int64) (types.AccountKey, sdk.Error) {
+	record, err := vm.storage.GetValidatorSetAtHeight(ctx, height)
+	if err != nil {
+		return "", err
+	}
+	if record != nil {
+		for _, entry := range record.Validators {
+			if bytes.Equal(entry.Address, address) {
+				return entry.Username, nil
 			}
 		}
+	}
 
-		if validator.AbsentCommit > param.AbsentCommitLimitation {
-			actualPenalty, err := vm.PunishOncallValidator(
-				ctx, validator.Username, param.PenaltyMissCommit, types.PunishAbsentCommit)
-			if err != nil {
-				return totalPenalty, err
-			}
+	lst, err := vm.storage.GetValidatorList(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, validatorName := range append(lst.PreBlockValidators, lst.OncallValidators...) {
+		validator, err := vm.storage.GetValidator(ctx, validatorName)
+		if err != nil {
+			return "", err
+		}
+		if bytes.Equal(validator.ABCIValidator.Address, address) {
+			return validatorName, nil
+		}
+	}
+	return "", nil
+}
 
-			totalPenalty = totalPenalty.Plus(actualPenalty)
+// RecordValidatorSet - record validator set of previous block if it changes,
+// records older than ValidatorSetHistoryHeight are pruned
+func (vm ValidatorManager) RecordValidatorSet(ctx sdk.Context) sdk.Error {
+	lst, err := vm.storage.GetValidatorList(ctx)
+	if err != nil {
+		return err
+	}
+	entries := []model.ValidatorSetEntry{}
+	for _, validatorName := range lst.PreBlockValidators {
+		validator, err := vm.storage.GetValidator(ctx, validatorName)
+		if err != nil {
+			return err
 		}
+		entries = append(entries, model.ValidatorSetEntry{
+			Username: validatorName,
+			Address:  validator.ABCIValidator.Address,
+		})
 	}
 
-	return totalPenalty, nil
+	latest, err := vm.storage.GetValidatorSetAtHeight(ctx, ctx.BlockHeight())
+	if err != nil {
+		return err
+	}
+	if latest == nil || !isSameValidatorSet(latest.Validators, entries) {
+		if err := vm.storage.SetValidatorSetRecord(ctx, &model.ValidatorSetRecord{
+			Height:     ctx.BlockHeight(),
+			Validators: entries,
+		}); err != nil {
+			return err
+		}
+	}
+	return vm.storage.PruneValidatorSetHistory(ctx, ctx.BlockHeight()-types.ValidatorSetHistoryHeight)
+}
+
+// GetValidatorSetAtHeight - get recorded validator set at given height, returns nil if not recorded
+func (vm ValidatorManager) GetValidatorSetAtHeight(
+	ctx sdk.Context, height int64) (*model.ValidatorSetRecord, sdk.Error) {
+	return vm.storage.GetValidatorSetAtHeight(ctx, height)
+}
+
+// AddUnbondingDeposit - add withdrawn deposit to unbonding deposit created at current height,
+// returns the deposit and whether it is newly created
+func (vm ValidatorManager) AddUnbondingDeposit(
+	ctx sdk.Context, username types.AccountKey, coin types.Coin,
+	unbondingPeriodSec int64) (*model.UnbondingDeposit, bool, sdk.Error) {
+	deposit, err := vm.storage.GetUnbondingDeposit(ctx, username, ctx.BlockHeight())
+	if err != nil {
+		return nil, false, err
+	}
+	isNew := deposit == nil
+	if isNew {
+		deposit = &model.UnbondingDeposit{
+			Username:      username,
+			Amount:        types.NewCoinFromInt64(0),
+			CreatedHeight: ctx.BlockHeight(),
+			CreatedAt:     ctx.BlockHeader().Time.Unix(),
+			CompleteAt:    ctx.BlockHeader().Time.Unix() + unbondingPeriodSec,
+		}
+	}
+	deposit.Amount = deposit.Amount.Plus(coin)
+	if err := vm.storage.SetUnbondingDeposit(ctx, deposit); err != nil {
+		return nil, false, err
+	}
+	return deposit, isNew, nil
+}
+
+// CompleteUnbondingDeposit - remove unbonding deposit and return its amount,
+// returns zero coin if the deposit has been slashed
+func (vm ValidatorManager) CompleteUnbondingDeposit(
+	ctx sdk.Context, username types.AccountKey, createdHeight int64) (types.Coin, sdk.Error) {
+	deposit, err := vm.storage.GetUnbondingDeposit(ctx, username, createdHeight)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if deposit == nil {
+		return types.NewCoinFromInt64(0), nil
+	}
+	vm.storage.DeleteUnbondingDeposit(ctx, username, createdHeight)
+	return deposit.Amount, nil
+}
+
+// GetUnbondingDeposits - get all unbonding deposits of the validator
+func (vm ValidatorManager) GetUnbondingDeposits(
+	ctx sdk.Context, username types.AccountKey) ([]model.UnbondingDeposit, sdk.Error) {
+	return vm.storage.GetUnbondingDeposits(ctx, username)
+}
+
+// slashUnbondingDeposits - slash all unbonding deposits withdrawn at or after infraction height
+func (vm ValidatorManager) slashUnbondingDeposits(
+	ctx sdk.Context, username types.AccountKey, infractionHeight int64) (types.Coin, sdk.Error) {
+	slashed := types.NewCoinFromInt64(0)
+	deposits, err := vm.storage.GetUnbondingDeposits(ctx, username)
+	if err != nil {
+		return slashed, err
+	}
+	for _, deposit := range deposits {
+		if deposit.CreatedHeight < infractionHeight {
+			continue
+		}
+		slashed = slashed.Plus(deposit.Amount)
+		vm.storage.DeleteUnbondingDeposit(ctx, username, deposit.CreatedHeight)
+	}
+	return slashed, nil
 }
 
 // PunishValidatorsDidntVote - validators are required to vote Protocol Upgrade and Parameter Change proposal
@@ -405,6 +669,11 @@ func (vm ValidatorManager) TryBecomeOncallValidator(ctx sdk.Context, username ty
 		lst.AllValidators = append(lst.AllValidators, username)
 	}
 
+	// jailed validator can't be oncall until unjailed
+	if curValidator.IsJailed {
+		return vm.storage.SetValidatorList(ctx, lst)
+	}
+
 	// add to list directly if validator list is not full
 	if int64(len(lst.OncallValidators)) < param.ValidatorListSize {
 		lst.OncallValidators = append(lst.OncallValidators, curValidator.Username)
@@ -431,6 +700,48 @@ func (vm ValidatorManager) TryBecomeOncallValidator(ctx sdk.Context, username ty
 	return nil
 }
 
+// UnjailValidator - release jailed validator after jail period and try to become oncall validator
+func (vm ValidatorManager) UnjailValidator(ctx sdk.Context, username types.AccountKey) sdk.Error {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return err
+	}
+	if !validator.IsJailed {
+		return ErrValidatorNotJailed()
+	}
+	if ctx.BlockHeader().Time.Unix() < validator.JailUntil {
+		return ErrValidatorStillJailed(validator.JailUntil)
+	}
+	validator.IsJailed = false
+	validator.JailUntil = 0
+	if err := vm.storage.SetValidator(ctx, username, validator); err != nil {
+		return err
+	}
+	return vm.TryBecomeOncallValidator(ctx, username)
+}
+
+// GetJailedValidators - get all jailed validators with their release time
+func (vm ValidatorManager) GetJailedValidators(ctx sdk.Context) ([]model.JailedValidator, sdk.Error) {
+	lst, err := vm.storage.GetValidatorList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jailed := []model.JailedValidator{}
+	for _, validatorName := range lst.AllValidators {
+		validator, err := vm.storage.GetValidator(ctx, validatorName)
+		if err != nil {
+			return nil, err
+		}
+		if validator.IsJailed {
+			jailed = append(jailed, model.JailedValidator{
+				Username:  validator.Username,
+				JailUntil: validator.JailUntil,
+			})
+		}
+	}
+	return jailed, nil
+}
+
 // RemoveValidatorFromAllLists - remove the user from both oncall and allValidators lists
 func (vm ValidatorManager) RemoveValidatorFromAllLists(ctx sdk.Context, username types.AccountKey) sdk.Error {
 	lst, err := vm.storage.GetValidatorList(ctx)
@@ -473,6 +784,27 @@ func (vm ValidatorManager) AdjustValidatorList(ctx sdk.Context) sdk.Error {
 	return nil
 }
 
+// evidenceHash - hex encoded hash of byzantine evidence
+func evidenceHash(evidence abci.Evidence) string {
+	bz, err := evidence.Marshal()
+	if err != nil {
+		return ""
+	}
+	return hex.EncodeToString(tmhash.Sum(bz))
+}
+
+func isSameValidatorSet(set1, set2 []model.ValidatorSetEntry) bool {
+	if len(set1) != len(set2) {
+		return false
+	}
+	for i := range set1 {
+		if set1[i].Username != set2[i].Username || !bytes.Equal(set1[i].Address, set2[i].Address) {
+			return false
+		}
+	}
+	return true
+}
+
 func remove(me types.AccountKey, users []types.AccountKey) []types.AccountKey {
 	idx := 0
 	for idx < len(users) {
@@ -535,8 +867,9 @@ func (vm ValidatorManager) getBestCandidate(ctx sdk.Context) (types.AccountKey,
 		if err != nil {
 			return bestCandidate, err
 		}
-		// not in the oncall list and has a larger power
-		if types.FindAccountInList(validatorName, lst.OncallValidators) == -1 &&
+		// not jailed, not in the oncall list and has a larger power
+		if !validator.IsJailed &&
+			types.FindAccountInList(validatorName, lst.OncallValidators) == -1 &&
 			validator.Deposit.IsGT(bestCandidatePower) {
 			bestCandidate = validator.Username
 			bestCandidatePower = validator.Deposit
@@ -545,3 +878,13 @@ func (vm ValidatorManager) getBestCandidate(ctx sdk.Context) (types.AccountKey,
 	return bestCandidate, nil
 
 }
+
+// Export - export validator KVStore for genesis
+func (vm ValidatorManager) Export(ctx sdk.Context) (*model.ValidatorTables, sdk.Error) {
+	return vm.storage.Export(ctx)
+}
+
+// Import - import validator KVStore from exported genesis
+func (vm ValidatorManager) Import(ctx sdk.Context, tables *model.ValidatorTables) sdk.Error {
+	return vm.storage.Import(ctx, tables)
+}
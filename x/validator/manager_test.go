@@ -4,7 +4,9 @@ import (
 	"math/rand"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/validator/model"
 	"github.com/stretchr/testify/assert"
@@ -38,7 +40,7 @@ func TestByzantines(t *testing.T) {
 		name := "user" + strconv.Itoa(i)
 		msg := NewValidatorDepositMsg(name, deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, depositResult(msg), result)
 	}
 
 	// byzantine
@@ -62,6 +64,26 @@ func TestByzantines(t *testing.T) {
 		assert.Equal(t, -1, types.FindAccountInList(users[idx], validatorList3.AllValidators))
 	}
 
+	// byzantine punishment is recorded with evidence hash and whole deposit as penalty
+	for i, idx := range byzantineList {
+		history, err := valManager.GetPunishmentHistory(ctx, users[idx])
+		assert.Nil(t, err)
+		validatorMinDeposit, _ := valParam.ValidatorMinCommittingDeposit.ToInt64()
+		deposit := types.NewCoinFromInt64((int64(idx+1)*10)*types.Decimals + validatorMinDeposit)
+		assert.Equal(t, []model.PunishmentRecord{{
+			Height:       ctx.BlockHeight(),
+			Time:         ctx.BlockHeader().Time.Unix(),
+			PunishType:   types.PunishByzantine,
+			Penalty:      deposit,
+			EvidenceHash: evidenceHash(byzantines[i]),
+		}}, history.Records)
+		assert.NotEqual(t, "", history.Records[0].EvidenceHash)
+	}
+
+	// validator never punished has empty history
+	history, err := valManager.GetPunishmentHistory(ctx, users[0])
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(history.Records))
 }
 
 func TestAbsentValidatorWillBeFired(t *testing.T) {
@@ -69,7 +91,11 @@ func TestAbsentValidatorWillBeFired(t *testing.T) {
 	handler := NewHandler(am, valManager, voteManager, gm)
 	valManager.InitGenesis(ctx)
 
+	// absent commit limitation is used when signed blocks window is disabled
 	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	valParam.SignedBlocksWindow = 0
+	err := param.ChangeParamEvent{Param: *valParam}.Execute(ctx, valManager.paramHolder)
+	assert.Nil(t, err)
 	minBalance := types.NewCoinFromInt64(100000 * types.Decimals)
 	// create 21 test users
 	users := make([]types.AccountKey, 21)
@@ -86,7 +112,7 @@ func TestAbsentValidatorWillBeFired(t *testing.T) {
 		name := "user" + strconv.Itoa(i)
 		msg := NewValidatorDepositMsg(name, deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, depositResult(msg), result)
 	}
 
 	// construct signing list
@@ -112,7 +138,7 @@ func TestAbsentValidatorWillBeFired(t *testing.T) {
 	for i, v := range perm {
 		destSigningList[v] = signingList[i]
 	}
-	err := valManager.UpdateSigningValidator(ctx, signingList)
+	err = valManager.UpdateSigningValidator(ctx, signingList)
 	assert.Nil(t, err)
 
 	index = 0
@@ -166,7 +192,11 @@ func TestAbsentValidatorWontBeFired(t *testing.T) {
 	handler := NewHandler(am, valManager, voteManager, gm)
 	valManager.InitGenesis(ctx)
 
+	// absent commit limitation is used when signed blocks window is disabled
 	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	valParam.SignedBlocksWindow = 0
+	err := param.ChangeParamEvent{Param: *valParam}.Execute(ctx, valManager.paramHolder)
+	assert.Nil(t, err)
 	minBalance := types.NewCoinFromInt64(100000 * types.Decimals)
 	// create 21 test users
 	users := make([]types.AccountKey, 21)
@@ -183,7 +213,7 @@ func TestAbsentValidatorWontBeFired(t *testing.T) {
 		name := "user" + strconv.Itoa(i)
 		msg := NewValidatorDepositMsg(name, deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, depositResult(msg), result)
 	}
 
 	// construct signing list
@@ -209,7 +239,7 @@ func TestAbsentValidatorWontBeFired(t *testing.T) {
 	for i, v := range perm {
 		destSigningList[v] = signingList[i]
 	}
-	err := valManager.UpdateSigningValidator(ctx, signingList)
+	err = valManager.UpdateSigningValidator(ctx, signingList)
 	assert.Nil(t, err)
 
 	index = 0
@@ -249,8 +279,12 @@ func TestAbsentValidatorWontBeFired(t *testing.T) {
 	assert.Nil(t, err)
 	validatorList2, _ := valManager.storage.GetValidatorList(ctx)
 
-	assert.Equal(t, 21, len(validatorList2.OncallValidators))
+	// absent validators are jailed instead of fired
+	assert.Equal(t, 18, len(validatorList2.OncallValidators))
 	assert.Equal(t, 21, len(validatorList2.AllValidators))
+	for _, idx := range absentList {
+		assert.Equal(t, -1, types.FindAccountInList(types.AccountKey("user"+strconv.Itoa(idx)), validatorList2.OncallValidators))
+	}
 
 	// check deposit has been deducted by 200
 	for _, v := range absentList {
@@ -288,7 +322,7 @@ func TestGetOncallList(t *testing.T) {
 		name := "user" + strconv.Itoa(i)
 		msg := NewValidatorDepositMsg(name, deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, depositResult(msg), result)
 	}
 
 	lst, _ := valManager.GetValidatorList(ctx)
@@ -341,6 +375,324 @@ func TestPunishmentBasic(t *testing.T) {
 	assert.Equal(t, true, validator2.Deposit.IsZero())
 }
 
+func TestJailAndUnjail(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1000, 0)})
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(100000 * types.Decimals)
+	deposit := valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(1000 * types.Decimals))
+	user1 := createTestAccount(ctx, am, "user1", minBalance.Plus(deposit))
+	user2 := createTestAccount(ctx, am, "user2", minBalance.Plus(deposit))
+	voteManager.AddVoter(ctx, user1, valParam.ValidatorMinVotingDeposit)
+	voteManager.AddVoter(ctx, user2, valParam.ValidatorMinVotingDeposit)
+	handler(ctx, NewValidatorDepositMsg("user1", coinToString(deposit), secp256k1.GenPrivKey().PubKey(), ""))
+	handler(ctx, NewValidatorDepositMsg("user2", coinToString(deposit), secp256k1.GenPrivKey().PubKey(), ""))
+
+	// user1 misses commits but still has enough deposit, should be jailed
+	penalty, err := valManager.PunishOncallValidator(ctx, user1, valParam.PenaltyMissCommit, types.PunishAbsentCommit)
+	assert.Nil(t, err)
+	assert.Equal(t, valParam.PenaltyMissCommit, penalty)
+
+	lst, _ := valManager.storage.GetValidatorList(ctx)
+	assert.Equal(t, []types.AccountKey{user2}, lst.OncallValidators)
+	assert.Equal(t, 2, len(lst.AllValidators))
+
+	validator, _ := valManager.storage.GetValidator(ctx, user1)
+	assert.True(t, validator.IsJailed)
+	assert.Equal(t, 1000+types.ValidatorJailDurationSec, validator.JailUntil)
+	assert.Equal(t, deposit.Minus(valParam.PenaltyMissCommit), validator.Deposit)
+	history, _ := valManager.GetPunishmentHistory(ctx, user1)
+	assert.Equal(t, []model.PunishmentRecord{{
+		Height:     ctx.BlockHeight(),
+		Time:       1000,
+		PunishType: types.PunishAbsentCommit,
+		Penalty:    valParam.PenaltyMissCommit,
+	}}, history.Records)
+
+	jailed, err := valManager.GetJailedValidators(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.JailedValidator{{Username: user1, JailUntil: 1000 + types.ValidatorJailDurationSec}}, jailed)
+
+	// jailed validator can't become oncall validator
+	assert.Nil(t, valManager.TryBecomeOncallValidator(ctx, user1))
+	lst, _ = valManager.storage.GetValidatorList(ctx)
+	assert.Equal(t, []types.AccountKey{user2}, lst.OncallValidators)
+
+	testCases := []struct {
+		testName  string
+		username  types.AccountKey
+		atWhen    int64
+		expectErr sdk.Error
+	}{
+		{
+			testName:  "unjail validator not jailed",
+			username:  user2,
+			atWhen:    1000 + types.ValidatorJailDurationSec,
+			expectErr: ErrValidatorNotJailed(),
+		},
+		{
+			testName:  "unjail before jail period ends",
+			username:  user1,
+			atWhen:    999 + types.ValidatorJailDurationSec,
+			expectErr: ErrValidatorStillJailed(1000 + types.ValidatorJailDurationSec),
+		},
+		{
+			testName:  "unjail after jail period",
+			username:  user1,
+			atWhen:    1000 + types.ValidatorJailDurationSec,
+			expectErr: nil,
+		},
+		{
+			testName:  "unjail again",
+			username:  user1,
+			atWhen:    1000 + types.ValidatorJailDurationSec,
+			expectErr: ErrValidatorNotJailed(),
+		},
+	}
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(tc.atWhen, 0)})
+		err := valManager.UnjailValidator(ctx, tc.username)
+		if !assert.Equal(t, tc.expectErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
+		}
+	}
+
+	lst, _ = valManager.storage.GetValidatorList(ctx)
+	assert.Equal(t, 2, len(lst.OncallValidators))
+	assert.NotEqual(t, -1, types.FindAccountInList(user1, lst.OncallValidators))
+	jailed, _ = valManager.GetJailedValidators(ctx)
+	assert.Equal(t, 0, len(jailed))
+}
+
+func TestByzantineMatchedByValidatorSetHistory(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	valParam.ValidatorUnbondingPeriodSec = 3600
+	err := param.ChangeParamEvent{Param: *valParam}.Execute(ctx, valManager.paramHolder)
+	assert.Nil(t, err)
+
+	minBalance := types.NewCoinFromInt64(100000 * types.Decimals)
+	deposit := valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(1000 * types.Decimals))
+	user1 := createTestAccount(ctx, am, "user1", minBalance.Plus(deposit))
+	user2 := createTestAccount(ctx, am, "user2", minBalance.Plus(deposit))
+	voteManager.AddVoter(ctx, user1, valParam.ValidatorMinVotingDeposit)
+	voteManager.AddVoter(ctx, user2, valParam.ValidatorMinVotingDeposit)
+	valKey1 := secp256k1.GenPrivKey().PubKey()
+	handler(ctx, NewValidatorDepositMsg("user1", coinToString(deposit), valKey1, ""))
+	handler(ctx, NewValidatorDepositMsg("user2", coinToString(deposit), secp256k1.GenPrivKey().PubKey(), ""))
+
+	// validator set is recorded at height 10
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 10, Time: time.Unix(2000, 0)})
+	BeginBlocker(ctx, abci.RequestBeginBlock{}, valManager)
+	record, err := valManager.GetValidatorSetAtHeight(ctx, 15)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), record.Height)
+	assert.Equal(t, 2, len(record.Validators))
+
+	// user1 revokes at height 20, deposit is unbonding instead of returned
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 20, Time: time.Unix(3000, 0)})
+	result := handler(ctx, NewValidatorRevokeMsg("user1"))
+	assert.True(t, result.IsOK())
+	deposits, err := valManager.GetUnbondingDeposits(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.UnbondingDeposit{{
+		Username:      user1,
+		Amount:        deposit,
+		CreatedHeight: 20,
+		CreatedAt:     3000,
+		CompleteAt:    3000 + 3600,
+	}}, deposits)
+	assert.Equal(t,
+		&types.TimeEventList{Events: []types.Event{UnbondingEvent{Username: user1, CreatedHeight: 20}}},
+		gm.GetTimeEventListAtTime(ctx, 3000+3600))
+
+	// validator set without user1 is recorded at height 21
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 21, Time: time.Unix(3001, 0)})
+	BeginBlocker(ctx, abci.RequestBeginBlock{}, valManager)
+	record, _ = valManager.GetValidatorSetAtHeight(ctx, 21)
+	assert.Equal(t, int64(21), record.Height)
+	assert.Equal(t, 1, len(record.Validators))
+	assert.Equal(t, user2, record.Validators[0].Username)
+	// record before the change is kept while height is within history window
+	record, _ = valManager.GetValidatorSetAtHeight(ctx, 15)
+	assert.Equal(t, int64(10), record.Height)
+	assert.Equal(t, 2, len(record.Validators))
+
+	// evidence at height 25 doesn't match user1 who is no longer a validator
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 30, Time: time.Unix(4000, 0)})
+	evidence := abci.Evidence{
+		Validator: abci.Validator{Address: valKey1.Address(), PubKey: tmtypes.TM2PB.PubKey(valKey1), Power: 1000},
+		Height:    25,
+	}
+	penalty, err := valManager.FireIncompetentValidator(ctx, []abci.Evidence{evidence})
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(0), penalty)
+
+	// evidence at height 15 matches user1 by validator set history, unbonding deposit is slashed once
+	evidence.Height = 15
+	penalty, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{evidence, evidence})
+	assert.Nil(t, err)
+	assert.Equal(t, deposit, penalty)
+	deposits, _ = valManager.GetUnbondingDeposits(ctx, user1)
+	assert.Equal(t, 0, len(deposits))
+	history, _ := valManager.GetPunishmentHistory(ctx, user1)
+	assert.Equal(t, []model.PunishmentRecord{{
+		Height:       30,
+		Time:         4000,
+		PunishType:   types.PunishByzantine,
+		Penalty:      deposit,
+		EvidenceHash: evidenceHash(evidence),
+	}}, history.Records)
+
+	// slashed deposit is not returned when unbonding completes
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 40, Time: time.Unix(3000+3600, 0)})
+	saving, _ := am.GetSavingFromBank(ctx, user1)
+	err = UnbondingEvent{Username: user1, CreatedHeight: 20}.Execute(ctx, valManager, am, gm)
+	assert.Nil(t, err)
+	newSaving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.Equal(t, saving, newSaving)
+	assert.Nil(t, gm.GetTimeEventListAtTime(ctx, 3000+3600+valParam.ValidatorCoinReturnIntervalSec))
+}
+
+func TestByzantineAfterRevokedValidatorRemoved(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	valParam.ValidatorUnbondingPeriodSec = 3600
+	err := param.ChangeParamEvent{Param: *valParam}.Execute(ctx, valManager.paramHolder)
+	assert.Nil(t, err)
+
+	minBalance := types.NewCoinFromInt64(100000 * types.Decimals)
+	deposit := valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(1000 * types.Decimals))
+	user1 := createTestAccount(ctx, am, "user1", minBalance.Plus(deposit))
+	voteManager.AddVoter(ctx, user1, valParam.ValidatorMinVotingDeposit)
+	valKey1 := secp256k1.GenPrivKey().PubKey()
+	handler(ctx, NewValidatorDepositMsg("user1", coinToString(deposit), valKey1, ""))
+
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 10, Time: time.Unix(2000, 0)})
+	BeginBlocker(ctx, abci.RequestBeginBlock{}, valManager)
+
+	// user1 revokes at height 20, validator record is deleted at end of block
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 20, Time: time.Unix(3000, 0)})
+	result := handler(ctx, NewValidatorRevokeMsg("user1"))
+	assert.True(t, result.IsOK())
+	_, err = valManager.GetUpdateValidatorList(ctx)
+	assert.Nil(t, err)
+	assert.False(t, valManager.storage.DoesValidatorExist(ctx, user1))
+
+	// evidence at height 15 still slashes unbonding deposit of the removed validator
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 30, Time: time.Unix(4000, 0)})
+	evidence := abci.Evidence{
+		Validator: abci.Validator{Address: valKey1.Address(), PubKey: tmtypes.TM2PB.PubKey(valKey1), Power: 1000},
+		Height:    15,
+	}
+	penalty, err := valManager.FireIncompetentValidator(ctx, []abci.Evidence{evidence})
+	assert.Nil(t, err)
+	assert.Equal(t, deposit, penalty)
+	deposits, _ := valManager.GetUnbondingDeposits(ctx, user1)
+	assert.Equal(t, 0, len(deposits))
+	history, _ := valManager.GetPunishmentHistory(ctx, user1)
+	assert.Equal(t, []model.PunishmentRecord{{
+		Height:       30,
+		Time:         4000,
+		PunishType:   types.PunishByzantine,
+		Penalty:      deposit,
+		EvidenceHash: evidenceHash(evidence),
+	}}, history.Records)
+	assert.False(t, valManager.storage.DoesValidatorExist(ctx, user1))
+}
+
+func TestFailedEvidenceDoesNotAbortOthers(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(100000 * types.Decimals)
+	deposit := valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(1000 * types.Decimals))
+	user1 := createTestAccount(ctx, am, "user1", minBalance.Plus(deposit))
+	user2 := createTestAccount(ctx, am, "user2", minBalance.Plus(deposit))
+	voteManager.AddVoter(ctx, user1, valParam.ValidatorMinVotingDeposit)
+	voteManager.AddVoter(ctx, user2, valParam.ValidatorMinVotingDeposit)
+	valKey1 := secp256k1.GenPrivKey().PubKey()
+	valKey2 := secp256k1.GenPrivKey().PubKey()
+	handler(ctx, NewValidatorDepositMsg("user1", coinToString(deposit), valKey1, ""))
+	handler(ctx, NewValidatorDepositMsg("user2", coinToString(deposit), valKey2, ""))
+
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 10, Time: time.Unix(2000, 0)})
+	BeginBlocker(ctx, abci.RequestBeginBlock{}, valManager)
+
+	// punishment of user1 fails when recording it, nothing of it is written
+	ctx.KVStore(testValidatorKVStoreKey).Set(model.GetPunishmentHistoryKey(user1), []byte("invalid"))
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 30, Time: time.Unix(4000, 0)})
+	evidences := []abci.Evidence{
+		{
+			Validator: abci.Validator{Address: valKey1.Address(), PubKey: tmtypes.TM2PB.PubKey(valKey1), Power: 1000},
+			Height:    10,
+		},
+		{
+			Validator: abci.Validator{Address: valKey2.Address(), PubKey: tmtypes.TM2PB.PubKey(valKey2), Power: 1000},
+			Height:    10,
+		},
+	}
+	penalty, err := valManager.FireIncompetentValidator(ctx, evidences)
+	assert.Nil(t, err)
+	assert.Equal(t, deposit, penalty)
+
+	lst, _ := valManager.storage.GetValidatorList(ctx)
+	assert.NotEqual(t, -1, types.FindAccountInList(user1, lst.AllValidators))
+	assert.Equal(t, -1, types.FindAccountInList(user2, lst.AllValidators))
+	validator1, err := valManager.storage.GetValidator(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, deposit, validator1.Deposit)
+	history, _ := valManager.GetPunishmentHistory(ctx, user2)
+	assert.Equal(t, 1, len(history.Records))
+}
+
+func TestUnbondingDepositReturned(t *testing.T) {
+	ctx, am, valManager, _, gm := setupTest(t, 0)
+	valManager.InitGenesis(ctx)
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	valParam.ValidatorUnbondingPeriodSec = 3600
+	err := param.ChangeParamEvent{Param: *valParam}.Execute(ctx, valManager.paramHolder)
+	assert.Nil(t, err)
+
+	user1 := createTestAccount(ctx, am, "user1", types.NewCoinFromInt64(0))
+
+	// two withdraws in one block are merged into one unbonding deposit
+	withdraw := types.NewCoinFromInt64(100 * types.Decimals)
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 2, Time: time.Unix(2000, 0)})
+	for i := 0; i < 2; i++ {
+		err := unbondCoinTo(ctx, user1, valManager, gm, am, withdraw)
+		assert.Nil(t, err)
+	}
+	deposits, _ := valManager.GetUnbondingDeposits(ctx, user1)
+	assert.Equal(t, 1, len(deposits))
+	assert.Equal(t, withdraw.Plus(withdraw), deposits[0].Amount)
+	assert.Equal(t, 1, len(gm.GetTimeEventListAtTime(ctx, 2000+3600).Events))
+
+	// unbonding deposit is returned in coin return events after unbonding period
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 100, Time: time.Unix(2000+3600, 0)})
+	err = UnbondingEvent{Username: user1, CreatedHeight: 2}.Execute(ctx, valManager, am, gm)
+	assert.Nil(t, err)
+	deposits, _ = valManager.GetUnbondingDeposits(ctx, user1)
+	assert.Equal(t, 0, len(deposits))
+	assert.NotNil(t, gm.GetTimeEventListAtTime(ctx, 2000+3600+valParam.ValidatorCoinReturnIntervalSec))
+}
+
 func TestPunishmentAndSubstitutionExists(t *testing.T) {
 	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
 	handler := NewHandler(am, valManager, voteManager, gm)
@@ -363,7 +715,7 @@ func TestPunishmentAndSubstitutionExists(t *testing.T) {
 		valKeys[i] = secp256k1.GenPrivKey().PubKey()
 		msg := NewValidatorDepositMsg("user"+strconv.Itoa(i+1), deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, depositResult(msg), result)
 	}
 
 	// lowest is user4 with power (min + 400)
@@ -33,3 +33,43 @@ func ErrFailedToUnmarshalValidator(err error) sdk.Error {
 func ErrFailedToUnmarshalValidatorList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalValidatorList, fmt.Sprintf("failed to unmarshal validator list: %s", err.Error()))
 }
+
+func ErrFailedToMarshalCommission(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalCommission, fmt.Sprintf("failed to marshal commission: %s", err.Error()))
+}
+
+func ErrFailedToUnmarshalCommission(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalCommission, fmt.Sprintf("failed to unmarshal commission: %s", err.Error()))
+}
+
+func ErrFailedToMarshalPunishmentHistory(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPunishmentHistory, fmt.Sprintf("failed to marshal punishment history: %s", err.Error()))
+}
+
+func ErrFailedToUnmarshalPunishmentHistory(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPunishmentHistory, fmt.Sprintf("failed to unmarshal punishment history: %s", err.Error()))
+}
+
+func ErrFailedToMarshalValidatorSetRecord(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalValidatorSetRecord, fmt.Sprintf("failed to marshal validator set record: %s", err.Error()))
+}
+
+func ErrFailedToUnmarshalValidatorSetRecord(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalValidatorSetRecord, fmt.Sprintf("failed to unmarshal validator set record: %s", err.Error()))
+}
+
+func ErrFailedToMarshalUnbondingDeposit(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalUnbondingDeposit, fmt.Sprintf("failed to marshal unbonding deposit: %s", err.Error()))
+}
+
+func ErrFailedToUnmarshalUnbondingDeposit(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalUnbondingDeposit, fmt.Sprintf("failed to unmarshal unbonding deposit: %s", err.Error()))
+}
+
+func ErrFailedToMarshalSigningInfo(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalSigningInfo, fmt.Sprintf("failed to marshal signing info: %s", err.Error()))
+}
+
+func ErrFailedToUnmarshalSigningInfo(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalSigningInfo, fmt.Sprintf("failed to unmarshal signing info: %s", err.Error()))
+}
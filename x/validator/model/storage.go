@@ -1,6 +1,9 @@
 package model
 
 import (
+	"encoding/binary"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/types"
@@ -9,6 +12,11 @@ import (
 var (
 	validatorSubstore     = []byte{0x00}
 	validatorListSubstore = []byte{0x01}
+	commissionSubstore    = []byte{0x02}
+	punishmentSubstore    = []byte{0x03}
+	validatorSetSubstore  = []byte{0x04}
+	unbondingSubstore     = []byte{0x05}
+	signingInfoSubstore   = []byte{0x06}
 )
 
 type ValidatorStorage struct {
@@ -94,6 +102,305 @@ func (vs ValidatorStorage) SetValidatorList(ctx sdk.Context, lst *ValidatorList)
 	return nil
 }
 
+// GetCommission - get commission declared by the validator, returns nil if not declared
+func (vs ValidatorStorage) GetCommission(ctx sdk.Context, accKey types.AccountKey) (*Commission, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	commissionByte := store.Get(GetCommissionKey(accKey))
+	if commissionByte == nil {
+		return nil, nil
+	}
+	commission := new(Commission)
+	if err := vs.cdc.UnmarshalJSON(commissionByte, commission); err != nil {
+		return nil, ErrFailedToUnmarshalCommission(err)
+	}
+	return commission, nil
+}
+
+// SetCommission - set commission of the validator
+func (vs ValidatorStorage) SetCommission(ctx sdk.Context, accKey types.AccountKey, commission *Commission) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	commissionByte, err := vs.cdc.MarshalJSON(*commission)
+	if err != nil {
+		return ErrFailedToMarshalCommission(err)
+	}
+	store.Set(GetCommissionKey(accKey), commissionByte)
+	return nil
+}
+
+// DeleteCommission - delete commission of the validator
+func (vs ValidatorStorage) DeleteCommission(ctx sdk.Context, accKey types.AccountKey) {
+	store := ctx.KVStore(vs.key)
+	store.Delete(GetCommissionKey(accKey))
+}
+
+// GetPunishmentHistory - get punishment log of the validator, returns empty history if not punished
+func (vs ValidatorStorage) GetPunishmentHistory(ctx sdk.Context, accKey types.AccountKey) (*PunishmentHistory, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	historyByte := store.Get(GetPunishmentHistoryKey(accKey))
+	if historyByte == nil {
+		return &PunishmentHistory{Records: []PunishmentRecord{}}, nil
+	}
+	history := new(PunishmentHistory)
+	if err := vs.cdc.UnmarshalJSON(historyByte, history); err != nil {
+		return nil, ErrFailedToUnmarshalPunishmentHistory(err)
+	}
+	return history, nil
+}
+
+// SetPunishmentHistory - set punishment log of the validator
+func (vs ValidatorStorage) SetPunishmentHistory(ctx sdk.Context, accKey types.AccountKey, history *PunishmentHistory) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	historyByte, err := vs.cdc.MarshalJSON(*history)
+	if err != nil {
+		return ErrFailedToMarshalPunishmentHistory(err)
+	}
+	store.Set(GetPunishmentHistoryKey(accKey), historyByte)
+	return nil
+}
+
+// GetValidatorSetAtHeight - get the latest validator set record at or before height,
+// returns nil if no record found
+func (vs ValidatorStorage) GetValidatorSetAtHeight(ctx sdk.Context, height int64) (*ValidatorSetRecord, sdk.Error) {
+	// negative height would wrap around in record key
+	if height < 0 {
+		return nil, nil
+	}
+	store := ctx.KVStore(vs.key)
+	iter := store.ReverseIterator(validatorSetSubstore, GetValidatorSetRecordKey(height+1))
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, nil
+	}
+	record := new(ValidatorSetRecord)
+	if err := vs.cdc.UnmarshalJSON(iter.Value(), record); err != nil {
+		return nil, ErrFailedToUnmarshalValidatorSetRecord(err)
+	}
+	return record, nil
+}
+
+// SetValidatorSetRecord - set validator set record at record height
+func (vs ValidatorStorage) SetValidatorSetRecord(ctx sdk.Context, record *ValidatorSetRecord) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	recordByte, err := vs.cdc.MarshalJSON(*record)
+	if err != nil {
+		return ErrFailedToMarshalValidatorSetRecord(err)
+	}
+	store.Set(GetValidatorSetRecordKey(record.Height), recordByte)
+	return nil
+}
+
+// PruneValidatorSetHistory - delete validator set records which are not needed
+// to look up validator set at or after height, the record still in effect at height
+// is kept. Nothing is pruned if height is not positive
+func (vs ValidatorStorage) PruneValidatorSetHistory(ctx sdk.Context, height int64) sdk.Error {
+	if height <= 0 {
+		return nil
+	}
+	record, err := vs.GetValidatorSetAtHeight(ctx, height)
+	if err != nil || record == nil {
+		return err
+	}
+	store := ctx.KVStore(vs.key)
+	iter := store.Iterator(validatorSetSubstore, GetValidatorSetRecordKey(record.Height))
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// GetUnbondingDeposit - get unbonding deposit of the user created at given height,
+// returns nil if not found
+func (vs ValidatorStorage) GetUnbondingDeposit(
+	ctx sdk.Context, accKey types.AccountKey, createdHeight int64) (*UnbondingDeposit, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	depositByte := store.Get(GetUnbondingDepositKey(accKey, createdHeight))
+	if depositByte == nil {
+		return nil, nil
+	}
+	deposit := new(UnbondingDeposit)
+	if err := vs.cdc.UnmarshalJSON(depositByte, deposit); err != nil {
+		return nil, ErrFailedToUnmarshalUnbondingDeposit(err)
+	}
+	return deposit, nil
+}
+
+// GetUnbondingDeposits - get all unbonding deposits of the user
+func (vs ValidatorStorage) GetUnbondingDeposits(
+	ctx sdk.Context, accKey types.AccountKey) ([]UnbondingDeposit, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	iter := sdk.KVStorePrefixIterator(store, getUnbondingDepositPrefix(accKey))
+	defer iter.Close()
+	deposits := []UnbondingDeposit{}
+	for ; iter.Valid(); iter.Next() {
+		var deposit UnbondingDeposit
+		if err := vs.cdc.UnmarshalJSON(iter.Value(), &deposit); err != nil {
+			return nil, ErrFailedToUnmarshalUnbondingDeposit(err)
+		}
+		deposits = append(deposits, deposit)
+	}
+	return deposits, nil
+}
+
+// SetUnbondingDeposit - set unbonding deposit of the user
+func (vs ValidatorStorage) SetUnbondingDeposit(ctx sdk.Context, deposit *UnbondingDeposit) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	depositByte, err := vs.cdc.MarshalJSON(*deposit)
+	if err != nil {
+		return ErrFailedToMarshalUnbondingDeposit(err)
+	}
+	store.Set(GetUnbondingDepositKey(deposit.Username, deposit.CreatedHeight), depositByte)
+	return nil
+}
+
+// DeleteUnbondingDeposit - delete unbonding deposit of the user created at given height
+func (vs ValidatorStorage) DeleteUnbondingDeposit(ctx sdk.Context, accKey types.AccountKey, createdHeight int64) {
+	store := ctx.KVStore(vs.key)
+	store.Delete(GetUnbondingDepositKey(accKey, createdHeight))
+}
+
+// GetSigningInfo - get signing record of the validator, returns empty record if not tracked
+func (vs ValidatorStorage) GetSigningInfo(ctx sdk.Context, accKey types.AccountKey) (*SigningInfo, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	infoByte := store.Get(GetSigningInfoKey(accKey))
+	if infoByte == nil {
+		return &SigningInfo{}, nil
+	}
+	info := new(SigningInfo)
+	if err := vs.cdc.UnmarshalJSON(infoByte, info); err != nil {
+		return nil, ErrFailedToUnmarshalSigningInfo(err)
+	}
+	return info, nil
+}
+
+// SetSigningInfo - set signing record of the validator
+func (vs ValidatorStorage) SetSigningInfo(ctx sdk.Context, accKey types.AccountKey, info *SigningInfo) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	infoByte, err := vs.cdc.MarshalJSON(*info)
+	if err != nil {
+		return ErrFailedToMarshalSigningInfo(err)
+	}
+	store.Set(GetSigningInfoKey(accKey), infoByte)
+	return nil
+}
+
+// DeleteSigningInfo - delete signing record of the validator
+func (vs ValidatorStorage) DeleteSigningInfo(ctx sdk.Context, accKey types.AccountKey) {
+	store := ctx.KVStore(vs.key)
+	store.Delete(GetSigningInfoKey(accKey))
+}
+
+// Export - export all records in validator KVStore
+func (vs ValidatorStorage) Export(ctx sdk.Context) (*ValidatorTables, sdk.Error) {
+	tables := &ValidatorTables{}
+	store := ctx.KVStore(vs.key)
+	iter := sdk.KVStorePrefixIterator(store, validatorSubstore)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var row Validator
+		if err := vs.cdc.UnmarshalJSON(iter.Value(), &row); err != nil {
+			return nil, ErrFailedToUnmarshalValidator(err)
+		}
+		tables.Validators = append(tables.Validators, row)
+	}
+
+	commissionIter := sdk.KVStorePrefixIterator(store, commissionSubstore)
+	defer commissionIter.Close()
+	for ; commissionIter.Valid(); commissionIter.Next() {
+		row := CommissionRow{Username: types.AccountKey(commissionIter.Key()[len(commissionSubstore):])}
+		if err := vs.cdc.UnmarshalJSON(commissionIter.Value(), &row.Commission); err != nil {
+			return nil, ErrFailedToUnmarshalCommission(err)
+		}
+		tables.Commissions = append(tables.Commissions, row)
+	}
+
+	punishmentIter := sdk.KVStorePrefixIterator(store, punishmentSubstore)
+	defer punishmentIter.Close()
+	for ; punishmentIter.Valid(); punishmentIter.Next() {
+		row := PunishmentHistoryRow{Username: types.AccountKey(punishmentIter.Key()[len(punishmentSubstore):])}
+		if err := vs.cdc.UnmarshalJSON(punishmentIter.Value(), &row.History); err != nil {
+			return nil, ErrFailedToUnmarshalPunishmentHistory(err)
+		}
+		tables.PunishmentHistories = append(tables.PunishmentHistories, row)
+	}
+
+	setIter := sdk.KVStorePrefixIterator(store, validatorSetSubstore)
+	defer setIter.Close()
+	for ; setIter.Valid(); setIter.Next() {
+		var row ValidatorSetRecord
+		if err := vs.cdc.UnmarshalJSON(setIter.Value(), &row); err != nil {
+			return nil, ErrFailedToUnmarshalValidatorSetRecord(err)
+		}
+		tables.ValidatorSetHistory = append(tables.ValidatorSetHistory, row)
+	}
+
+	unbondingIter := sdk.KVStorePrefixIterator(store, unbondingSubstore)
+	defer unbondingIter.Close()
+	for ; unbondingIter.Valid(); unbondingIter.Next() {
+		var row UnbondingDeposit
+		if err := vs.cdc.UnmarshalJSON(unbondingIter.Value(), &row); err != nil {
+			return nil, ErrFailedToUnmarshalUnbondingDeposit(err)
+		}
+		tables.UnbondingDeposits = append(tables.UnbondingDeposits, row)
+	}
+
+	signingIter := sdk.KVStorePrefixIterator(store, signingInfoSubstore)
+	defer signingIter.Close()
+	for ; signingIter.Valid(); signingIter.Next() {
+		row := SigningInfoRow{Username: types.AccountKey(signingIter.Key()[len(signingInfoSubstore):])}
+		if err := vs.cdc.UnmarshalJSON(signingIter.Value(), &row.SigningInfo); err != nil {
+			return nil, ErrFailedToUnmarshalSigningInfo(err)
+		}
+		tables.SigningInfos = append(tables.SigningInfos, row)
+	}
+
+	lst, err := vs.GetValidatorList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables.List = *lst
+	return tables, nil
+}
+
+// Import - import all records exported by Export to validator KVStore
+func (vs ValidatorStorage) Import(ctx sdk.Context, tables *ValidatorTables) sdk.Error {
+	for _, row := range tables.Validators {
+		if err := vs.SetValidator(ctx, row.Username, &row); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.Commissions {
+		if err := vs.SetCommission(ctx, row.Username, &row.Commission); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.PunishmentHistories {
+		if err := vs.SetPunishmentHistory(ctx, row.Username, &row.History); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.ValidatorSetHistory {
+		if err := vs.SetValidatorSetRecord(ctx, &row); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.UnbondingDeposits {
+		if err := vs.SetUnbondingDeposit(ctx, &row); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.SigningInfos {
+		if err := vs.SetSigningInfo(ctx, row.Username, &row.SigningInfo); err != nil {
+			return err
+		}
+	}
+	return vs.SetValidatorList(ctx, &tables.List)
+}
+
 func GetValidatorKey(accKey types.AccountKey) []byte {
 	return append(validatorSubstore, accKey...)
 }
@@ -101,3 +408,31 @@ func GetValidatorKey(accKey types.AccountKey) []byte {
 func GetValidatorListKey() []byte {
 	return validatorListSubstore
 }
+
+func GetCommissionKey(accKey types.AccountKey) []byte {
+	return append(commissionSubstore, accKey...)
+}
+
+func GetPunishmentHistoryKey(accKey types.AccountKey) []byte {
+	return append(punishmentSubstore, accKey...)
+}
+
+func GetSigningInfoKey(accKey types.AccountKey) []byte {
+	return append(signingInfoSubstore, accKey...)
+}
+
+// GetValidatorSetRecordKey - key of validator set record, height is big endian encoded
+// so records are iterated in height order
+func GetValidatorSetRecordKey(height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(validatorSetSubstore, heightBytes...)
+}
+
+func getUnbondingDepositPrefix(accKey types.AccountKey) []byte {
+	return append(append(unbondingSubstore, accKey...), types.KeySeparator...)
+}
+
+func GetUnbondingDepositKey(accKey types.AccountKey, createdHeight int64) []byte {
+	return strconv.AppendInt(getUnbondingDepositPrefix(accKey), createdHeight, 10)
+}
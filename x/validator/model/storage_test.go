@@ -121,3 +121,98 @@ func TestValidatorList(t *testing.T) {
 		}
 	}
 }
+
+func TestValidatorSetHistory(t *testing.T) {
+	ctx, vs := setup(t)
+
+	record, err := vs.GetValidatorSetAtHeight(ctx, 100)
+	assert.Nil(t, err)
+	assert.Nil(t, record)
+
+	for _, height := range []int64{10, 20, 300} {
+		err := vs.SetValidatorSetRecord(ctx, &ValidatorSetRecord{
+			Height: height,
+			Validators: []ValidatorSetEntry{
+				{Username: types.AccountKey("user"), Address: []byte{byte(height)}},
+			},
+		})
+		assert.Nil(t, err)
+	}
+
+	testCases := []struct {
+		testName       string
+		height         int64
+		expectedHeight int64
+	}{
+		{testName: "before first record", height: 9, expectedHeight: 0},
+		{testName: "at first record", height: 10, expectedHeight: 10},
+		{testName: "between records", height: 299, expectedHeight: 20},
+		{testName: "after last record", height: 1000, expectedHeight: 300},
+	}
+	for _, tc := range testCases {
+		record, err := vs.GetValidatorSetAtHeight(ctx, tc.height)
+		assert.Nil(t, err)
+		if tc.expectedHeight == 0 {
+			if record != nil {
+				t.Errorf("%s: diff record, got %v, want nil", tc.testName, record)
+			}
+			continue
+		}
+		if record == nil || record.Height != tc.expectedHeight {
+			t.Errorf("%s: diff record, got %v, want height %v", tc.testName, record, tc.expectedHeight)
+		}
+	}
+
+	// nothing is pruned before history window is filled
+	for _, height := range []int64{-100000, 0} {
+		err = vs.PruneValidatorSetHistory(ctx, height)
+		assert.Nil(t, err)
+		record, err = vs.GetValidatorSetAtHeight(ctx, 15)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(10), record.Height)
+	}
+	record, err = vs.GetValidatorSetAtHeight(ctx, -1)
+	assert.Nil(t, err)
+	assert.Nil(t, record)
+
+	// prune keeps the record covering height 25
+	err = vs.PruneValidatorSetHistory(ctx, 25)
+	assert.Nil(t, err)
+	record, err = vs.GetValidatorSetAtHeight(ctx, 15)
+	assert.Nil(t, err)
+	assert.Nil(t, record)
+	record, err = vs.GetValidatorSetAtHeight(ctx, 25)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(20), record.Height)
+}
+
+func TestUnbondingDeposit(t *testing.T) {
+	ctx, vs := setup(t)
+	user := types.AccountKey("user")
+
+	deposit, err := vs.GetUnbondingDeposit(ctx, user, 1)
+	assert.Nil(t, err)
+	assert.Nil(t, deposit)
+
+	deposit1 := UnbondingDeposit{
+		Username: user, Amount: types.NewCoinFromInt64(1), CreatedHeight: 1, CreatedAt: 10, CompleteAt: 100}
+	deposit2 := UnbondingDeposit{
+		Username: user, Amount: types.NewCoinFromInt64(2), CreatedHeight: 2, CreatedAt: 20, CompleteAt: 110}
+	assert.Nil(t, vs.SetUnbondingDeposit(ctx, &deposit1))
+	assert.Nil(t, vs.SetUnbondingDeposit(ctx, &deposit2))
+	assert.Nil(t, vs.SetUnbondingDeposit(ctx, &UnbondingDeposit{
+		Username: types.AccountKey("user2"), Amount: types.NewCoinFromInt64(3), CreatedHeight: 1, CompleteAt: 100}))
+
+	deposit, err = vs.GetUnbondingDeposit(ctx, user, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, deposit1, *deposit)
+
+	deposits, err := vs.GetUnbondingDeposits(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, []UnbondingDeposit{deposit1, deposit2}, deposits)
+
+	vs.DeleteUnbondingDeposit(ctx, user, 1)
+	deposits, err = vs.GetUnbondingDeposits(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, []UnbondingDeposit{deposit2}, deposits)
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"github.com/lino-network/lino/types"
+)
+
+// CommissionRow - commission declared by a validator
+type CommissionRow struct {
+	Username   types.AccountKey `json:"username"`
+	Commission Commission       `json:"commission"`
+}
+
+// PunishmentHistoryRow - punishment log of a validator
+type PunishmentHistoryRow struct {
+	Username types.AccountKey  `json:"username"`
+	History  PunishmentHistory `json:"history"`
+}
+
+// SigningInfoRow - signing record of a validator
+type SigningInfoRow struct {
+	Username    types.AccountKey `json:"username"`
+	SigningInfo SigningInfo      `json:"signing_info"`
+}
+
+// ValidatorTables - state of validator KVStore
+type ValidatorTables struct {
+	Validators          []Validator            `json:"validators"`
+	List                ValidatorList          `json:"list"`
+	Commissions         []CommissionRow        `json:"commissions"`
+	PunishmentHistories []PunishmentHistoryRow `json:"punishment_histories"`
+	ValidatorSetHistory []ValidatorSetRecord   `json:"validator_set_history"`
+	UnbondingDeposits   []UnbondingDeposit     `json:"unbonding_deposits"`
+	SigningInfos        []SigningInfoRow       `json:"signing_infos"`
+}
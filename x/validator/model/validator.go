@@ -1,6 +1,7 @@
 package model
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/lino-network/lino/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -14,6 +15,14 @@ type Validator struct {
 	ByzantineCommit int64            `json:"byzantine_commit"`
 	ProducedBlocks  int64            `json:"produced_blocks"`
 	Link            string           `json:"link"`
+	IsJailed        bool             `json:"is_jailed,omitempty"`
+	JailUntil       int64            `json:"jail_until,omitempty"`
+}
+
+// JailedValidator - validator jailed for missing commits and the time it can unjail
+type JailedValidator struct {
+	Username  types.AccountKey `json:"username"`
+	JailUntil int64            `json:"jail_until"`
 }
 
 // Validator list
@@ -24,3 +33,65 @@ type ValidatorList struct {
 	LowestPower        types.Coin         `json:"lowest_power"`
 	LowestValidator    types.AccountKey   `json:"lowest_validator"`
 }
+
+// Commission - rate of hourly inflation kept by the validator, the rest is shared
+// with delegators. Rate change in one update can't exceed MaxChangeRate
+type Commission struct {
+	Rate          sdk.Rat `json:"rate"`
+	MaxChangeRate sdk.Rat `json:"max_change_rate"`
+	UpdatedAt     int64   `json:"updated_at"`
+}
+
+// PunishmentRecord - a punishment to validator, evidence hash is only set for byzantine punishment
+type PunishmentRecord struct {
+	Height       int64            `json:"height"`
+	Time         int64            `json:"time"`
+	PunishType   types.PunishType `json:"punish_type"`
+	Penalty      types.Coin       `json:"penalty"`
+	EvidenceHash string           `json:"evidence_hash"`
+}
+
+// PunishmentHistory - append-only punishment log of a validator
+type PunishmentHistory struct {
+	Records []PunishmentRecord `json:"records"`
+}
+
+// ValidatorSetEntry - username and consensus address of a validator in a validator set
+type ValidatorSetEntry struct {
+	Username types.AccountKey `json:"username"`
+	Address  []byte           `json:"address"`
+}
+
+// ValidatorSetRecord - oncall validator set since Height, only recorded when the set changes
+type ValidatorSetRecord struct {
+	Height     int64               `json:"height"`
+	Validators []ValidatorSetEntry `json:"validators"`
+}
+
+// UnbondingDeposit - deposit withdrawn at CreatedHeight, it is still slashable for
+// byzantine behavior committed at or before CreatedHeight until it returns at CompleteAt
+type UnbondingDeposit struct {
+	Username      types.AccountKey `json:"username"`
+	Amount        types.Coin       `json:"amount"`
+	CreatedHeight int64            `json:"created_height"`
+	CreatedAt     int64            `json:"created_at"`
+	CompleteAt    int64            `json:"complete_at"`
+}
+
+// SigningInfo - signing record of a validator in the sliding window of recent blocks,
+// bit i of MissedBlocks is set if the block at window index i is missed
+type SigningInfo struct {
+	WindowSize          int64  `json:"window_size"`
+	IndexOffset         int64  `json:"index_offset"`
+	MissedBlocks        []byte `json:"missed_blocks"`
+	MissedBlocksCounter int64  `json:"missed_blocks_counter"`
+}
+
+// ValidatorUptime - signed ratio of a validator in tracked blocks of the sliding window
+type ValidatorUptime struct {
+	Username      types.AccountKey `json:"username"`
+	WindowSize    int64            `json:"window_size"`
+	TrackedBlocks int64            `json:"tracked_blocks"`
+	MissedBlocks  int64            `json:"missed_blocks"`
+	Uptime        sdk.Rat          `json:"uptime"`
+}
@@ -12,6 +12,8 @@ import (
 var _ types.Msg = ValidatorDepositMsg{}
 var _ types.Msg = ValidatorWithdrawMsg{}
 var _ types.Msg = ValidatorRevokeMsg{}
+var _ types.Msg = ValidatorUpdateCommissionMsg{}
+var _ types.Msg = ValidatorUnjailMsg{}
 
 // ValidatorDepositMsg - deposit to become validator or add deposit
 type ValidatorDepositMsg struct {
@@ -19,6 +21,10 @@ type ValidatorDepositMsg struct {
 	Deposit   types.LNO        `json:"deposit"`
 	ValPubKey crypto.PubKey    `json:"validator_public_key"`
 	Link      string           `json:"link"`
+
+	// optional, commission can only be declared once
+	CommissionRate          string `json:"commission_rate,omitempty"`
+	MaxCommissionChangeRate string `json:"max_commission_change_rate,omitempty"`
 }
 
 // ValidatorWithdrawMsg - withdraw validator deposit
@@ -32,6 +38,17 @@ type ValidatorRevokeMsg struct {
 	Username types.AccountKey `json:"username"`
 }
 
+// ValidatorUpdateCommissionMsg - update declared commission rate
+type ValidatorUpdateCommissionMsg struct {
+	Username       types.AccountKey `json:"username"`
+	CommissionRate string           `json:"commission_rate"`
+}
+
+// ValidatorUnjailMsg - unjail validator after jail period
+type ValidatorUnjailMsg struct {
+	Username types.AccountKey `json:"username"`
+}
+
 // ValidatorDepositMsg Msg Implementations
 func NewValidatorDepositMsg(validator string, deposit types.LNO, pubKey crypto.PubKey, link string) ValidatorDepositMsg {
 	return ValidatorDepositMsg{
@@ -42,6 +59,21 @@ func NewValidatorDepositMsg(validator string, deposit types.LNO, pubKey crypto.P
 	}
 }
 
+// NewValidatorDepositMsgWithCommission - deposit and declare commission rate
+func NewValidatorDepositMsgWithCommission(
+	validator string, deposit types.LNO, pubKey crypto.PubKey, link string,
+	commissionRate, maxCommissionChangeRate string) ValidatorDepositMsg {
+	msg := NewValidatorDepositMsg(validator, deposit, pubKey, link)
+	msg.CommissionRate = commissionRate
+	msg.MaxCommissionChangeRate = maxCommissionChangeRate
+	return msg
+}
+
+// HasCommission - return true if commission is declared in this msg
+func (msg ValidatorDepositMsg) HasCommission() bool {
+	return len(msg.CommissionRate) > 0 || len(msg.MaxCommissionChangeRate) > 0
+}
+
 // Type - implement sdk.Msg
 func (msg ValidatorDepositMsg) Type() string { return types.ValidatorRouterName } // TODO: "account/register"
 
@@ -61,6 +93,14 @@ func (msg ValidatorDepositMsg) ValidateBasic() sdk.Error {
 		return err
 	}
 
+	if msg.HasCommission() {
+		if _, err := parseCommissionRate(msg.CommissionRate); err != nil {
+			return err
+		}
+		if _, err := parseCommissionRate(msg.MaxCommissionChangeRate); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -190,3 +230,101 @@ func (msg ValidatorRevokeMsg) GetSigners() []sdk.AccAddress {
 func (msg ValidatorRevokeMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// ValidatorUpdateCommissionMsg Msg Implementations
+func NewValidatorUpdateCommissionMsg(validator string, commissionRate string) ValidatorUpdateCommissionMsg {
+	return ValidatorUpdateCommissionMsg{
+		Username:       types.AccountKey(validator),
+		CommissionRate: commissionRate,
+	}
+}
+
+// Type - implement sdk.Msg
+func (msg ValidatorUpdateCommissionMsg) Type() string { return types.ValidatorRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorUpdateCommissionMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if _, err := parseCommissionRate(msg.CommissionRate); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg ValidatorUpdateCommissionMsg) String() string {
+	return fmt.Sprintf("ValidatorUpdateCommissionMsg{Username:%v, CommissionRate:%v}", msg.Username, msg.CommissionRate)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorUpdateCommissionMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorUpdateCommissionMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorUpdateCommissionMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorUpdateCommissionMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// ValidatorUnjailMsg Msg Implementations
+func NewValidatorUnjailMsg(validator string) ValidatorUnjailMsg {
+	return ValidatorUnjailMsg{
+		Username: types.AccountKey(validator),
+	}
+}
+
+// Type - implement sdk.Msg
+func (msg ValidatorUnjailMsg) Type() string { return types.ValidatorRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorUnjailMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg ValidatorUnjailMsg) String() string {
+	return fmt.Sprintf("ValidatorUnjailMsg{Username:%v}", msg.Username)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorUnjailMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorUnjailMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorUnjailMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorUnjailMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
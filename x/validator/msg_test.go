@@ -89,6 +89,30 @@ func TestValidatorDepositMsg(t *testing.T) {
 				"user", "1", secp256k1.GenPrivKey().PubKey(), string(make([]byte, types.MaximumLinkURL+1))),
 			expectedError: ErrInvalidWebsite(),
 		},
+		{
+			testName: "deposit with commission",
+			validatorDepositMsg: NewValidatorDepositMsgWithCommission(
+				"user1", "1", secp256k1.GenPrivKey().PubKey(), "", "0.1", "0.01"),
+			expectedError: nil,
+		},
+		{
+			testName: "commission rate larger than 1",
+			validatorDepositMsg: NewValidatorDepositMsgWithCommission(
+				"user1", "1", secp256k1.GenPrivKey().PubKey(), "", "1.1", "0.01"),
+			expectedError: ErrInvalidCommissionRate(),
+		},
+		{
+			testName: "negative max commission change rate",
+			validatorDepositMsg: NewValidatorDepositMsgWithCommission(
+				"user1", "1", secp256k1.GenPrivKey().PubKey(), "", "0.1", "-0.01"),
+			expectedError: ErrInvalidCommissionRate(),
+		},
+		{
+			testName: "missing max commission change rate",
+			validatorDepositMsg: NewValidatorDepositMsgWithCommission(
+				"user1", "1", secp256k1.GenPrivKey().PubKey(), "", "0.1", ""),
+			expectedError: ErrInvalidCommissionRate(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -99,6 +123,73 @@ func TestValidatorDepositMsg(t *testing.T) {
 	}
 }
 
+func TestValidatorUpdateCommissionMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ValidatorUpdateCommissionMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewValidatorUpdateCommissionMsg("user1", "0.5"),
+			expectedError: nil,
+		},
+		{
+			testName:      "zero commission rate",
+			msg:           NewValidatorUpdateCommissionMsg("user1", "0"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			msg:           NewValidatorUpdateCommissionMsg("", "0.5"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid commission rate",
+			msg:           NewValidatorUpdateCommissionMsg("user1", "a"),
+			expectedError: ErrInvalidCommissionRate(),
+		},
+		{
+			testName:      "commission rate too long",
+			msg:           NewValidatorUpdateCommissionMsg("user1", "0.1234567890"),
+			expectedError: ErrInvalidCommissionRate(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestValidatorUnjailMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ValidatorUnjailMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewValidatorUnjailMsg("user1"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			msg:           NewValidatorUnjailMsg(""),
+			expectedError: ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName           string
@@ -121,6 +212,16 @@ func TestMsgPermission(t *testing.T) {
 			msg:                NewValidatorRevokeMsg("test"),
 			expectedPermission: types.TransactionPermission,
 		},
+		{
+			testName:           "validator update commission msg",
+			msg:                NewValidatorUpdateCommissionMsg("test", "0.1"),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "validator unjail msg",
+			msg:                NewValidatorUnjailMsg("test"),
+			expectedPermission: types.TransactionPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -150,6 +251,14 @@ func TestGetSignBytes(t *testing.T) {
 			testName: "validator revoke msg",
 			msg:      NewValidatorRevokeMsg("test"),
 		},
+		{
+			testName: "validator update commission msg",
+			msg:      NewValidatorUpdateCommissionMsg("test", "0.1"),
+		},
+		{
+			testName: "validator unjail msg",
+			msg:      NewValidatorUnjailMsg("test"),
+		},
 	}
 
 	for testName, tc := range testCases {
@@ -194,3 +303,12 @@ func TestGetSigners(t *testing.T) {
 		}
 	}
 }
+
+func TestDepositMsgSignBytesWithoutCommission(t *testing.T) {
+	msg := NewValidatorDepositMsg("test", types.LNO("1"), secp256k1.GenPrivKey().PubKey(), "")
+	assert.NotContains(t, string(msg.GetSignBytes()), "commission")
+
+	msg = NewValidatorDepositMsgWithCommission(
+		"test", types.LNO("1"), secp256k1.GenPrivKey().PubKey(), "", "0.1", "0.01")
+	assert.Contains(t, string(msg.GetSignBytes()), "commission_rate")
+}
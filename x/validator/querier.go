@@ -0,0 +1,126 @@
+package validator
+
+import (
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryValidator - query validator, path: validator/<username>
+	QueryValidator = "validator"
+	// QueryValidatorList - query validator list, path: validatorList
+	QueryValidatorList = "validatorList"
+	// QueryCommission - query commission declared by validator, path: commission/<username>
+	QueryCommission = "commission"
+	// QueryJailedValidators - query jailed validators and their release time, path: jailedValidators
+	QueryJailedValidators = "jailedValidators"
+	// QueryPunishmentHistory - query punishment log of validator, path: punishmentHistory/<username>
+	QueryPunishmentHistory = "punishmentHistory"
+	// QueryUptime - query signed ratio of validator in the sliding window, path: uptime/<username>
+	QueryUptime = "uptime"
+)
+
+// NewQuerier - create a querier which serves custom queries under validator route
+func NewQuerier(vm ValidatorManager) sdk.Querier {
+	cdc := wire.NewCodec()
+	wire.RegisterCrypto(cdc)
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) == 0 {
+			return nil, types.ErrUnknownQueryPath("")
+		}
+		switch path[0] {
+		case QueryValidator:
+			return queryValidator(ctx, cdc, path[1:], vm)
+		case QueryValidatorList:
+			return queryValidatorList(ctx, cdc, path[1:], vm)
+		case QueryCommission:
+			return queryCommission(ctx, cdc, path[1:], vm)
+		case QueryJailedValidators:
+			return queryJailedValidators(ctx, cdc, path[1:], vm)
+		case QueryPunishmentHistory:
+			return queryPunishmentHistory(ctx, cdc, path[1:], vm)
+		case QueryUptime:
+			return queryUptime(ctx, cdc, path[1:], vm)
+		default:
+			return nil, types.ErrUnknownQueryPath(path[0])
+		}
+	}
+}
+
+func queryValidator(
+	ctx sdk.Context, cdc *wire.Codec, path []string, vm ValidatorManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	validator, err := vm.storage.GetValidator(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, validator)
+}
+
+func queryValidatorList(
+	ctx sdk.Context, cdc *wire.Codec, path []string, vm ValidatorManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	lst, err := vm.storage.GetValidatorList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, lst)
+}
+
+func queryCommission(
+	ctx sdk.Context, cdc *wire.Codec, path []string, vm ValidatorManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	commission, err := vm.storage.GetCommission(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	if commission == nil {
+		return nil, ErrCommissionNotDeclared()
+	}
+	return types.MarshalQueryResult(cdc, commission)
+}
+
+func queryJailedValidators(
+	ctx sdk.Context, cdc *wire.Codec, path []string, vm ValidatorManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	jailed, err := vm.GetJailedValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, jailed)
+}
+
+func queryPunishmentHistory(
+	ctx sdk.Context, cdc *wire.Codec, path []string, vm ValidatorManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	history, err := vm.storage.GetPunishmentHistory(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, history)
+}
+
+func queryUptime(
+	ctx sdk.Context, cdc *wire.Codec, path []string, vm ValidatorManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	uptime, err := vm.GetValidatorUptime(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, uptime)
+}
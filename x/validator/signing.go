@@ -0,0 +1,93 @@
+package validator
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/param"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/validator/model"
+)
+
+// updateSigningInfo - record whether the validator missed current block in its sliding window,
+// signing info is reset if window size changes
+func (vm ValidatorManager) updateSigningInfo(
+	ctx sdk.Context, username types.AccountKey, missed bool, windowSize int64) sdk.Error {
+	info, err := vm.storage.GetSigningInfo(ctx, username)
+	if err != nil {
+		return err
+	}
+	if info.WindowSize != windowSize {
+		info = &model.SigningInfo{
+			WindowSize:   windowSize,
+			MissedBlocks: make([]byte, (windowSize+7)/8),
+		}
+	}
+
+	index := info.IndexOffset % windowSize
+	previous := getMissedBit(info.MissedBlocks, index)
+	if !previous && missed {
+		info.MissedBlocksCounter++
+	} else if previous && !missed {
+		info.MissedBlocksCounter--
+	}
+	setMissedBit(info.MissedBlocks, index, missed)
+	info.IndexOffset++
+	return vm.storage.SetSigningInfo(ctx, username, info)
+}
+
+// isAbsentBeyondLimit - check if validator should be punished for missing blocks. If signed blocks
+// window is enabled, validator is punished when signed ratio in a full window is below min signed ratio
+func (vm ValidatorManager) isAbsentBeyondLimit(
+	ctx sdk.Context, validator *model.Validator, valParam *param.ValidatorParam) (bool, sdk.Error) {
+	if valParam.SignedBlocksWindow == 0 {
+		return validator.AbsentCommit > valParam.AbsentCommitLimitation, nil
+	}
+	info, err := vm.storage.GetSigningInfo(ctx, validator.Username)
+	if err != nil {
+		return false, err
+	}
+	if info.WindowSize != valParam.SignedBlocksWindow || info.IndexOffset < info.WindowSize {
+		return false, nil
+	}
+	signed := info.WindowSize - info.MissedBlocksCounter
+	return sdk.NewRat(signed, info.WindowSize).LT(valParam.MinSignedPerWindow), nil
+}
+
+// GetValidatorUptime - get signed ratio of validator in tracked blocks of the sliding window,
+// uptime is one if no block is tracked
+func (vm ValidatorManager) GetValidatorUptime(
+	ctx sdk.Context, username types.AccountKey) (*model.ValidatorUptime, sdk.Error) {
+	if !vm.storage.DoesValidatorExist(ctx, username) {
+		return nil, ErrAccountNotFound()
+	}
+	info, err := vm.storage.GetSigningInfo(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	tracked := info.IndexOffset
+	if tracked > info.WindowSize {
+		tracked = info.WindowSize
+	}
+	uptime := sdk.OneRat()
+	if tracked > 0 {
+		uptime = sdk.NewRat(tracked-info.MissedBlocksCounter, tracked)
+	}
+	return &model.ValidatorUptime{
+		Username:      username,
+		WindowSize:    info.WindowSize,
+		TrackedBlocks: tracked,
+		MissedBlocks:  info.MissedBlocksCounter,
+		Uptime:        uptime,
+	}, nil
+}
+
+func getMissedBit(bits []byte, index int64) bool {
+	return bits[index/8]&(1<<uint(index%8)) != 0
+}
+
+func setMissedBit(bits []byte, index int64, missed bool) {
+	if missed {
+		bits[index/8] |= 1 << uint(index%8)
+	} else {
+		bits[index/8] &^= 1 << uint(index%8)
+	}
+}
@@ -0,0 +1,146 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/lino-network/lino/param"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/validator/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestSigningWindow(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	valParam.SignedBlocksWindow = 10
+	valParam.MinSignedPerWindow = sdk.NewRat(1, 2)
+	err := param.ChangeParamEvent{Param: *valParam}.Execute(ctx, valManager.paramHolder)
+	assert.Nil(t, err)
+
+	minBalance := types.NewCoinFromInt64(100000 * types.Decimals)
+	deposit := valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(1000 * types.Decimals))
+	users := []types.AccountKey{"user1", "user2", "user3"}
+	valKeys := []crypto.PubKey{}
+	for _, user := range users {
+		createTestAccount(ctx, am, string(user), minBalance.Plus(deposit))
+		voteManager.AddVoter(ctx, user, valParam.ValidatorMinVotingDeposit)
+		valKey := secp256k1.GenPrivKey().PubKey()
+		valKeys = append(valKeys, valKey)
+		handler(ctx, NewValidatorDepositMsg(string(user), coinToString(deposit), valKey, ""))
+	}
+
+	// user1 misses one block in every two, user2 misses first 5 blocks, user3 misses 6 blocks
+	signBlock := func(height int64) {
+		signed := []bool{height%2 == 0, height >= 5, height%5 >= 3}
+		signingList := []abci.SigningValidator{}
+		for i, valKey := range valKeys {
+			signingList = append(signingList, abci.SigningValidator{
+				Validator:       abci.Validator{Address: valKey.Address(), Power: 1000},
+				SignedLastBlock: signed[i],
+			})
+		}
+		err := valManager.UpdateSigningValidator(ctx, signingList)
+		assert.Nil(t, err)
+	}
+
+	for height := int64(0); height < 9; height++ {
+		signBlock(height)
+	}
+	// nobody is punished before window is full
+	penalty, err := valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
+	assert.Nil(t, err)
+	assert.True(t, penalty.IsZero())
+
+	signBlock(9)
+	uptime, err := valManager.GetValidatorUptime(ctx, users[2])
+	assert.Nil(t, err)
+	assert.Equal(t, model.ValidatorUptime{
+		Username:      users[2],
+		WindowSize:    10,
+		TrackedBlocks: 10,
+		MissedBlocks:  6,
+		Uptime:        sdk.NewRat(4, 10),
+	}, *uptime)
+
+	// only user3 signed less than half of the window
+	penalty, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
+	assert.Nil(t, err)
+	assert.Equal(t, valParam.PenaltyMissCommit, penalty)
+	lst, _ := valManager.GetValidatorList(ctx)
+	assert.Equal(t, []types.AccountKey{users[0], users[1]}, lst.OncallValidators)
+
+	// signing info of punished validator is reset
+	uptime, err = valManager.GetValidatorUptime(ctx, users[2])
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), uptime.TrackedBlocks)
+	assert.True(t, uptime.Uptime.Equal(sdk.OneRat()))
+
+	// window slides, block missed by user2 at index 0 is replaced by a signed one
+	signBlock(10)
+	uptime, err = valManager.GetValidatorUptime(ctx, users[1])
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), uptime.MissedBlocks)
+	assert.True(t, uptime.Uptime.Equal(sdk.NewRat(6, 10)))
+
+	testCases := []struct {
+		testName     string
+		username     types.AccountKey
+		expectUptime sdk.Rat
+		expectedErr  sdk.Error
+	}{
+		{
+			testName:     "sparse misses at half of the window",
+			username:     users[0],
+			expectUptime: sdk.NewRat(1, 2),
+		},
+		{
+			testName:    "validator doesn't exist",
+			username:    types.AccountKey("user4"),
+			expectedErr: ErrAccountNotFound(),
+		},
+	}
+	for _, tc := range testCases {
+		uptime, err := valManager.GetValidatorUptime(ctx, tc.username)
+		if tc.expectedErr != nil {
+			assert.Equal(t, tc.expectedErr, err, tc.testName)
+			continue
+		}
+		assert.Nil(t, err, tc.testName)
+		if !uptime.Uptime.Equal(tc.expectUptime) {
+			t.Errorf("%s: diff uptime, got %v, want %v", tc.testName, uptime.Uptime, tc.expectUptime)
+		}
+	}
+}
+
+func TestSigningWindowResize(t *testing.T) {
+	ctx, _, valManager, _, _ := setupTest(t, 0)
+	valManager.InitGenesis(ctx)
+	user1 := types.AccountKey("user1")
+
+	for i := 0; i < 3; i++ {
+		err := valManager.updateSigningInfo(ctx, user1, true, 10)
+		assert.Nil(t, err)
+	}
+	info, _ := valManager.storage.GetSigningInfo(ctx, user1)
+	assert.Equal(t, int64(3), info.MissedBlocksCounter)
+	assert.Equal(t, 2, len(info.MissedBlocks))
+
+	// window size change restarts tracking
+	err := valManager.updateSigningInfo(ctx, user1, false, 20)
+	assert.Nil(t, err)
+	info, _ = valManager.storage.GetSigningInfo(ctx, user1)
+	assert.Equal(t, model.SigningInfo{
+		WindowSize:          20,
+		IndexOffset:         1,
+		MissedBlocks:        make([]byte, 3),
+		MissedBlocksCounter: 0,
+	}, *info)
+}
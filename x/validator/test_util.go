@@ -44,6 +44,7 @@ func setupTest(t *testing.T, height int64) (sdk.Context,
 	cdc := globalManager.WireCodec()
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "event/return", nil)
+	cdc.RegisterConcrete(UnbondingEvent{}, "event/validatorUnbonding", nil)
 
 	err := initGlobalManager(ctx, globalManager)
 	assert.Nil(t, err)
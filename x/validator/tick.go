@@ -17,9 +17,17 @@ func BeginBlocker(
 	if err := vm.SetValidatorList(ctx, validatorList); err != nil {
 		panic(err)
 	}
+	if err := vm.RecordValidatorSet(ctx); err != nil {
+		panic(err)
+	}
 
-	vm.UpdateSigningValidator(ctx, req.LastCommitInfo.Validators)
+	if err := vm.UpdateSigningValidator(ctx, req.LastCommitInfo.Validators); err != nil {
+		panic(err)
+	}
 
-	panelty, _ = vm.FireIncompetentValidator(ctx, req.ByzantineValidators)
+	panelty, err = vm.FireIncompetentValidator(ctx, req.ByzantineValidators)
+	if err != nil {
+		ctx.Logger().Error("failed to fire incompetent validators", "err", err)
+	}
 	return
 }
@@ -9,6 +9,8 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(ValidatorDepositMsg{}, "lino/valDeposit", nil)
 	cdc.RegisterConcrete(ValidatorWithdrawMsg{}, "lino/valWithdraw", nil)
 	cdc.RegisterConcrete(ValidatorRevokeMsg{}, "lino/valRevoke", nil)
+	cdc.RegisterConcrete(ValidatorUpdateCommissionMsg{}, "lino/valUpdateCommission", nil)
+	cdc.RegisterConcrete(ValidatorUnjailMsg{}, "lino/valUnjail", nil)
 }
 
 var msgCdc = wire.NewCodec()
@@ -9,15 +9,16 @@ import (
 
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/vote"
 	"github.com/lino-network/lino/x/vote/model"
 
 	"github.com/cosmos/cosmos-sdk/wire"
 )
 
 // GetDelegationCmd returns the delegator's delegation
-func GetDelegationCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetDelegationCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -27,9 +28,22 @@ func GetDelegationCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetDelegatorRewardCmd returns the delegator's unclaimed reward
+func GetDelegatorRewardCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		queryRoute,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "delegator-reward",
+		Short: "Query unclaimed delegator reward",
+		RunE:  cmdr.getDelegatorRewardCmd,
+	}
+}
+
 type commander struct {
-	storeName string
-	cdc       *wire.Codec
+	queryRoute string
+	cdc        *wire.Codec
 }
 
 func (c commander) getDelegationCmd(cmd *cobra.Command, args []string) error {
@@ -41,7 +55,7 @@ func (c commander) getDelegationCmd(cmd *cobra.Command, args []string) error {
 	voter := types.AccountKey(args[0])
 	delegator := types.AccountKey(args[1])
 
-	res, err := ctx.Query(model.GetDelegationKey(voter, delegator), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, vote.QueryDelegation, string(voter), string(delegator))
 	if err != nil {
 		return err
 	}
@@ -58,3 +72,26 @@ func (c commander) getDelegationCmd(cmd *cobra.Command, args []string) error {
 	fmt.Println(string(output))
 	return nil
 }
+
+func (c commander) getDelegatorRewardCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide delegator name")
+	}
+
+	res, err := ctx.QueryCustom(c.queryRoute, vote.QueryDelegatorReward, args[0])
+	if err != nil {
+		return err
+	}
+	reward := new(types.Coin)
+	if err := c.cdc.UnmarshalJSON(res, reward); err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(reward, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
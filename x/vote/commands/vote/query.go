@@ -11,12 +11,14 @@ import (
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/vote/model"
+
+	linovote "github.com/lino-network/lino/x/vote"
 )
 
 // GetVoterCmd returns target voter information
-func GetVoterCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetVoterCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -27,9 +29,9 @@ func GetVoterCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 }
 
 // GetVoteCmd returns a voter's vote on a proposal
-func GetVoteCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+func GetVoteCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
-		storeName,
+		queryRoute,
 		cdc,
 	}
 	return &cobra.Command{
@@ -40,8 +42,8 @@ func GetVoteCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 }
 
 type commander struct {
-	storeName string
-	cdc       *wire.Codec
+	queryRoute string
+	cdc        *wire.Codec
 }
 
 func (c commander) getVoterCmd(cmd *cobra.Command, args []string) error {
@@ -53,7 +55,7 @@ func (c commander) getVoterCmd(cmd *cobra.Command, args []string) error {
 	// find the key to look up the account
 	accKey := types.AccountKey(args[0])
 
-	res, err := ctx.Query(model.GetVoterKey(accKey), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, linovote.QueryVoter, string(accKey))
 	if err != nil {
 		return err
 	}
@@ -80,7 +82,7 @@ func (c commander) getVoteCmd(cmd *cobra.Command, args []string) error {
 	proposalID := types.ProposalKey(args[0])
 	voter := types.AccountKey(args[1])
 
-	res, err := ctx.Query(model.GetVoteKey(proposalID, voter), c.storeName)
+	res, err := ctx.QueryCustom(c.queryRoute, linovote.QueryVote, string(proposalID), string(voter))
 	if err != nil {
 		return err
 	}
@@ -26,6 +26,8 @@ func NewHandler(vm VoteManager, am acc.AccountManager, gm global.GlobalManager,
 			return handleDelegatorWithdrawMsg(ctx, vm, gm, am, rm, msg)
 		case ClaimInterestMsg:
 			return handleClaimInterestMsg(ctx, vm, gm, am, msg)
+		case ClaimDelegatorRewardMsg:
+			return handleClaimDelegatorRewardMsg(ctx, vm, am, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized vote msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -64,7 +66,13 @@ func handleStakeInMsg(
 		return err.Result()
 	}
 
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionStakeIn),
+			types.TagSender, []byte(msg.Username),
+			types.TagAmount, []byte(msg.Deposit),
+		),
+	}
 }
 
 func handleStakeOutMsg(
@@ -93,7 +101,13 @@ func handleStakeOutMsg(
 		param.VoterCoinReturnIntervalSec, coin, types.VoteReturnCoin); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionStakeOut),
+			types.TagSender, []byte(msg.Username),
+			types.TagAmount, []byte(msg.Amount),
+		),
+	}
 }
 
 func handleDelegateMsg(
@@ -131,7 +145,14 @@ func handleDelegateMsg(
 	if addErr := vm.AddDelegation(ctx, msg.Voter, msg.Delegator, coin); addErr != nil {
 		return addErr.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionDelegate),
+			types.TagSender, []byte(msg.Delegator),
+			types.TagReceiver, []byte(msg.Voter),
+			types.TagAmount, []byte(msg.Amount),
+		),
+	}
 }
 
 func handleDelegatorWithdrawMsg(
@@ -161,7 +182,14 @@ func handleDelegatorWithdrawMsg(
 		param.DelegatorCoinReturnIntervalSec, coin, types.DelegationReturnCoin); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionDelegatorWithdraw),
+			types.TagSender, []byte(msg.Delegator),
+			types.TagReceiver, []byte(msg.Voter),
+			types.TagAmount, []byte(msg.Amount),
+		),
+	}
 }
 
 func handleClaimInterestMsg(ctx sdk.Context, vm VoteManager, gm global.GlobalManager, am acc.AccountManager, msg ClaimInterestMsg) sdk.Result {
@@ -177,7 +205,32 @@ func handleClaimInterestMsg(ctx sdk.Context, vm VoteManager, gm global.GlobalMan
 		ctx, msg.Username, interest, "", "", types.ClaimInterest); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionClaimInterest),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
+}
+
+func handleClaimDelegatorRewardMsg(ctx sdk.Context, vm VoteManager, am acc.AccountManager, msg ClaimDelegatorRewardMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Username) {
+		return ErrAccountNotFound().Result()
+	}
+	reward, err := vm.ClaimDelegatorReward(ctx, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+	if err := am.AddSavingCoin(
+		ctx, msg.Username, reward, "", "", types.ClaimDelegatorReward); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionClaimDelegatorReward),
+			types.TagSender, []byte(msg.Username),
+		),
+	}
 }
 
 func AddStake(
@@ -22,7 +22,7 @@ func TestVoterDepositBasic(t *testing.T) {
 	// let user1 register as voter
 	msg := NewStakeInMsg("user1", coinToString(voteParam.MinStakeIn))
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, stakeInResult(msg), result)
 
 	// check acc1's money has been withdrawn
 	acc1saving, _ := am.GetSavingFromBank(ctx, user1)
@@ -40,6 +40,32 @@ func TestVoterDepositBasic(t *testing.T) {
 	assert.Equal(t, linoStat.UnclaimedLinoStake, voter.LinoStake)
 }
 
+func TestClaimDelegatorReward(t *testing.T) {
+	ctx, am, vm, gm, rm := setupTest(t, 0)
+	handler := NewHandler(vm, am, gm, rm)
+
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	vm.AddDelegatorReward(ctx, user1, c100)
+
+	msg := NewClaimDelegatorRewardMsg("user1")
+	result := handler(ctx, msg)
+	assert.Equal(t, sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionClaimDelegatorReward),
+			types.TagSender, []byte(msg.Username),
+		),
+	}, result)
+
+	saving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.Equal(t, minBalance.Plus(c100), saving)
+	reward, _ := vm.GetDelegatorReward(ctx, user1)
+	assert.Equal(t, true, reward.IsZero())
+
+	result = handler(ctx, NewClaimDelegatorRewardMsg("user2"))
+	assert.Equal(t, ErrAccountNotFound().Result(), result)
+}
+
 func TestDelegateBasic(t *testing.T) {
 	ctx, am, vm, gm, rm := setupTest(t, 0)
 	handler := NewHandler(vm, am, gm, rm)
@@ -61,7 +87,7 @@ func TestDelegateBasic(t *testing.T) {
 	msg2 := NewDelegateMsg("user2", "user1", coinToString(delegatedCoin))
 	handler(ctx, msg2)
 	result2 := handler(ctx, msg2)
-	assert.Equal(t, sdk.Result{}, result2)
+	assert.Equal(t, delegateResult(msg2), result2)
 
 	// make sure the voter's voting power is correct
 	voter, _ := vm.storage.GetVoter(ctx, user1)
@@ -77,7 +103,7 @@ func TestDelegateBasic(t *testing.T) {
 	// let user3 delegate power to user1
 	msg3 := NewDelegateMsg("user3", "user1", coinToString(delegatedCoin))
 	result3 := handler(ctx, msg3)
-	assert.Equal(t, sdk.Result{}, result3)
+	assert.Equal(t, delegateResult(msg3), result3)
 
 	// check delegator list is correct
 	delegators, _ := vm.storage.GetAllDelegators(ctx, "user1")
@@ -154,7 +180,7 @@ func TestRevokeBasic(t *testing.T) {
 	// let user3 reovke delegation
 	msg4 := NewDelegatorWithdrawMsg("user3", "user1", coinToString(delegatedCoin))
 	result := handler(ctx, msg4)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, delegatorWithdrawResult(msg4), result)
 
 	// make sure user3 won't get coins immediately, but user1 power down immediately
 	voter, _ := vm.storage.GetVoter(ctx, "user1")
@@ -173,7 +199,7 @@ func TestRevokeBasic(t *testing.T) {
 
 	vm.storage.SetReferenceList(ctx, referenceList)
 	result2 := handler(ctx, msg5)
-	assert.Equal(t, sdk.Result{}, result2)
+	assert.Equal(t, stakeOutResult(msg5), result2)
 
 	// make sure user2 wont get coins immediately, and delegatin was deleted
 	acc1Balance, _ := am.GetSavingFromBank(ctx, user1)
@@ -228,7 +254,7 @@ func TestVoterWithdraw(t *testing.T) {
 
 	msg3 := NewStakeOutMsg("user1", coinToString(withdraw))
 	result3 := handler(ctx, msg3)
-	assert.Equal(t, sdk.Result{}, result3)
+	assert.Equal(t, stakeOutResult(msg3), result3)
 
 	linoStat, _ = gs.GetLinoStakeStat(ctx, day)
 
@@ -278,13 +304,14 @@ func TestDelegatorWithdraw(t *testing.T) {
 			expectedResult: ErrIllegalWithdraw().Result(),
 		},
 		{
-			testName:       "normal withdraw",
-			addDelegation:  false,
-			delegatedCoin:  types.NewCoinFromInt64(0),
-			delegator:      user2,
-			voter:          user1,
-			withdraw:       delegatedCoin.Minus(delta),
-			expectedResult: sdk.Result{},
+			testName:      "normal withdraw",
+			addDelegation: false,
+			delegatedCoin: types.NewCoinFromInt64(0),
+			delegator:     user2,
+			voter:         user1,
+			withdraw:      delegatedCoin.Minus(delta),
+			expectedResult: delegatorWithdrawResult(NewDelegatorWithdrawMsg(
+				string(user2), string(user1), coinToString(delegatedCoin.Minus(delta)))),
 		},
 	}
 
@@ -292,7 +319,7 @@ func TestDelegatorWithdraw(t *testing.T) {
 		if tc.addDelegation {
 			msg := NewDelegateMsg(string(tc.delegator), string(tc.voter), coinToString(tc.delegatedCoin))
 			res := handler(ctx, msg)
-			if !assert.Equal(t, sdk.Result{}, res) {
+			if !assert.Equal(t, delegateResult(msg), res) {
 				t.Errorf("failed to add delegation")
 			}
 		}
@@ -393,3 +420,45 @@ func TestDeleteVoteBasic(t *testing.T) {
 	_, err := vm.storage.GetVote(ctx, proposalID1, "user2")
 	assert.Equal(t, model.ErrVoteNotFound(), err)
 }
+
+func stakeInResult(msg StakeInMsg) sdk.Result {
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionStakeIn),
+			types.TagSender, []byte(msg.Username),
+			types.TagAmount, []byte(msg.Deposit),
+		),
+	}
+}
+
+func stakeOutResult(msg StakeOutMsg) sdk.Result {
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionStakeOut),
+			types.TagSender, []byte(msg.Username),
+			types.TagAmount, []byte(msg.Amount),
+		),
+	}
+}
+
+func delegateResult(msg DelegateMsg) sdk.Result {
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionDelegate),
+			types.TagSender, []byte(msg.Delegator),
+			types.TagReceiver, []byte(msg.Voter),
+			types.TagAmount, []byte(msg.Amount),
+		),
+	}
+}
+
+func delegatorWithdrawResult(msg DelegatorWithdrawMsg) sdk.Result {
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			types.TagAction, []byte(types.ActionDelegatorWithdraw),
+			types.TagSender, []byte(msg.Delegator),
+			types.TagReceiver, []byte(msg.Voter),
+			types.TagAmount, []byte(msg.Amount),
+		),
+	}
+}
@@ -1,6 +1,8 @@
 package vote
 
 import (
+	"math/big"
+
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/vote/model"
@@ -8,6 +10,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// rewardIndexPrecision - scale of reward per delegation index, keeps rounding
+// dust of each distribution below one coin per 10^18 coin delegated
+var rewardIndexPrecision = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
 // VoteManager - vote manager
 type VoteManager struct {
 	storage     model.VoteStorage
@@ -154,6 +160,11 @@ func (vm VoteManager) AddDelegation(ctx sdk.Context, voterName types.AccountKey,
 		}
 	}
 
+	// settle reward accrued by previous amount before delegation changes
+	if err := vm.settleDelegatorReward(ctx, voterName, delegatorName, delegation.Amount); err != nil {
+		return err
+	}
+
 	// add delegatedPower for voter
 	voter, err := vm.storage.GetVoter(ctx, voterName)
 	if err != nil {
@@ -232,6 +243,15 @@ func (vm VoteManager) DelegatorWithdraw(
 	if coin.IsZero() {
 		return ErrInvalidCoin()
 	}
+	delegation, err := vm.storage.GetDelegation(ctx, voterName, delegatorName)
+	if err != nil {
+		return err
+	}
+	// settle reward accrued by previous amount before delegation changes
+	if err := vm.settleDelegatorReward(ctx, voterName, delegatorName, delegation.Amount); err != nil {
+		return err
+	}
+
 	// change voter's delegated power
 	voter, err := vm.storage.GetVoter(ctx, voterName)
 	if err != nil {
@@ -253,10 +273,6 @@ func (vm VoteManager) DelegatorWithdraw(
 	}
 
 	// change this delegation's amount
-	delegation, err := vm.storage.GetDelegation(ctx, voterName, delegatorName)
-	if err != nil {
-		return err
-	}
 	delegation.Amount = delegation.Amount.Minus(coin)
 
 	if delegation.Amount.IsZero() {
@@ -264,7 +280,9 @@ func (vm VoteManager) DelegatorWithdraw(
 			return err
 		}
 	} else {
-		vm.storage.SetDelegation(ctx, voterName, delegatorName, delegation)
+		if err := vm.storage.SetDelegation(ctx, voterName, delegatorName, delegation); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -299,6 +317,142 @@ func (vm VoteManager) AddInterest(
 	return nil
 }
 
+// DistributeDelegatorReward - share reward to all delegators of the voter pro rata
+// by delegation amount, returns the amount actually distributed. Reward is only
+// accrued to the voter's reward index here and settled to each delegator when
+// the delegation changes or the reward is claimed, so the cost doesn't grow
+// with the number of delegators
+func (vm VoteManager) DistributeDelegatorReward(
+	ctx sdk.Context, voterName types.AccountKey, reward types.Coin) (types.Coin, sdk.Error) {
+	distributed := types.NewCoinFromInt64(0)
+	if !reward.IsPositive() || !vm.DoesVoterExist(ctx, voterName) {
+		return distributed, nil
+	}
+	voter, err := vm.storage.GetVoter(ctx, voterName)
+	if err != nil {
+		return distributed, err
+	}
+	if !voter.DelegatedPower.IsPositive() {
+		return distributed, nil
+	}
+	pool, err := vm.storage.GetDelegatorRewardPool(ctx, voterName)
+	if err != nil {
+		return distributed, err
+	}
+	increment := new(big.Int).Quo(
+		new(big.Int).Mul(reward.Amount.BigInt(), rewardIndexPrecision),
+		voter.DelegatedPower.Amount.BigInt())
+	pool.RewardPerDelegation = pool.RewardPerDelegation.Add(sdk.NewIntFromBigInt(increment))
+	pool.Outstanding = pool.Outstanding.Plus(reward)
+	if err := vm.storage.SetDelegatorRewardPool(ctx, voterName, pool); err != nil {
+		return distributed, err
+	}
+	return reward, nil
+}
+
+// pendingDelegatorReward - reward accrued to delegation since it was last settled
+func (vm VoteManager) pendingDelegatorReward(
+	ctx sdk.Context, voterName types.AccountKey, delegatorName types.AccountKey,
+	amount types.Coin) (types.Coin, *model.DelegatorRewardPool, sdk.Error) {
+	pool, err := vm.storage.GetDelegatorRewardPool(ctx, voterName)
+	if err != nil {
+		return types.NewCoinFromInt64(0), nil, err
+	}
+	index, err := vm.storage.GetDelegationRewardIndex(ctx, voterName, delegatorName)
+	if err != nil {
+		return types.NewCoinFromInt64(0), nil, err
+	}
+	pending := types.NewCoinFromBigInt(new(big.Int).Quo(
+		new(big.Int).Mul(amount.Amount.BigInt(), pool.RewardPerDelegation.Sub(index).BigInt()),
+		rewardIndexPrecision))
+	// never pay out more than accrued to the voter
+	if pending.IsGT(pool.Outstanding) {
+		pending = pool.Outstanding
+	}
+	return pending, pool, nil
+}
+
+// settleDelegatorReward - move reward accrued to delegation to delegator's unclaimed
+// delegator reward, amount is the delegation amount before it changes
+func (vm VoteManager) settleDelegatorReward(
+	ctx sdk.Context, voterName types.AccountKey, delegatorName types.AccountKey, amount types.Coin) sdk.Error {
+	pending, pool, err := vm.pendingDelegatorReward(ctx, voterName, delegatorName, amount)
+	if err != nil {
+		return err
+	}
+	if pending.IsPositive() {
+		if err := vm.AddDelegatorReward(ctx, delegatorName, pending); err != nil {
+			return err
+		}
+		pool.Outstanding = pool.Outstanding.Minus(pending)
+		if err := vm.storage.SetDelegatorRewardPool(ctx, voterName, pool); err != nil {
+			return err
+		}
+	}
+	return vm.storage.SetDelegationRewardIndex(ctx, voterName, delegatorName, pool.RewardPerDelegation)
+}
+
+// AddDelegatorReward - add reward to delegator's unclaimed delegator reward
+func (vm VoteManager) AddDelegatorReward(
+	ctx sdk.Context, delegator types.AccountKey, reward types.Coin) sdk.Error {
+	unclaimed, err := vm.storage.GetDelegatorReward(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	return vm.storage.SetDelegatorReward(ctx, delegator, unclaimed.Plus(reward))
+}
+
+// GetDelegatorReward - get unclaimed delegator reward, including reward not settled yet
+func (vm VoteManager) GetDelegatorReward(
+	ctx sdk.Context, delegator types.AccountKey) (types.Coin, sdk.Error) {
+	reward, err := vm.storage.GetDelegatorReward(ctx, delegator)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	delegatees, err := vm.storage.GetAllDelegatees(ctx, delegator)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	for _, voterName := range delegatees {
+		delegation, err := vm.storage.GetDelegation(ctx, voterName, delegator)
+		if err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+		pending, _, err := vm.pendingDelegatorReward(ctx, voterName, delegator, delegation.Amount)
+		if err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+		reward = reward.Plus(pending)
+	}
+	return reward, nil
+}
+
+// ClaimDelegatorReward - settle all delegations of delegator, return and clear all unclaimed delegator reward
+func (vm VoteManager) ClaimDelegatorReward(
+	ctx sdk.Context, delegator types.AccountKey) (types.Coin, sdk.Error) {
+	delegatees, err := vm.storage.GetAllDelegatees(ctx, delegator)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	for _, voterName := range delegatees {
+		delegation, err := vm.storage.GetDelegation(ctx, voterName, delegator)
+		if err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+		if err := vm.settleDelegatorReward(ctx, voterName, delegator, delegation.Amount); err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+	}
+	reward, err := vm.storage.GetDelegatorReward(ctx, delegator)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if err := vm.storage.DeleteDelegatorReward(ctx, delegator); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return reward, nil
+}
+
 // GetVotingPower - get voter voting power
 func (vm VoteManager) GetVotingPower(ctx sdk.Context, voterName types.AccountKey) (types.Coin, sdk.Error) {
 	voter, err := vm.storage.GetVoter(ctx, voterName)
@@ -335,8 +489,9 @@ func (vm VoteManager) GetPenaltyList(
 		// vm.storage.DeleteVote(ctx, proposalID, vote.Voter)
 	}
 
-	// put all validators who didn't vote on these two types proposal into penalty list
-	if proposalType == types.ChangeParam || proposalType == types.ProtocolUpgrade {
+	// put all validators who didn't vote on these types proposal into penalty list
+	if proposalType == types.ChangeParam || proposalType == types.ProtocolUpgrade ||
+		proposalType == types.FailedEventRecovery {
 		penaltyList.PenaltyList = oncallValidators
 	}
 	return penaltyList, nil
@@ -387,3 +542,13 @@ func (vm VoteManager) GetValidatorReferenceList(ctx sdk.Context) (*model.Referen
 func (vm VoteManager) SetValidatorReferenceList(ctx sdk.Context, lst *model.ReferenceList) sdk.Error {
 	return vm.storage.SetReferenceList(ctx, lst)
 }
+
+// Export - export vote KVStore for genesis
+func (vm VoteManager) Export(ctx sdk.Context) (*model.VoteTables, sdk.Error) {
+	return vm.storage.Export(ctx)
+}
+
+// Import - import vote KVStore from exported genesis
+func (vm VoteManager) Import(ctx sdk.Context, tables *model.VoteTables) sdk.Error {
+	return vm.storage.Import(ctx, tables)
+}
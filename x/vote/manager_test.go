@@ -122,6 +122,98 @@ func TestAddAndClaimInterest(t *testing.T) {
 
 }
 
+func TestDistributeAndClaimDelegatorReward(t *testing.T) {
+	testName := "TestDistributeAndClaimDelegatorReward"
+	ctx, _, vm, _, _ := setupTest(t, 0)
+
+	voter := types.AccountKey("voter")
+	delegator1 := types.AccountKey("delegator1")
+	delegator2 := types.AccountKey("delegator2")
+	vm.AddVoter(ctx, voter, c100)
+	vm.AddVoter(ctx, delegator1, c100)
+	vm.AddVoter(ctx, delegator2, c100)
+
+	// no delegator, nothing is distributed
+	distributed, err := vm.DistributeDelegatorReward(ctx, voter, types.NewCoinFromInt64(10))
+	if err != nil {
+		t.Errorf("%s: failed to distribute delegator reward, got err %v", testName, err)
+	}
+	assert.Equal(t, types.NewCoinFromInt64(0), distributed)
+
+	vm.AddDelegation(ctx, voter, delegator1, types.NewCoinFromInt64(100))
+	vm.AddDelegation(ctx, voter, delegator2, types.NewCoinFromInt64(200))
+
+	// reward is shared pro rata, dust stays in the voter's reward pool
+	distributed, err = vm.DistributeDelegatorReward(ctx, voter, types.NewCoinFromInt64(10))
+	if err != nil {
+		t.Errorf("%s: failed to distribute delegator reward, got err %v", testName, err)
+	}
+	assert.Equal(t, types.NewCoinFromInt64(10), distributed)
+
+	reward1, _ := vm.GetDelegatorReward(ctx, delegator1)
+	assert.Equal(t, types.NewCoinFromInt64(3), reward1)
+	reward2, _ := vm.GetDelegatorReward(ctx, delegator2)
+	assert.Equal(t, types.NewCoinFromInt64(6), reward2)
+
+	// reward accumulates until claimed
+	vm.DistributeDelegatorReward(ctx, voter, types.NewCoinFromInt64(30))
+	claimed, err := vm.ClaimDelegatorReward(ctx, delegator1)
+	if err != nil {
+		t.Errorf("%s: failed to claim delegator reward, got err %v", testName, err)
+	}
+	assert.Equal(t, types.NewCoinFromInt64(13), claimed)
+	reward1, _ = vm.GetDelegatorReward(ctx, delegator1)
+	assert.Equal(t, true, reward1.IsZero())
+	reward2, _ = vm.GetDelegatorReward(ctx, delegator2)
+	assert.Equal(t, types.NewCoinFromInt64(26), reward2)
+}
+
+func TestDelegatorRewardSettledOnDelegationChange(t *testing.T) {
+	testName := "TestDelegatorRewardSettledOnDelegationChange"
+	ctx, _, vm, _, _ := setupTest(t, 0)
+
+	voter := types.AccountKey("voter")
+	delegator1 := types.AccountKey("delegator1")
+	delegator2 := types.AccountKey("delegator2")
+	vm.AddVoter(ctx, voter, c100)
+	vm.AddVoter(ctx, delegator1, c100)
+	vm.AddVoter(ctx, delegator2, c100)
+	vm.AddDelegation(ctx, voter, delegator1, types.NewCoinFromInt64(100))
+	vm.AddDelegation(ctx, voter, delegator2, types.NewCoinFromInt64(200))
+	vm.DistributeDelegatorReward(ctx, voter, types.NewCoinFromInt64(10))
+
+	// reward accrued before delegation increases is settled at old amount
+	if err := vm.AddDelegation(ctx, voter, delegator1, types.NewCoinFromInt64(200)); err != nil {
+		t.Errorf("%s: failed to add delegation, got err %v", testName, err)
+	}
+	unclaimed, _ := vm.storage.GetDelegatorReward(ctx, delegator1)
+	assert.Equal(t, types.NewCoinFromInt64(3), unclaimed)
+	vm.DistributeDelegatorReward(ctx, voter, types.NewCoinFromInt64(50))
+	reward1, _ := vm.GetDelegatorReward(ctx, delegator1)
+	assert.Equal(t, types.NewCoinFromInt64(33), reward1)
+
+	// reward accrued before full withdraw is kept after delegation is deleted
+	if err := vm.DelegatorWithdraw(ctx, voter, delegator2, types.NewCoinFromInt64(200)); err != nil {
+		t.Errorf("%s: failed to withdraw delegation, got err %v", testName, err)
+	}
+	assert.False(t, vm.DoesDelegationExist(ctx, voter, delegator2))
+	vm.DistributeDelegatorReward(ctx, voter, types.NewCoinFromInt64(30))
+	reward1, _ = vm.GetDelegatorReward(ctx, delegator1)
+	assert.Equal(t, types.NewCoinFromInt64(63), reward1)
+	reward2, _ := vm.GetDelegatorReward(ctx, delegator2)
+	assert.Equal(t, types.NewCoinFromInt64(26), reward2)
+
+	// delegating again doesn't earn reward distributed while not delegating
+	vm.AddDelegation(ctx, voter, delegator2, types.NewCoinFromInt64(100))
+	reward2, _ = vm.GetDelegatorReward(ctx, delegator2)
+	assert.Equal(t, types.NewCoinFromInt64(26), reward2)
+
+	claimed, _ := vm.ClaimDelegatorReward(ctx, delegator1)
+	assert.Equal(t, types.NewCoinFromInt64(63), claimed)
+	pool, _ := vm.storage.GetDelegatorRewardPool(ctx, voter)
+	assert.Equal(t, true, pool.Outstanding.IsEqual(types.NewCoinFromInt64(90-63-26)))
+}
+
 func TestIsInValidatorList(t *testing.T) {
 	ctx, am, vm, _, _ := setupTest(t, 0)
 	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
@@ -66,3 +66,33 @@ func ErrFailedToUnmarshalDelegation(err error) sdk.Error {
 func ErrFailedToUnmarshalReferenceList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalReferenceList, fmt.Sprintf("failed to unmarshal reference list: %s", err.Error()))
 }
+
+// ErrFailedToMarshalDelegatorReward - error if marshal delegator reward failed
+func ErrFailedToMarshalDelegatorReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalDelegatorReward, fmt.Sprintf("failed to marshal delegator reward: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalDelegatorReward - error if unmarshal delegator reward failed
+func ErrFailedToUnmarshalDelegatorReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalDelegatorReward, fmt.Sprintf("failed to unmarshal delegator reward: %s", err.Error()))
+}
+
+// ErrFailedToMarshalRewardPool - error if marshal delegator reward pool failed
+func ErrFailedToMarshalRewardPool(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalRewardPool, fmt.Sprintf("failed to marshal delegator reward pool: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalRewardPool - error if unmarshal delegator reward pool failed
+func ErrFailedToUnmarshalRewardPool(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalRewardPool, fmt.Sprintf("failed to unmarshal delegator reward pool: %s", err.Error()))
+}
+
+// ErrFailedToMarshalRewardIndex - error if marshal delegation reward index failed
+func ErrFailedToMarshalRewardIndex(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalRewardIndex, fmt.Sprintf("failed to marshal delegation reward index: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalRewardIndex - error if unmarshal delegation reward index failed
+func ErrFailedToUnmarshalRewardIndex(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalRewardIndex, fmt.Sprintf("failed to unmarshal delegation reward index: %s", err.Error()))
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"strings"
+
 	"github.com/lino-network/lino/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,11 +10,14 @@ import (
 )
 
 var (
-	delegationSubstore    = []byte{0x00}
-	voterSubstore         = []byte{0x01}
-	voteSubstore          = []byte{0x02}
-	referenceListSubStore = []byte{0x03}
-	delegateeSubStore     = []byte{0x04}
+	delegationSubstore      = []byte{0x00}
+	voterSubstore           = []byte{0x01}
+	voteSubstore            = []byte{0x02}
+	referenceListSubStore   = []byte{0x03}
+	delegateeSubStore       = []byte{0x04}
+	delegatorRewardSubStore = []byte{0x05}
+	rewardPoolSubStore      = []byte{0x06}
+	rewardIndexSubStore     = []byte{0x07}
 )
 
 // VoteStorage - vote storage
@@ -155,6 +160,7 @@ func (vs VoteStorage) DeleteDelegation(ctx sdk.Context, voter types.AccountKey,
 	store := ctx.KVStore(vs.key)
 	store.Delete(GetDelegationKey(voter, delegator))
 	store.Delete(getDelegateeKey(delegator, voter))
+	store.Delete(getRewardIndexKey(voter, delegator))
 	return nil
 }
 
@@ -178,6 +184,21 @@ func (vs VoteStorage) GetAllDelegators(ctx sdk.Context, voterName types.AccountK
 	return delegators, nil
 }
 
+// GetAllDelegatees - get all voters a delegator delegates to from KVStore
+func (vs VoteStorage) GetAllDelegatees(ctx sdk.Context, delegatorName types.AccountKey) ([]types.AccountKey, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	prefix := getDelegateePrefix(delegatorName)
+	iterator := store.Iterator(subspace(prefix))
+
+	var delegatees []types.AccountKey
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegatees = append(delegatees, types.AccountKey(iterator.Key()[len(prefix):]))
+	}
+	iterator.Close()
+	return delegatees, nil
+}
+
 // GetAllVotes - get all votes of a proposal from KVStore
 func (vs VoteStorage) GetAllVotes(ctx sdk.Context, proposalID types.ProposalKey) ([]Vote, sdk.Error) {
 	store := ctx.KVStore(vs.key)
@@ -223,6 +244,206 @@ func (vs VoteStorage) SetReferenceList(ctx sdk.Context, lst *ReferenceList) sdk.
 	return nil
 }
 
+// GetDelegatorReward - get unclaimed validator inflation shared to delegator, zero if not exist
+func (vs VoteStorage) GetDelegatorReward(ctx sdk.Context, delegator types.AccountKey) (types.Coin, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	rewardByte := store.Get(getDelegatorRewardKey(delegator))
+	if rewardByte == nil {
+		return types.NewCoinFromInt64(0), nil
+	}
+	reward := types.NewCoinFromInt64(0)
+	if err := vs.cdc.UnmarshalJSON(rewardByte, &reward); err != nil {
+		return types.NewCoinFromInt64(0), ErrFailedToUnmarshalDelegatorReward(err)
+	}
+	return reward, nil
+}
+
+// SetDelegatorReward - set unclaimed delegator reward to KVStore
+func (vs VoteStorage) SetDelegatorReward(ctx sdk.Context, delegator types.AccountKey, reward types.Coin) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	rewardByte, err := vs.cdc.MarshalJSON(reward)
+	if err != nil {
+		return ErrFailedToMarshalDelegatorReward(err)
+	}
+	store.Set(getDelegatorRewardKey(delegator), rewardByte)
+	return nil
+}
+
+// DeleteDelegatorReward - delete delegator reward from KVStore
+func (vs VoteStorage) DeleteDelegatorReward(ctx sdk.Context, delegator types.AccountKey) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	store.Delete(getDelegatorRewardKey(delegator))
+	return nil
+}
+
+// GetDelegatorRewardPool - get delegator reward pool of a voter, empty pool if not exist
+func (vs VoteStorage) GetDelegatorRewardPool(ctx sdk.Context, voter types.AccountKey) (*DelegatorRewardPool, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	poolByte := store.Get(getRewardPoolKey(voter))
+	if poolByte == nil {
+		return &DelegatorRewardPool{
+			RewardPerDelegation: sdk.NewInt(0),
+			Outstanding:         types.NewCoinFromInt64(0),
+		}, nil
+	}
+	pool := new(DelegatorRewardPool)
+	if err := vs.cdc.UnmarshalJSON(poolByte, pool); err != nil {
+		return nil, ErrFailedToUnmarshalRewardPool(err)
+	}
+	return pool, nil
+}
+
+// SetDelegatorRewardPool - set delegator reward pool of a voter to KVStore
+func (vs VoteStorage) SetDelegatorRewardPool(ctx sdk.Context, voter types.AccountKey, pool *DelegatorRewardPool) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	poolByte, err := vs.cdc.MarshalJSON(*pool)
+	if err != nil {
+		return ErrFailedToMarshalRewardPool(err)
+	}
+	store.Set(getRewardPoolKey(voter), poolByte)
+	return nil
+}
+
+// GetDelegationRewardIndex - get reward index of a delegation when it was last settled, zero if not exist
+func (vs VoteStorage) GetDelegationRewardIndex(ctx sdk.Context, voter types.AccountKey, delegator types.AccountKey) (sdk.Int, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	indexByte := store.Get(getRewardIndexKey(voter, delegator))
+	if indexByte == nil {
+		return sdk.NewInt(0), nil
+	}
+	index := sdk.NewInt(0)
+	if err := vs.cdc.UnmarshalJSON(indexByte, &index); err != nil {
+		return sdk.NewInt(0), ErrFailedToUnmarshalRewardIndex(err)
+	}
+	return index, nil
+}
+
+// SetDelegationRewardIndex - set reward index of a delegation to KVStore
+func (vs VoteStorage) SetDelegationRewardIndex(ctx sdk.Context, voter types.AccountKey, delegator types.AccountKey, index sdk.Int) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	indexByte, err := vs.cdc.MarshalJSON(index)
+	if err != nil {
+		return ErrFailedToMarshalRewardIndex(err)
+	}
+	store.Set(getRewardIndexKey(voter, delegator), indexByte)
+	return nil
+}
+
+// Export - export all records in vote KVStore
+func (vs VoteStorage) Export(ctx sdk.Context) (*VoteTables, sdk.Error) {
+	tables := &VoteTables{}
+	store := ctx.KVStore(vs.key)
+
+	voterIter := store.Iterator(subspace(voterSubstore))
+	defer voterIter.Close()
+	for ; voterIter.Valid(); voterIter.Next() {
+		var voter Voter
+		if err := vs.cdc.UnmarshalJSON(voterIter.Value(), &voter); err != nil {
+			return nil, ErrFailedToUnmarshalVoter(err)
+		}
+		tables.Voters = append(tables.Voters, voter)
+	}
+
+	voteIter := store.Iterator(subspace(voteSubstore))
+	defer voteIter.Close()
+	for ; voteIter.Valid(); voteIter.Next() {
+		row := VoteRow{ProposalID: types.ProposalKey(getKeyOwner(voteIter.Key(), voteSubstore))}
+		if err := vs.cdc.UnmarshalJSON(voteIter.Value(), &row.Vote); err != nil {
+			return nil, ErrFailedToUnmarshalVote(err)
+		}
+		tables.Votes = append(tables.Votes, row)
+	}
+
+	delegationIter := store.Iterator(subspace(delegationSubstore))
+	defer delegationIter.Close()
+	for ; delegationIter.Valid(); delegationIter.Next() {
+		row := DelegationRow{Voter: types.AccountKey(getKeyOwner(delegationIter.Key(), delegationSubstore))}
+		if err := vs.cdc.UnmarshalJSON(delegationIter.Value(), &row.Delegation); err != nil {
+			return nil, ErrFailedToUnmarshalDelegation(err)
+		}
+		tables.Delegations = append(tables.Delegations, row)
+	}
+
+	rewardIter := store.Iterator(subspace(delegatorRewardSubStore))
+	defer rewardIter.Close()
+	for ; rewardIter.Valid(); rewardIter.Next() {
+		row := DelegatorRewardRow{Delegator: types.AccountKey(rewardIter.Key()[len(delegatorRewardSubStore):])}
+		if err := vs.cdc.UnmarshalJSON(rewardIter.Value(), &row.Reward); err != nil {
+			return nil, ErrFailedToUnmarshalDelegatorReward(err)
+		}
+		tables.DelegatorRewards = append(tables.DelegatorRewards, row)
+	}
+
+	poolIter := store.Iterator(subspace(rewardPoolSubStore))
+	defer poolIter.Close()
+	for ; poolIter.Valid(); poolIter.Next() {
+		row := DelegatorRewardPoolRow{Voter: types.AccountKey(poolIter.Key()[len(rewardPoolSubStore):])}
+		if err := vs.cdc.UnmarshalJSON(poolIter.Value(), &row.Pool); err != nil {
+			return nil, ErrFailedToUnmarshalRewardPool(err)
+		}
+		tables.DelegatorRewardPools = append(tables.DelegatorRewardPools, row)
+	}
+
+	indexIter := store.Iterator(subspace(rewardIndexSubStore))
+	defer indexIter.Close()
+	for ; indexIter.Valid(); indexIter.Next() {
+		parts := strings.SplitN(string(indexIter.Key()[len(rewardIndexSubStore):]), types.KeySeparator, 2)
+		row := DelegationRewardIndexRow{Voter: types.AccountKey(parts[0]), Delegator: types.AccountKey(parts[1])}
+		if err := vs.cdc.UnmarshalJSON(indexIter.Value(), &row.RewardIndex); err != nil {
+			return nil, ErrFailedToUnmarshalRewardIndex(err)
+		}
+		tables.DelegationRewardIndexes = append(tables.DelegationRewardIndexes, row)
+	}
+
+	lst, err := vs.GetReferenceList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables.ReferenceList = *lst
+	return tables, nil
+}
+
+// Import - import all records exported by Export to vote KVStore
+func (vs VoteStorage) Import(ctx sdk.Context, tables *VoteTables) sdk.Error {
+	for _, voter := range tables.Voters {
+		if err := vs.SetVoter(ctx, voter.Username, &voter); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.Votes {
+		if err := vs.SetVote(ctx, row.ProposalID, row.Vote.Voter, &row.Vote); err != nil {
+			return err
+		}
+	}
+	// delegatee index is rebuilt by SetDelegation
+	for _, row := range tables.Delegations {
+		if err := vs.SetDelegation(ctx, row.Voter, row.Delegation.Delegator, &row.Delegation); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.DelegatorRewards {
+		if err := vs.SetDelegatorReward(ctx, row.Delegator, row.Reward); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.DelegatorRewardPools {
+		if err := vs.SetDelegatorRewardPool(ctx, row.Voter, &row.Pool); err != nil {
+			return err
+		}
+	}
+	for _, row := range tables.DelegationRewardIndexes {
+		if err := vs.SetDelegationRewardIndex(ctx, row.Voter, row.Delegator, row.RewardIndex); err != nil {
+			return err
+		}
+	}
+	return vs.SetReferenceList(ctx, &tables.ReferenceList)
+}
+
+// getKeyOwner - get owner from "substore" + "owner" + "separator" + "other"
+func getKeyOwner(key []byte, substore []byte) string {
+	return strings.SplitN(string(key[len(substore):]), types.KeySeparator, 2)[0]
+}
+
 func getDelegationPrefix(me types.AccountKey) []byte {
 	return append(append(delegationSubstore, me...), types.KeySeparator...)
 }
@@ -258,6 +479,19 @@ func getDelegateeKey(me, delegatee types.AccountKey) []byte {
 	return append(getDelegateePrefix(me), delegatee...)
 }
 
+func getDelegatorRewardKey(me types.AccountKey) []byte {
+	return append(delegatorRewardSubStore, me...)
+}
+
+func getRewardPoolKey(me types.AccountKey) []byte {
+	return append(rewardPoolSubStore, me...)
+}
+
+// getRewardIndexKey - "reward index substore" + "voter" + "separator" + "delegator"
+func getRewardIndexKey(voter, delegator types.AccountKey) []byte {
+	return append(append(append(rewardIndexSubStore, voter...), types.KeySeparator...), delegator...)
+}
+
 func subspace(prefix []byte) (start, end []byte) {
 	end = make([]byte, len(prefix))
 	copy(end, prefix)
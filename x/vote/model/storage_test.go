@@ -512,3 +512,47 @@ func TestAllDelegation(t *testing.T) {
 		}
 	}
 }
+
+func TestDelegationRewardIndex(t *testing.T) {
+	ctx, vs := setup(t)
+	voter := types.AccountKey("voter")
+	delegator := types.AccountKey("delegator")
+	assert.Nil(t, vs.InitGenesis(ctx))
+
+	pool, err := vs.GetDelegatorRewardPool(ctx, voter)
+	assert.Nil(t, err)
+	assert.Equal(t, true, pool.RewardPerDelegation.IsZero())
+	assert.Equal(t, true, pool.Outstanding.IsZero())
+
+	pool.RewardPerDelegation = sdk.NewInt(100)
+	pool.Outstanding = types.NewCoinFromInt64(10)
+	assert.Nil(t, vs.SetDelegatorRewardPool(ctx, voter, pool))
+	assert.Nil(t, vs.SetDelegation(ctx, voter, delegator, &Delegation{
+		Delegator: delegator, Amount: types.NewCoinFromInt64(1)}))
+	assert.Nil(t, vs.SetDelegationRewardIndex(ctx, voter, delegator, sdk.NewInt(100)))
+
+	tables, err := vs.Export(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tables.DelegatorRewardPools))
+	assert.Equal(t, 1, len(tables.DelegationRewardIndexes))
+	assert.Equal(t, delegator, tables.DelegationRewardIndexes[0].Delegator)
+
+	delegatees, err := vs.GetAllDelegatees(ctx, delegator)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{voter}, delegatees)
+
+	// reward index is removed with delegation
+	assert.Nil(t, vs.DeleteDelegation(ctx, voter, delegator))
+	index, err := vs.GetDelegationRewardIndex(ctx, voter, delegator)
+	assert.Nil(t, err)
+	assert.Equal(t, true, index.IsZero())
+
+	ctx2, vs2 := setup(t)
+	assert.Nil(t, vs2.Import(ctx2, tables))
+	index, err = vs2.GetDelegationRewardIndex(ctx2, voter, delegator)
+	assert.Nil(t, err)
+	assert.Equal(t, true, index.Equal(sdk.NewInt(100)))
+	importedPool, err := vs2.GetDelegatorRewardPool(ctx2, voter)
+	assert.Nil(t, err)
+	assert.Equal(t, true, importedPool.Outstanding.IsEqual(types.NewCoinFromInt64(10)))
+}
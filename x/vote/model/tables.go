@@ -0,0 +1,49 @@
+package model
+
+import (
+	"github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteRow - vote to a proposal
+type VoteRow struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Vote       Vote              `json:"vote"`
+}
+
+// DelegationRow - delegation to a voter
+type DelegationRow struct {
+	Voter      types.AccountKey `json:"voter"`
+	Delegation Delegation       `json:"delegation"`
+}
+
+// DelegatorRewardRow - unclaimed validator inflation shared to delegator
+type DelegatorRewardRow struct {
+	Delegator types.AccountKey `json:"delegator"`
+	Reward    types.Coin       `json:"reward"`
+}
+
+// DelegatorRewardPoolRow - delegator reward pool of a voter
+type DelegatorRewardPoolRow struct {
+	Voter types.AccountKey    `json:"voter"`
+	Pool  DelegatorRewardPool `json:"pool"`
+}
+
+// DelegationRewardIndexRow - reward index of a delegation when it was last settled
+type DelegationRewardIndexRow struct {
+	Voter       types.AccountKey `json:"voter"`
+	Delegator   types.AccountKey `json:"delegator"`
+	RewardIndex sdk.Int          `json:"reward_index"`
+}
+
+// VoteTables - state of vote KVStore
+type VoteTables struct {
+	Voters                  []Voter                    `json:"voters"`
+	Votes                   []VoteRow                  `json:"votes"`
+	Delegations             []DelegationRow            `json:"delegations"`
+	DelegatorRewards        []DelegatorRewardRow       `json:"delegator_rewards"`
+	DelegatorRewardPools    []DelegatorRewardPoolRow   `json:"delegator_reward_pools"`
+	DelegationRewardIndexes []DelegationRewardIndexRow `json:"delegation_reward_indexes"`
+	ReferenceList           ReferenceList              `json:"reference_list"`
+}
@@ -2,6 +2,8 @@ package model
 
 import (
 	types "github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Voter - a voter in blockchain is account with voter deposit, who can vote for a proposal
@@ -31,3 +33,10 @@ type Delegation struct {
 type ReferenceList struct {
 	AllValidators []types.AccountKey `json:"all_validators"`
 }
+
+// DelegatorRewardPool - validator inflation shared to delegators of a voter but not settled yet,
+// RewardPerDelegation is the accumulated reward per unit of delegation scaled by reward index precision
+type DelegatorRewardPool struct {
+	RewardPerDelegation sdk.Int    `json:"reward_per_delegation"`
+	Outstanding         types.Coin `json:"outstanding"`
+}
@@ -14,6 +14,7 @@ var _ types.Msg = StakeOutMsg{}
 var _ types.Msg = DelegateMsg{}
 var _ types.Msg = DelegatorWithdrawMsg{}
 var _ types.Msg = ClaimInterestMsg{}
+var _ types.Msg = ClaimDelegatorRewardMsg{}
 
 // StakeInMsg - voter deposit
 type StakeInMsg struct {
@@ -46,6 +47,11 @@ type ClaimInterestMsg struct {
 	Username types.AccountKey `json:"username"`
 }
 
+// ClaimDelegatorRewardMsg - claim validator inflation shared to delegator
+type ClaimDelegatorRewardMsg struct {
+	Username types.AccountKey `json:"username"`
+}
+
 // NewStakeInMsg - return a StakeInMsg
 func NewStakeInMsg(username string, deposit types.LNO) StakeInMsg {
 	return StakeInMsg{
@@ -308,3 +314,50 @@ func (msg ClaimInterestMsg) GetSigners() []sdk.AccAddress {
 func (msg ClaimInterestMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// NewClaimDelegatorRewardMsg - return a ClaimDelegatorRewardMsg
+func NewClaimDelegatorRewardMsg(username string) ClaimDelegatorRewardMsg {
+	return ClaimDelegatorRewardMsg{
+		Username: types.AccountKey(username),
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg ClaimDelegatorRewardMsg) Type() string { return types.VoteRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg ClaimDelegatorRewardMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg ClaimDelegatorRewardMsg) String() string {
+	return fmt.Sprintf("ClaimDelegatorRewardMsg{Username:%v}", msg.Username)
+}
+
+// GetPermission - implements types.Msg
+func (msg ClaimDelegatorRewardMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ClaimDelegatorRewardMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ClaimDelegatorRewardMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg ClaimDelegatorRewardMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
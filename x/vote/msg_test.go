@@ -74,6 +74,40 @@ func TestClaimInterestMsg(t *testing.T) {
 	}
 }
 
+func TestClaimDelegatorRewardMsg(t *testing.T) {
+	testCases := map[string]struct {
+		msg      ClaimDelegatorRewardMsg
+		wantCode sdk.CodeType
+	}{
+		"normal case": {
+			msg:      NewClaimDelegatorRewardMsg("test"),
+			wantCode: sdk.CodeOK,
+		},
+		"invalid claim delegator reward - Username is too short": {
+			msg:      NewClaimDelegatorRewardMsg("te"),
+			wantCode: types.CodeInvalidUsername,
+		},
+		"invalid claim delegator reward - Username is too long": {
+			msg:      NewClaimDelegatorRewardMsg("testtesttesttesttesttest"),
+			wantCode: types.CodeInvalidUsername,
+		},
+	}
+
+	for testName, tc := range testCases {
+		got := tc.msg.ValidateBasic()
+
+		if got == nil {
+			if tc.wantCode != sdk.CodeOK {
+				t.Errorf("%s: diff error: got %v, want %v", testName, tc.wantCode, tc.wantCode)
+			}
+			continue
+		}
+		if got.Code() != tc.wantCode {
+			t.Errorf("%s: diff error code: got %v, want %v", testName, got.Code(), tc.wantCode)
+		}
+	}
+}
+
 func TestStakeOutMsg(t *testing.T) {
 	testCases := []struct {
 		testName      string
@@ -203,6 +237,11 @@ func TestMsgPermission(t *testing.T) {
 			msg:                NewDelegatorWithdrawMsg("delegator", "voter", types.LNO("1")),
 			expectedPermission: types.TransactionPermission,
 		},
+		{
+			testName:           "claim delegator reward",
+			msg:                NewClaimDelegatorRewardMsg("test"),
+			expectedPermission: types.AppPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -234,6 +273,10 @@ func TestGetSignBytes(t *testing.T) {
 			testName: "delegate withdraw",
 			msg:      NewDelegatorWithdrawMsg("delegator", "voter", types.LNO("1")),
 		},
+		{
+			testName: "claim delegator reward",
+			msg:      NewClaimDelegatorRewardMsg("test"),
+		},
 	}
 
 	for _, tc := range testCases {
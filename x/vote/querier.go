@@ -0,0 +1,143 @@
+package vote
+
+import (
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryVoter - query voter, path: voter/<username>
+	QueryVoter = "voter"
+	// QueryVote - query vote of a voter to proposal, path: vote/<proposal id>/<voter>
+	QueryVote = "vote"
+	// QueryDelegation - query delegation, path: delegation/<voter>/<delegator>
+	QueryDelegation = "delegation"
+	// QueryAllDelegators - query all delegators of a voter, path: allDelegators/<voter>
+	QueryAllDelegators = "allDelegators"
+	// QueryAllVotes - query all votes of a proposal, path: allVotes/<proposal id>
+	QueryAllVotes = "allVotes"
+	// QueryReferenceList - query validator reference list, path: referenceList
+	QueryReferenceList = "referenceList"
+	// QueryDelegatorReward - query unclaimed delegator reward, path: delegatorReward/<username>
+	QueryDelegatorReward = "delegatorReward"
+)
+
+// NewQuerier - create a querier which serves custom queries under vote route
+func NewQuerier(vm VoteManager) sdk.Querier {
+	cdc := wire.NewCodec()
+	wire.RegisterCrypto(cdc)
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) == 0 {
+			return nil, types.ErrUnknownQueryPath("")
+		}
+		switch path[0] {
+		case QueryVoter:
+			return queryVoter(ctx, cdc, path[1:], vm)
+		case QueryVote:
+			return queryVote(ctx, cdc, path[1:], vm)
+		case QueryDelegation:
+			return queryDelegation(ctx, cdc, path[1:], vm)
+		case QueryAllDelegators:
+			return queryAllDelegators(ctx, cdc, path[1:], vm)
+		case QueryAllVotes:
+			return queryAllVotes(ctx, cdc, path[1:], vm)
+		case QueryReferenceList:
+			return queryReferenceList(ctx, cdc, path[1:], vm)
+		case QueryDelegatorReward:
+			return queryDelegatorReward(ctx, cdc, path[1:], vm)
+		default:
+			return nil, types.ErrUnknownQueryPath(path[0])
+		}
+	}
+}
+
+func queryVoter(
+	ctx sdk.Context, cdc *wire.Codec, path []string, vm VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	voter, err := vm.storage.GetVoter(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, voter)
+}
+
+func queryVote(
+	ctx sdk.Context, cdc *wire.Codec, path []string, vm VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 2); err != nil {
+		return nil, err
+	}
+	vote, err := vm.storage.GetVote(ctx, types.ProposalKey(path[0]), types.AccountKey(path[1]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, vote)
+}
+
+func queryDelegation(
+	ctx sdk.Context, cdc *wire.Codec, path []string, vm VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 2); err != nil {
+		return nil, err
+	}
+	delegation, err := vm.storage.GetDelegation(
+		ctx, types.AccountKey(path[0]), types.AccountKey(path[1]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, delegation)
+}
+
+func queryAllDelegators(
+	ctx sdk.Context, cdc *wire.Codec, path []string, vm VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	delegators, err := vm.storage.GetAllDelegators(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	if delegators == nil {
+		delegators = []types.AccountKey{}
+	}
+	return types.MarshalQueryResult(cdc, delegators)
+}
+
+func queryAllVotes(
+	ctx sdk.Context, cdc *wire.Codec, path []string, vm VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	votes, err := vm.storage.GetAllVotes(ctx, types.ProposalKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, votes)
+}
+
+func queryReferenceList(
+	ctx sdk.Context, cdc *wire.Codec, path []string, vm VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 0); err != nil {
+		return nil, err
+	}
+	lst, err := vm.storage.GetReferenceList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, lst)
+}
+
+func queryDelegatorReward(
+	ctx sdk.Context, cdc *wire.Codec, path []string, vm VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckQueryParams(path, 1); err != nil {
+		return nil, err
+	}
+	reward, err := vm.GetDelegatorReward(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	return types.MarshalQueryResult(cdc, reward)
+}
@@ -11,6 +11,7 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(DelegateMsg{}, "lino/delegate", nil)
 	cdc.RegisterConcrete(DelegatorWithdrawMsg{}, "lino/delegateWithdraw", nil)
 	cdc.RegisterConcrete(ClaimInterestMsg{}, "lino/claimInterest", nil)
+	cdc.RegisterConcrete(ClaimDelegatorRewardMsg{}, "lino/claimDelegatorReward", nil)
 }
 
 var msgCdc = wire.NewCodec()